@@ -4,22 +4,34 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"flag"
 	"fmt"
 	"log"
 	"net"
+	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/opiproject/gospdk/spdk"
 
 	"github.com/opiproject/opi-spdk-bridge/pkg/backend"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
 	"github.com/opiproject/opi-spdk-bridge/pkg/frontend"
+	"github.com/opiproject/opi-spdk-bridge/pkg/kms"
 	"github.com/opiproject/opi-spdk-bridge/pkg/kvm"
 	"github.com/opiproject/opi-spdk-bridge/pkg/middleend"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
 
 	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
 func splitBusesBySeparator(str string) []string {
@@ -29,7 +41,38 @@ func splitBusesBySeparator(str string) []string {
 	return []string{}
 }
 
+func newKeyManager(kind, localKek, localKeys, kmipEndpoint, kmipCert, kmipKey, kmipCa string) (kms.KeyManager, error) {
+	switch kind {
+	case "":
+		return nil, nil
+	case "local":
+		return kms.NewLocalKeyManager(localKek, localKeys)
+	case "kmip":
+		cert, err := tls.LoadX509KeyPair(kmipCert, kmipKey)
+		if err != nil {
+			return nil, err
+		}
+		config := &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+		if kmipCa != "" {
+			ca, err := os.ReadFile(filepath.Clean(kmipCa))
+			if err != nil {
+				return nil, err
+			}
+			config.RootCAs = x509.NewCertPool()
+			if !config.RootCAs.AppendCertsFromPEM(ca) {
+				return nil, fmt.Errorf("no certificates found in %v", kmipCa)
+			}
+		}
+		return kms.NewKmipKeyManager(kmipEndpoint, config), nil
+	default:
+		return nil, fmt.Errorf("not supported key manager: %v", kind)
+	}
+}
+
 func main() {
+	// key material sent to SPDK is logged by the SPDK client
+	log.SetOutput(server.NewRedactingWriter(os.Stderr))
+
 	var port int
 	flag.IntVar(&port, "port", 50051, "The Server port")
 
@@ -50,6 +93,54 @@ func main() {
 
 	var tcpTransportListenAddr string
 	flag.StringVar(&tcpTransportListenAddr, "tcp_trid", "127.0.0.1:4420", "ipv4 address:port (aka traddr:trsvcid) or ipv6 [address]:port tuple (aka [traddr]:trsvcid) to listen on for Nvme/TCP transport")
+
+	var rdmaTransportListenAddr string
+	flag.StringVar(&rdmaTransportListenAddr, "rdma_trid", "", "ipv4 address:port (aka traddr:trsvcid) or ipv6 [address]:port tuple (aka [traddr]:trsvcid) to listen on for Nvme/RDMA transport instead of Nvme/TCP. Requires RDMA transport created in SPDK. Not valid with -kvm option")
+
+	var aioOverUring bool
+	flag.BoolVar(&aioOverUring, "aio_uring", false, "Creates Aio volumes as io_uring bdevs if supported by SPDK")
+
+	var compressPmPath string
+	flag.StringVar(&compressPmPath, "compress_pm_path", "/var/tmp/opi-compress", "Directory where SPDK keeps metadata of compressed volumes")
+
+	var faultInjection bool
+	flag.BoolVar(&faultInjection, "fault_injection", false, "Allows creating volumes which fail or delay I/O for resilience testing")
+
+	var keyManagerKind string
+	flag.StringVar(&keyManagerKind, "kms", "", "Key manager resolving key references of encrypted volumes: \"local\" or \"kmip\"")
+
+	var kmsLocalKek string
+	flag.StringVar(&kmsLocalKek, "kms_local_kek", "", "File with raw AES-256 key encryption key. Valid only with -kms=local option")
+
+	var kmsLocalKeys string
+	flag.StringVar(&kmsLocalKeys, "kms_local_keys", "", "Directory with wrapped data encryption keys named after their references. Valid only with -kms=local option")
+
+	var kmipEndpoint string
+	flag.StringVar(&kmipEndpoint, "kmip_addr", "127.0.0.1:5696", "KMIP server address:port. Valid only with -kms=kmip option")
+
+	var kmipCert string
+	flag.StringVar(&kmipCert, "kmip_cert", "", "Client certificate for KMIP server. Valid only with -kms=kmip option")
+
+	var kmipKey string
+	flag.StringVar(&kmipKey, "kmip_key", "", "Client private key for KMIP server. Valid only with -kms=kmip option")
+
+	var kmipCa string
+	flag.StringVar(&kmipCa, "kmip_ca", "", "CA certificates to verify KMIP server, system roots if not set. Valid only with -kms=kmip option")
+
+	var cryptoAccelModule string
+	flag.StringVar(&cryptoAccelModule, "crypto_accel_module", "", "SPDK accel module executing crypto operations of encrypted volumes: \"software\", \"dpdk_cryptodev\" or \"mlx5\". Requires SPDK started with --wait-for-rpc, whose subsystems are then initialized by the bridge")
+
+	var cryptoAccelDriver string
+	flag.StringVar(&cryptoAccelDriver, "crypto_accel_driver", "", "DPDK crypto driver: \"crypto_aesni_mb\", \"crypto_qat\" or \"mlx5_pci\". Valid only with -crypto_accel_module=dpdk_cryptodev option")
+
+	var cryptoTweakMode string
+	flag.StringVar(&cryptoTweakMode, "crypto_tweak_mode", "", "Tweak mode of AES_XTS keys, e.g. \"INCR_512_FULL_LBA\". Valid only with -crypto_accel_module=mlx5 option")
+
+	var qosInterval time.Duration
+	flag.DurationVar(&qosInterval, "qos_interval", time.Second, "Interval of adjusting limits of QoS volumes with per-direction IOPS caps or min limits. 0 disables adjustment")
+
+	var nvmeHostAllowlist bool
+	flag.BoolVar(&nvmeHostAllowlist, "nvme_host_allowlist", false, "Creates Nvme subsystems which accept only hosts added by NvmeSubsystemHostService.AddNvmeSubsystemHost instead of any host")
 	flag.Parse()
 
 	buses := splitBusesBySeparator(busesStr)
@@ -61,35 +152,108 @@ func main() {
 	s := grpc.NewServer()
 
 	jsonRPC := spdk.NewSpdkJSONRPC(spdkAddress)
-	backendServer := backend.NewServer(jsonRPC)
-	middleendServer := middleend.NewServer(jsonRPC)
+	volumes := volume.NewRegistry()
+	backendServer := backend.NewServer(jsonRPC, volumes)
+	middleendServer := middleend.NewServer(jsonRPC, volumes)
+	middleendServer.SetCompressPmPath(compressPmPath)
+
+	keyManager, err := newKeyManager(keyManagerKind, kmsLocalKek, kmsLocalKeys, kmipEndpoint, kmipCert, kmipKey, kmipCa)
+	if err != nil {
+		log.Fatalf("failed to create key manager: %v", err)
+	}
+	if keyManager != nil {
+		log.Printf("Key references of encrypted volumes are resolved by %v key manager.", keyManagerKind)
+		middleendServer.SetKeyManager(keyManager)
+	}
+
+	cryptoOptions := middleend.CryptoOptions{
+		Module:    middleend.AccelModule(cryptoAccelModule),
+		Driver:    cryptoAccelDriver,
+		TweakMode: middleend.TweakMode(cryptoTweakMode),
+	}
+	err = middleendServer.ConfigureCrypto(cryptoOptions)
+	switch {
+	case status.Code(err) == codes.FailedPrecondition:
+		log.Printf("Crypto operations are executed by default accel modules: %v", err)
+	case err != nil:
+		log.Fatalf("failed to configure crypto: %v", err)
+	}
+
+	if qosInterval > 0 {
+		go middleendServer.RunQosController(context.Background(), qosInterval)
+	}
+
+	if aioOverUring {
+		uring, err := backendServer.EnableAioOverUring()
+		if err != nil {
+			log.Fatalf("failed to detect io_uring support: %v", err)
+		}
+		log.Printf("Aio volumes are created as io_uring bdevs: %v", uring)
+	}
+
+	if faultInjection {
+		log.Println("Fault injection volumes are enabled.")
+		backendServer.EnableFaultInjection()
+	}
+
+	if useKvm && rdmaTransportListenAddr != "" {
+		log.Fatalf("-rdma_trid is not valid with -kvm option")
+	}
 
 	if useKvm {
 		log.Println("Creating KVM server.")
-		frontendServer := frontend.NewCustomizedServer(jsonRPC,
+		frontendServer := frontend.NewCustomizedServer(jsonRPC, volumes,
 			kvm.NewVfiouserSubsystemListener(ctrlrDir),
 			frontend.NewVhostUserBlkTransport(),
 		)
+		if nvmeHostAllowlist {
+			frontendServer.RequireNvmeHostAllowlist()
+		}
 		kvmServer := kvm.NewServer(frontendServer, qmpAddress, ctrlrDir, buses)
 
 		pb.RegisterFrontendNvmeServiceServer(s, kvmServer)
 		pb.RegisterFrontendVirtioBlkServiceServer(s, kvmServer)
 		pb.RegisterFrontendVirtioScsiServiceServer(s, kvmServer)
+		bridgepb.RegisterNvmeSubsystemHostServiceServer(s, kvmServer)
+		bridgepb.RegisterNvmeControllerConnectionServiceServer(s, kvmServer)
+		bridgepb.RegisterNvmeSubsystemSettingsServiceServer(s, kvmServer)
 	} else {
-		frontendServer := frontend.NewCustomizedServer(jsonRPC,
-			frontend.NewTCPSubsystemListener(tcpTransportListenAddr),
+		subsysListener := frontend.NewTCPSubsystemListener(tcpTransportListenAddr)
+		if rdmaTransportListenAddr != "" {
+			log.Printf("Nvme controllers listen on RDMA transport at %v", rdmaTransportListenAddr)
+			subsysListener = frontend.NewRDMASubsystemListener(rdmaTransportListenAddr)
+		}
+		frontendServer := frontend.NewCustomizedServer(jsonRPC, volumes,
+			subsysListener,
 			frontend.NewVhostUserBlkTransport(),
 		)
+		if nvmeHostAllowlist {
+			frontendServer.RequireNvmeHostAllowlist()
+		}
 		pb.RegisterFrontendNvmeServiceServer(s, frontendServer)
 		pb.RegisterFrontendVirtioBlkServiceServer(s, frontendServer)
 		pb.RegisterFrontendVirtioScsiServiceServer(s, frontendServer)
+		bridgepb.RegisterNvmeSubsystemHostServiceServer(s, frontendServer)
+		bridgepb.RegisterNvmeControllerConnectionServiceServer(s, frontendServer)
+		bridgepb.RegisterNvmeSubsystemSettingsServiceServer(s, frontendServer)
 	}
 
 	pb.RegisterNvmeRemoteControllerServiceServer(s, backendServer)
 	pb.RegisterNullVolumeServiceServer(s, backendServer)
 	pb.RegisterAioVolumeServiceServer(s, backendServer)
+	bridgepb.RegisterNvmeRemoteControllerOptionsServiceServer(s, backendServer)
+	bridgepb.RegisterUringVolumeServiceServer(s, backendServer)
+	bridgepb.RegisterNullVolumeMetadataServiceServer(s, backendServer)
+	bridgepb.RegisterFaultVolumeServiceServer(s, backendServer)
 	pb.RegisterMiddleendEncryptionServiceServer(s, middleendServer)
 	pb.RegisterMiddleendQosVolumeServiceServer(s, middleendServer)
+	bridgepb.RegisterCompressedVolumeServiceServer(s, middleendServer)
+	bridgepb.RegisterCachedVolumeServiceServer(s, middleendServer)
+	bridgepb.RegisterEncryptedVolumeKeyServiceServer(s, middleendServer)
+	bridgepb.RegisterQosVolumeBurstServiceServer(s, middleendServer)
+	bridgepb.RegisterQosPolicyServiceServer(s, middleendServer)
+	bridgepb.RegisterQosGroupServiceServer(s, middleendServer)
+	bridgepb.RegisterVolumeStatsServiceServer(s, middleendServer)
 
 	reflection.Register(s)
 
@@ -15,6 +15,7 @@ import (
 	"github.com/opiproject/gospdk/spdk"
 	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
 	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
 
 	"github.com/google/uuid"
 	"go.einride.tech/aip/fieldbehavior"
@@ -24,8 +25,21 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
+// defaultAioBlockSize is used when no block size is requested on create
+const defaultAioBlockSize = 512
+
+// bdevAioRescanParams holds the parameters required to rescan an AIO or
+// io_uring Block Device
+type bdevAioRescanParams struct {
+	Name string `json:"name"`
+}
+
+// bdevAioRescanResult is the result of rescanning an AIO or io_uring Block Device
+type bdevAioRescanResult bool
+
 func sortAioVolumes(volumes []*pb.AioVolume) {
 	sort.Slice(volumes, func(i int, j int) bool {
 		return volumes[i].Name < volumes[j].Name
@@ -34,7 +48,7 @@ func sortAioVolumes(volumes []*pb.AioVolume) {
 
 // CreateAioVolume creates an Aio volume
 func (s *Server) CreateAioVolume(_ context.Context, in *pb.CreateAioVolumeRequest) (*pb.AioVolume, error) {
-	log.Printf("CreateAioVolume: Received from client: %v", in)
+	log.Printf("CreateAioVolume: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -58,33 +72,21 @@ func (s *Server) CreateAioVolume(_ context.Context, in *pb.CreateAioVolumeReques
 		log.Printf("Already existing AioVolume with id %v", in.AioVolume.Name)
 		return volume, nil
 	}
-	// not found, so create a new one
-	params := spdk.BdevAioCreateParams{
-		Name:      resourceID,
-		BlockSize: 512,
-		Filename:  in.AioVolume.Filename,
+	if err := s.checkVolumeNameUnused(in.AioVolume.Name); err != nil {
+		return nil, err
 	}
-	var result spdk.BdevAioCreateResult
-	err := s.rpc.Call("bdev_aio_create", &params, &result)
+	// not found, so create a new one
+	response, err := s.createAioBdev(resourceID, in.AioVolume)
 	if err != nil {
-		log.Printf("error: %v", err)
 		return nil, err
 	}
-	log.Printf("Received from SPDK: %v", result)
-	if result == "" {
-		msg := fmt.Sprintf("Could not create Aio Dev: %s", params.Name)
-		log.Print(msg)
-		return nil, status.Errorf(codes.InvalidArgument, msg)
-	}
-	response := server.ProtoClone(in.AioVolume)
-	s.Volumes.AioVolumes[in.AioVolume.Name] = response
-	log.Printf("CreateAioVolume: Sending to client: %v", response)
+	log.Printf("CreateAioVolume: Sending to client: %v", server.Redact(response))
 	return response, nil
 }
 
 // DeleteAioVolume deletes an Aio volume
 func (s *Server) DeleteAioVolume(_ context.Context, in *pb.DeleteAioVolumeRequest) (*emptypb.Empty, error) {
-	log.Printf("DeleteAioVolume: Received from client: %v", in)
+	log.Printf("DeleteAioVolume: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -105,12 +107,20 @@ func (s *Server) DeleteAioVolume(_ context.Context, in *pb.DeleteAioVolumeReques
 		log.Printf("error: %v", err)
 		return nil, err
 	}
+	if err := s.registry.CheckUnused(volume.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
 	resourceID := path.Base(volume.Name)
 	params := spdk.BdevAioDeleteParams{
 		Name: resourceID,
 	}
+	method := "bdev_aio_delete"
+	if s.aio.uringBacked[volume.Name] {
+		method = "bdev_uring_delete"
+	}
 	var result spdk.BdevAioDeleteResult
-	err := s.rpc.Call("bdev_aio_delete", &params, &result)
+	err := s.rpc.Call(method, &params, &result)
 	if err != nil {
 		log.Printf("error: %v", err)
 		return nil, err
@@ -122,12 +132,14 @@ func (s *Server) DeleteAioVolume(_ context.Context, in *pb.DeleteAioVolumeReques
 		return nil, status.Errorf(codes.InvalidArgument, msg)
 	}
 	delete(s.Volumes.AioVolumes, volume.Name)
+	delete(s.aio.uringBacked, volume.Name)
+	s.unregisterVolume(volume.Name)
 	return &emptypb.Empty{}, nil
 }
 
 // UpdateAioVolume updates an Aio volume
 func (s *Server) UpdateAioVolume(_ context.Context, in *pb.UpdateAioVolumeRequest) (*pb.AioVolume, error) {
-	log.Printf("UpdateAioVolume: Received from client: %v", in)
+	log.Printf("UpdateAioVolume: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -143,26 +155,11 @@ func (s *Server) UpdateAioVolume(_ context.Context, in *pb.UpdateAioVolumeReques
 	if !ok {
 		if in.AllowMissing {
 			log.Printf("Got AllowMissing, create a new resource, don't return error when resource not found")
-			params := spdk.BdevAioCreateParams{
-				Name:      path.Base(in.AioVolume.Name),
-				BlockSize: 512,
-				Filename:  in.AioVolume.Filename,
-			}
-			var result spdk.BdevAioCreateResult
-			err := s.rpc.Call("bdev_aio_create", &params, &result)
+			response, err := s.createAioBdev(path.Base(in.AioVolume.Name), in.AioVolume)
 			if err != nil {
-				log.Printf("error: %v", err)
 				return nil, err
 			}
-			log.Printf("Received from SPDK: %v", result)
-			if result == "" {
-				msg := fmt.Sprintf("Could not create Aio Dev: %s", params.Name)
-				log.Print(msg)
-				return nil, status.Errorf(codes.InvalidArgument, msg)
-			}
-			response := server.ProtoClone(in.AioVolume)
-			s.Volumes.AioVolumes[in.AioVolume.Name] = response
-			log.Printf("CreateAioVolume: Sending to client: %v", response)
+			log.Printf("CreateAioVolume: Sending to client: %v", server.Redact(response))
 			return response, nil
 		}
 		err := status.Errorf(codes.NotFound, "unable to find key %s", in.AioVolume.Name)
@@ -175,46 +172,57 @@ func (s *Server) UpdateAioVolume(_ context.Context, in *pb.UpdateAioVolumeReques
 		log.Printf("error: %v", err)
 		return nil, err
 	}
-	params1 := spdk.BdevAioDeleteParams{
+	if err := s.checkAioVolumeImmutableFields(volume, in.AioVolume, in.UpdateMask); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// pick up a grown backing file without tearing down the bdev
+	params := bdevAioRescanParams{
 		Name: resourceID,
 	}
-	var result1 spdk.BdevAioDeleteResult
-	err1 := s.rpc.Call("bdev_aio_delete", &params1, &result1)
-	if err1 != nil {
-		log.Printf("error: %v", err1)
-		return nil, err1
+	method := "bdev_aio_rescan"
+	if s.aio.uringBacked[volume.Name] {
+		method = "bdev_uring_rescan"
 	}
-	log.Printf("Received from SPDK: %v", result1)
-	if !result1 {
-		msg := fmt.Sprintf("Could not delete Aio Dev: %s", params1.Name)
+	var result bdevAioRescanResult
+	err := s.rpc.Call(method, &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not rescan Aio Dev: %s", params.Name)
 		log.Print(msg)
 		return nil, status.Errorf(codes.InvalidArgument, msg)
 	}
-	params2 := spdk.BdevAioCreateParams{
-		Name:      resourceID,
-		BlockSize: 512,
-		Filename:  in.AioVolume.Filename,
+	params2 := spdk.BdevGetBdevsParams{
+		Name: resourceID,
 	}
-	var result2 spdk.BdevAioCreateResult
-	err2 := s.rpc.Call("bdev_aio_create", &params2, &result2)
-	if err2 != nil {
-		log.Printf("error: %v", err2)
-		return nil, err2
+	var result2 []spdk.BdevGetBdevsResult
+	err = s.rpc.Call("bdev_get_bdevs", &params2, &result2)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
 	}
 	log.Printf("Received from SPDK: %v", result2)
-	if result2 == "" {
-		msg := fmt.Sprintf("Could not create Aio Dev: %s", params2.Name)
+	if len(result2) != 1 {
+		msg := fmt.Sprintf("expecting exactly 1 result, got %d", len(result2))
 		log.Print(msg)
 		return nil, status.Errorf(codes.InvalidArgument, msg)
 	}
-	response := server.ProtoClone(in.AioVolume)
-	s.Volumes.AioVolumes[in.AioVolume.Name] = response
+	volume.BlocksCount = result2[0].NumBlocks
+	if err := s.registry.SetSize(volume.Name, volume.BlockSize, volume.BlocksCount); err != nil {
+		log.Printf("error: %v", err)
+	}
+	response := server.ProtoClone(volume)
+	log.Printf("UpdateAioVolume: Sending to client: %v", server.Redact(response))
 	return response, nil
 }
 
 // ListAioVolumes lists Aio volumes
 func (s *Server) ListAioVolumes(_ context.Context, in *pb.ListAioVolumesRequest) (*pb.ListAioVolumesResponse, error) {
-	log.Printf("ListAioVolumes: Received from client: %v", in)
+	log.Printf("ListAioVolumes: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -251,7 +259,7 @@ func (s *Server) ListAioVolumes(_ context.Context, in *pb.ListAioVolumesRequest)
 
 // GetAioVolume gets an Aio volume
 func (s *Server) GetAioVolume(_ context.Context, in *pb.GetAioVolumeRequest) (*pb.AioVolume, error) {
-	log.Printf("GetAioVolume: Received from client: %v", in)
+	log.Printf("GetAioVolume: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -288,9 +296,11 @@ func (s *Server) GetAioVolume(_ context.Context, in *pb.GetAioVolumeRequest) (*p
 	return &pb.AioVolume{Name: result[0].Name, BlockSize: result[0].BlockSize, BlocksCount: result[0].NumBlocks}, nil
 }
 
-// StatsAioVolume gets an Aio volume stats
+// StatsAioVolume gets an Aio volume stats. Counters saturate at
+// math.MaxInt32 and latencies are in microseconds, see
+// server.VolumeStats.ToProto. StatsVolume reports them in 64 bits
 func (s *Server) StatsAioVolume(_ context.Context, in *pb.StatsAioVolumeRequest) (*pb.StatsAioVolumeResponse, error) {
-	log.Printf("StatsAioVolume: Received from client: %v", in)
+	log.Printf("StatsAioVolume: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -325,15 +335,92 @@ func (s *Server) StatsAioVolume(_ context.Context, in *pb.StatsAioVolumeRequest)
 		log.Print(msg)
 		return nil, status.Errorf(codes.InvalidArgument, msg)
 	}
-	return &pb.StatsAioVolumeResponse{Stats: &pb.VolumeStats{
-		ReadBytesCount:    int32(result.Bdevs[0].BytesRead),
-		ReadOpsCount:      int32(result.Bdevs[0].NumReadOps),
-		WriteBytesCount:   int32(result.Bdevs[0].BytesWritten),
-		WriteOpsCount:     int32(result.Bdevs[0].NumWriteOps),
-		UnmapBytesCount:   int32(result.Bdevs[0].BytesUnmapped),
-		UnmapOpsCount:     int32(result.Bdevs[0].NumUnmapOps),
-		ReadLatencyTicks:  int32(result.Bdevs[0].ReadLatencyTicks),
-		WriteLatencyTicks: int32(result.Bdevs[0].WriteLatencyTicks),
-		UnmapLatencyTicks: int32(result.Bdevs[0].UnmapLatencyTicks),
-	}}, nil
+	stats := server.NewVolumeStats(&result)
+	if err := s.volumeHistogram(volume.Name, stats); err != nil {
+		return nil, err
+	}
+	s.stats.Update(resourceID, stats)
+	log.Printf("Rates of %v: %+v", resourceID, stats.Rates)
+	return &pb.StatsAioVolumeResponse{Stats: stats.ToProto()}, nil
+}
+
+// createAioBdev creates an Aio bdev with the given name and stores the volume
+func (s *Server) createAioBdev(resourceID string, aioVolume *pb.AioVolume) (*pb.AioVolume, error) {
+	blockSize, err := fileBdevBlockSize(aioVolume)
+	if err != nil {
+		return nil, err
+	}
+	params := spdk.BdevAioCreateParams{
+		Name:      resourceID,
+		BlockSize: int(blockSize),
+		Filename:  aioVolume.Filename,
+	}
+	method := "bdev_aio_create"
+	if s.aio.overUring {
+		// bdev_uring_create takes the same parameters
+		method = "bdev_uring_create"
+	}
+	var result spdk.BdevAioCreateResult
+	err = s.rpc.Call(method, &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if result == "" {
+		msg := fmt.Sprintf("Could not create Aio Dev: %s", params.Name)
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	response := server.ProtoClone(aioVolume)
+	response.BlockSize = blockSize
+	s.Volumes.AioVolumes[response.Name] = response
+	volumeType := volume.TypeAio
+	if s.aio.overUring {
+		s.aio.uringBacked[response.Name] = true
+		volumeType = volume.TypeUring
+	}
+	s.registerVolume(response.Name, volumeType, pb.AioVolumeService_ServiceDesc.ServiceName, response.BlockSize, response.BlocksCount)
+	return server.ProtoClone(response), nil
+}
+
+// fileBdevBlockSize returns the block size requested for a file based bdev
+// or the default one if not set
+func fileBdevBlockSize(volume *pb.AioVolume) (int64, error) {
+	blockSize := volume.BlockSize
+	if blockSize == 0 {
+		blockSize = defaultAioBlockSize
+	}
+	if blockSize < defaultAioBlockSize || blockSize&(blockSize-1) != 0 {
+		msg := fmt.Sprintf("block_size must be a power of 2 not less than %d, got %d", defaultAioBlockSize, blockSize)
+		log.Print(msg)
+		return 0, status.Errorf(codes.InvalidArgument, msg)
+	}
+	return blockSize, nil
+}
+
+// checkAioVolumeImmutableFields rejects updates of fields which cannot be
+// changed without recreating the bdev. Unset fields are not compared
+func (s *Server) checkAioVolumeImmutableFields(volume *pb.AioVolume, update *pb.AioVolume, mask *fieldmaskpb.FieldMask) error {
+	updated := func(field string) bool {
+		if mask == nil || len(mask.Paths) == 0 {
+			return true
+		}
+		for _, p := range mask.Paths {
+			if p == "*" || p == field {
+				return true
+			}
+		}
+		return false
+	}
+	if updated("filename") && update.Filename != "" && update.Filename != volume.Filename {
+		return status.Error(codes.InvalidArgument, "filename of AioVolume cannot be changed")
+	}
+	if updated("block_size") && update.BlockSize != 0 && update.BlockSize != volume.BlockSize {
+		return status.Error(codes.InvalidArgument, "block_size of AioVolume cannot be changed")
+	}
+	if updated("uuid") && update.Uuid != nil && update.Uuid.Value != volume.Uuid.GetValue() {
+		return status.Error(codes.InvalidArgument, "uuid of AioVolume cannot be changed")
+	}
+	return nil
 }
@@ -96,6 +96,24 @@ func TestBackEnd_CreateAioVolume(t *testing.T) {
 			"",
 			false,
 		},
+		"valid request with default block size": {
+			testAioVolumeID,
+			&pb.AioVolume{BlocksCount: 12, Filename: "/tmp/aio_bdev_file"},
+			&testAioVolume,
+			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":"mytest"}`},
+			codes.OK,
+			"",
+			false,
+		},
+		"invalid block size": {
+			testAioVolumeID,
+			&pb.AioVolume{BlockSize: 1000, Filename: "/tmp/aio_bdev_file"},
+			nil,
+			[]string{},
+			codes.InvalidArgument,
+			fmt.Sprintf("block_size must be a power of 2 not less than %d, got %d", 512, 1000),
+			false,
+		},
 		"already exists": {
 			testAioVolumeID,
 			&testAioVolume,
@@ -176,83 +194,106 @@ func TestBackEnd_UpdateAioVolume(t *testing.T) {
 			fmt.Sprintf("invalid field path: %s", "'*' must not be used with other paths"),
 			false,
 		},
-		"delete fails": {
+		"rescan fails": {
 			nil,
 			testAioVolumeWithName,
 			nil,
 			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
 			codes.InvalidArgument,
-			fmt.Sprintf("Could not delete Aio Dev: %s", testAioVolumeID),
+			fmt.Sprintf("Could not rescan Aio Dev: %s", testAioVolumeID),
 			false,
 		},
-		"delete empty": {
+		"rescan empty": {
 			nil,
 			testAioVolumeWithName,
 			nil,
 			[]string{""},
 			codes.Unknown,
-			fmt.Sprintf("bdev_aio_delete: %v", "EOF"),
+			fmt.Sprintf("bdev_aio_rescan: %v", "EOF"),
 			false,
 		},
-		"delete ID mismatch": {
+		"rescan ID mismatch": {
 			nil,
 			testAioVolumeWithName,
 			nil,
 			[]string{`{"id":0,"error":{"code":0,"message":""},"result":false}`},
 			codes.Unknown,
-			fmt.Sprintf("bdev_aio_delete: %v", "json response ID mismatch"),
+			fmt.Sprintf("bdev_aio_rescan: %v", "json response ID mismatch"),
 			false,
 		},
-		"delete exception": {
+		"rescan exception": {
 			nil,
 			testAioVolumeWithName,
 			nil,
 			[]string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":false}`},
 			codes.Unknown,
-			fmt.Sprintf("bdev_aio_delete: %v", "json response error: myopierr"),
+			fmt.Sprintf("bdev_aio_rescan: %v", "json response error: myopierr"),
 			false,
 		},
-		"delete ok create fails": {
+		"rescan ok get bdevs exception": {
 			nil,
 			testAioVolumeWithName,
 			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":""}`},
-			codes.InvalidArgument,
-			fmt.Sprintf("Could not create Aio Dev: %v", testAioVolumeID),
+			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":1,"message":"myopierr"},"result":[]}`},
+			codes.Unknown,
+			fmt.Sprintf("bdev_get_bdevs: %v", "json response error: myopierr"),
 			false,
 		},
-		"delete ok create empty": {
+		"rescan ok get bdevs no result": {
 			nil,
 			testAioVolumeWithName,
 			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, ""},
-			codes.Unknown,
-			fmt.Sprintf("bdev_aio_create: %v", "EOF"),
+			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":[]}`},
+			codes.InvalidArgument,
+			fmt.Sprintf("expecting exactly 1 result, got %d", 0),
 			false,
 		},
-		"delete ok create ID mismatch": {
+		"filename cannot be changed": {
 			nil,
-			testAioVolumeWithName,
+			&pb.AioVolume{Name: testAioVolumeName, Filename: "/tmp/other_file"},
 			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":0,"error":{"code":0,"message":""},"result":""}`},
-			codes.Unknown,
-			fmt.Sprintf("bdev_aio_create: %v", "json response ID mismatch"),
+			[]string{},
+			codes.InvalidArgument,
+			"filename of AioVolume cannot be changed",
 			false,
 		},
-		"delete ok create exception": {
+		"block size cannot be changed": {
+			&fieldmaskpb.FieldMask{Paths: []string{"block_size"}},
+			&pb.AioVolume{Name: testAioVolumeName, BlockSize: 4096, Filename: testAioVolume.Filename},
 			nil,
+			[]string{},
+			codes.InvalidArgument,
+			"block_size of AioVolume cannot be changed",
+			false,
+		},
+		"filename outside of mask is ignored": {
+			&fieldmaskpb.FieldMask{Paths: []string{"blocks_count"}},
+			&pb.AioVolume{Name: testAioVolumeName, Filename: "/tmp/other_file"},
 			testAioVolumeWithName,
-			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":1,"message":"myopierr"},"result":""}`},
-			codes.Unknown,
-			fmt.Sprintf("bdev_aio_create: %v", "json response error: myopierr"),
+			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":[{"name":"mytest","block_size":512,"num_blocks":12}]}`},
+			codes.OK,
+			"",
 			false,
 		},
 		"valid request with valid SPDK response": {
 			nil,
 			testAioVolumeWithName,
 			testAioVolumeWithName,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":"mytest"}`},
+			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":[{"name":"mytest","block_size":512,"num_blocks":12}]}`},
+			codes.OK,
+			"",
+			false,
+		},
+		"resize after backing file grew": {
+			nil,
+			testAioVolumeWithName,
+			&pb.AioVolume{
+				Name:        testAioVolumeName,
+				BlockSize:   512,
+				BlocksCount: 24,
+				Filename:    "/tmp/aio_bdev_file",
+			},
+			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":[{"name":"mytest","block_size":512,"num_blocks":24}]}`},
 			codes.OK,
 			"",
 			false,
@@ -680,7 +721,7 @@ func TestBackEnd_StatsAioVolume(t *testing.T) {
 				ReadLatencyTicks:  7,
 				WriteLatencyTicks: 8,
 			},
-			[]string{`{"jsonrpc":"2.0","id":%d,"result":{"tick_rate":2490000000,"ticks":18787040917434338,"bdevs":[{"name":"mytest","bytes_read":1,"num_read_ops":2,"bytes_written":3,"num_write_ops":4,"bytes_unmapped":0,"num_unmap_ops":0,"read_latency_ticks":7,"write_latency_ticks":8,"unmap_latency_ticks":0}]}}`},
+			[]string{`{"jsonrpc":"2.0","id":%d,"result":{"tick_rate":2490000000,"ticks":18787040917434338,"bdevs":[{"name":"mytest","bytes_read":1,"num_read_ops":2,"bytes_written":3,"num_write_ops":4,"bytes_unmapped":0,"num_unmap_ops":0,"read_latency_ticks":17430,"write_latency_ticks":19920,"unmap_latency_ticks":0}]}}`},
 			codes.OK,
 			"",
 		},
@@ -6,15 +6,17 @@
 package backend
 
 import (
+	"log"
 	"os"
 
 	"github.com/opiproject/gospdk/spdk"
 	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/keyring"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
 )
 
-// TODO: can we combine all of volume types into a single list?
-//		 maybe create a volume abstraction like bdev in SPDK?
-
 // VolumeParameters contains all BackEnd volume related structures
 type VolumeParameters struct {
 	AioVolumes  map[string]*pb.AioVolume
@@ -22,6 +24,8 @@ type VolumeParameters struct {
 
 	NvmeControllers map[string]*pb.NvmeRemoteController
 	NvmePaths       map[string]*pb.NvmePath
+	NvmeNamespaces  map[string]*pb.NvmeRemoteNamespace
+	UringVolumes    map[string]*pb.AioVolume
 }
 
 // Server contains backend related OPI services
@@ -29,11 +33,22 @@ type Server struct {
 	pb.UnimplementedNvmeRemoteControllerServiceServer
 	pb.UnimplementedNullVolumeServiceServer
 	pb.UnimplementedAioVolumeServiceServer
+	bridgepb.UnimplementedNvmeRemoteControllerOptionsServiceServer
+	bridgepb.UnimplementedNullVolumeMetadataServiceServer
+	bridgepb.UnimplementedUringVolumeServiceServer
+	bridgepb.UnimplementedFaultVolumeServiceServer
 
 	rpc        spdk.JSONRPC
 	Volumes    VolumeParameters
 	Pagination map[string]int
 	psk        psk
+	keyring    *keyring.Keyring
+	dhchap     dhchap
+	reconnect  map[string]*bridgepb.NvmeReconnectOptions
+	aio        aio
+	fault      faultInjection
+	registry   *volume.Registry
+	stats      *server.StatsTracker
 }
 
 type psk struct {
@@ -42,8 +57,11 @@ type psk struct {
 }
 
 // NewServer creates initialized instance of BackEnd server communicating
-// with provided jsonRPC
-func NewServer(jsonRPC spdk.JSONRPC) *Server {
+// with provided jsonRPC. Created volumes are registered in registry
+func NewServer(jsonRPC spdk.JSONRPC, registry *volume.Registry) *Server {
+	if registry == nil {
+		log.Panic("nil for volume.Registry is not allowed")
+	}
 	return &Server{
 		rpc: jsonRPC,
 		Volumes: VolumeParameters{
@@ -51,11 +69,42 @@ func NewServer(jsonRPC spdk.JSONRPC) *Server {
 			NullVolumes:     make(map[string]*pb.NullVolume),
 			NvmeControllers: make(map[string]*pb.NvmeRemoteController),
 			NvmePaths:       make(map[string]*pb.NvmePath),
+			NvmeNamespaces:  make(map[string]*pb.NvmeRemoteNamespace),
+			UringVolumes:    make(map[string]*pb.AioVolume),
 		},
 		Pagination: make(map[string]int),
 		psk: psk{
 			createTempFile: os.CreateTemp,
 			writeKey:       os.WriteFile,
 		},
+		keyring: keyring.NewKeyring(jsonRPC, ""),
+		dhchap: dhchap{
+			keys: make(map[string]*dhchapKeys),
+		},
+		reconnect: make(map[string]*bridgepb.NvmeReconnectOptions),
+		aio: aio{
+			uringBacked: make(map[string]bool),
+		},
+		fault: faultInjection{
+			volumes: make(map[string]*bridgepb.FaultVolume),
+		},
+		registry: registry,
+		stats:    server.NewStatsTracker(),
+	}
+}
+
+// volumeHistogram adds latency percentiles to stats of a registered volume
+// with an enabled histogram
+func (s *Server) volumeHistogram(name string, stats *server.VolumeStats) error {
+	vol, err := s.registry.Get(name)
+	if err != nil || vol.Histogram == "" {
+		return nil
+	}
+	histogram, err := server.GetLatencyHistogram(s.rpc, vol.BdevName)
+	if err != nil {
+		return err
 	}
+	stats.SetHistogram(histogram)
+	log.Printf("Latency of %v %v I/O: %v", vol.BdevName, vol.Histogram, stats.Percentiles)
+	return nil
 }
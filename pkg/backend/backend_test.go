@@ -17,7 +17,9 @@ import (
 
 	"github.com/opiproject/gospdk/spdk"
 	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
 	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
 )
 
 var checkGlobalTestProtoObjectsNotChanged = server.CheckTestProtoObjectsNotChanged(
@@ -25,6 +27,7 @@ var checkGlobalTestProtoObjectsNotChanged = server.CheckTestProtoObjectsNotChang
 	&testNullVolume,
 	&testNvmeCtrl,
 	&testNvmePath,
+	&testNvmeNamespace,
 )
 
 // TODO: move test infrastructure code to a separate (test/server) package to avoid duplication
@@ -33,6 +36,10 @@ type backendClient struct {
 	pb.NvmeRemoteControllerServiceClient
 	pb.NullVolumeServiceClient
 	pb.AioVolumeServiceClient
+	bridgepb.NvmeRemoteControllerOptionsServiceClient
+	bridgepb.UringVolumeServiceClient
+	bridgepb.NullVolumeMetadataServiceClient
+	bridgepb.FaultVolumeServiceClient
 }
 
 type testEnv struct {
@@ -57,7 +64,7 @@ func createTestEnvironment(spdkResponses []string) *testEnv {
 	env := &testEnv{}
 	env.testSocket = server.GenerateSocketName("backend")
 	env.ln, env.jsonRPC = server.CreateTestSpdkServer(env.testSocket, spdkResponses)
-	env.opiSpdkServer = NewServer(env.jsonRPC)
+	env.opiSpdkServer = NewServer(env.jsonRPC, volume.NewRegistry())
 
 	ctx := context.Background()
 	conn, err := grpc.DialContext(ctx,
@@ -75,6 +82,10 @@ func createTestEnvironment(spdkResponses []string) *testEnv {
 		pb.NewNvmeRemoteControllerServiceClient(env.conn),
 		pb.NewNullVolumeServiceClient(env.conn),
 		pb.NewAioVolumeServiceClient(env.conn),
+		bridgepb.NewNvmeRemoteControllerOptionsServiceClient(env.conn),
+		bridgepb.NewUringVolumeServiceClient(env.conn),
+		bridgepb.NewNullVolumeMetadataServiceClient(env.conn),
+		bridgepb.NewFaultVolumeServiceClient(env.conn),
 	}
 
 	return env
@@ -86,6 +97,10 @@ func dialer(opiSpdkServer *Server) func(context.Context, string) (net.Conn, erro
 	pb.RegisterNvmeRemoteControllerServiceServer(server, opiSpdkServer)
 	pb.RegisterNullVolumeServiceServer(server, opiSpdkServer)
 	pb.RegisterAioVolumeServiceServer(server, opiSpdkServer)
+	bridgepb.RegisterFaultVolumeServiceServer(server, opiSpdkServer)
+	bridgepb.RegisterNullVolumeMetadataServiceServer(server, opiSpdkServer)
+	bridgepb.RegisterUringVolumeServiceServer(server, opiSpdkServer)
+	bridgepb.RegisterNvmeRemoteControllerOptionsServiceServer(server, opiSpdkServer)
 
 	go func() {
 		if err := server.Serve(listener); err != nil {
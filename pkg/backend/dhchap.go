@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package backend implements the BackEnd APIs (network facing) of the storage Server
+package backend
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"reflect"
+
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/keyring"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+
+	"go.einride.tech/aip/fieldbehavior"
+	"go.einride.tech/aip/resourcename"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// bdevNvmeSetKeysParams holds the parameters required to re-authenticate
+// an Nvme controller with new DH-HMAC-CHAP keys
+type bdevNvmeSetKeysParams struct {
+	Name           string `json:"name"`
+	DhchapKey      string `json:"dhchap_key,omitempty"`
+	DhchapCtrlrKey string `json:"dhchap_ctrlr_key,omitempty"`
+}
+
+// bdevNvmeSetKeysResult is the result of setting new DH-HMAC-CHAP keys
+type bdevNvmeSetKeysResult bool
+
+// dhchapKeys contains names of keyring keys used by a remote controller
+type dhchapKeys struct {
+	hostKey    string
+	ctrlrKey   string
+	generation int
+}
+
+type dhchap struct {
+	keys     map[string]*dhchapKeys
+	digests  []string
+	dhGroups []string
+}
+
+// SetNvmeRemoteControllerDhchap configures DH-HMAC-CHAP in-band authentication
+// for paths of a remote controller. If the controller already has keys, they
+// are rotated and connected paths are re-authenticated with the new ones.
+// Digests and DH groups are global in SPDK and can be changed only while no
+// paths exist. They are set last, so that a failure to add the keys leaves
+// them unchanged.
+func (s *Server) SetNvmeRemoteControllerDhchap(_ context.Context, in *bridgepb.SetNvmeRemoteControllerDhchapRequest) (*emptypb.Empty, error) {
+	log.Printf("SetNvmeRemoteControllerDhchap: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	controller, ok := s.Volumes.NvmeControllers[in.Name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find NvmeRemoteController by key %s", in.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	settings := keyring.NewDhchap(in.Dhchap)
+	if err := settings.Validate(); err != nil {
+		log.Printf("error: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	optionsChanged := s.dhchapOptionsChanged(settings.Digests, settings.DhGroups)
+	if optionsChanged && len(s.Volumes.NvmePaths) > 0 {
+		msg := "dhchap digests and dh groups cannot be changed while NvmePaths exist"
+		log.Print(msg)
+		return nil, status.Error(codes.FailedPrecondition, msg)
+	}
+
+	resourceID := path.Base(controller.Name)
+	oldKeys := s.dhchap.keys[controller.Name]
+	generation := 0
+	if oldKeys != nil {
+		generation = oldKeys.generation + 1
+	}
+	keys, err := s.addDhchapKeys(resourceID, generation, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.numberOfPathsForController(controller.Name) > 0 {
+		params := bdevNvmeSetKeysParams{
+			Name:           resourceID,
+			DhchapKey:      keys.hostKey,
+			DhchapCtrlrKey: keys.ctrlrKey,
+		}
+		var result bdevNvmeSetKeysResult
+		err := s.rpc.Call("bdev_nvme_set_keys", &params, &result)
+		if err == nil && !result {
+			err = status.Errorf(codes.InvalidArgument, "Could not set dhchap keys for: %s", resourceID)
+		}
+		if err != nil {
+			log.Printf("error: %v", err)
+			s.removeDhchapKeys(keys)
+			return nil, err
+		}
+		log.Printf("Received from SPDK: %v", result)
+	}
+
+	// no paths exist if the options changed, so only the keys are undone
+	if optionsChanged {
+		if err := s.setDhchapOptions(settings.Digests, settings.DhGroups); err != nil {
+			s.removeDhchapKeys(keys)
+			return nil, err
+		}
+	}
+
+	if oldKeys != nil {
+		s.removeDhchapKeys(oldKeys)
+	}
+	s.dhchap.keys[controller.Name] = keys
+	return &emptypb.Empty{}, nil
+}
+
+// ClearNvmeRemoteControllerDhchap removes DH-HMAC-CHAP keys of a remote
+// controller without paths
+func (s *Server) ClearNvmeRemoteControllerDhchap(_ context.Context, in *bridgepb.ClearNvmeRemoteControllerDhchapRequest) (*emptypb.Empty, error) {
+	log.Printf("ClearNvmeRemoteControllerDhchap: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	keys, ok := s.dhchap.keys[in.Name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find dhchap keys for %s", in.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if s.numberOfPathsForController(in.Name) > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "NvmePaths exist for controller")
+	}
+	s.removeDhchapKeys(keys)
+	delete(s.dhchap.keys, in.Name)
+	return &emptypb.Empty{}, nil
+}
+
+// dhchapOptionsChanged tells if digests and DH groups differ from the ones
+// set in SPDK. No digests and DH groups keep the ones set
+func (s *Server) dhchapOptionsChanged(digests []string, dhGroups []string) bool {
+	if len(digests) == 0 && len(dhGroups) == 0 {
+		return false
+	}
+	return !reflect.DeepEqual(digests, s.dhchap.digests) || !reflect.DeepEqual(dhGroups, s.dhchap.dhGroups)
+}
+
+func (s *Server) setDhchapOptions(digests []string, dhGroups []string) error {
+	params := bdevNvmeSetOptionsParams{
+		DhchapDigests:  digests,
+		DhchapDhgroups: dhGroups,
+	}
+	var result bdevNvmeSetOptionsResult
+	err := s.rpc.Call("bdev_nvme_set_options", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := "Could not set dhchap digests and dh groups"
+		log.Print(msg)
+		return status.Error(codes.InvalidArgument, msg)
+	}
+	s.dhchap.digests = append([]string{}, digests...)
+	s.dhchap.dhGroups = append([]string{}, dhGroups...)
+	return nil
+}
+
+func (s *Server) addDhchapKeys(resourceID string, generation int, settings *keyring.Dhchap) (*dhchapKeys, error) {
+	keys := &dhchapKeys{generation: generation}
+
+	hostKey := fmt.Sprintf("%s-dhchap-host-%d", resourceID, generation)
+	if err := s.keyring.Add(hostKey, settings.HostKey); err != nil {
+		return nil, err
+	}
+	keys.hostKey = hostKey
+
+	if len(settings.CtrlrKey) > 0 {
+		ctrlrKey := fmt.Sprintf("%s-dhchap-ctrlr-%d", resourceID, generation)
+		if err := s.keyring.Add(ctrlrKey, settings.CtrlrKey); err != nil {
+			s.removeDhchapKeys(keys)
+			return nil, err
+		}
+		keys.ctrlrKey = ctrlrKey
+	}
+	return keys, nil
+}
+
+func (s *Server) removeDhchapKeys(keys *dhchapKeys) {
+	for _, name := range []string{keys.hostKey, keys.ctrlrKey} {
+		if name == "" {
+			continue
+		}
+		if err := s.keyring.Remove(name); err != nil {
+			log.Printf("error: failed to remove key %v: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,251 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package backend implements the BackEnd APIs (network facing) of the storage Server
+package backend
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+)
+
+var (
+	testDhchapHostKey  = []byte("DHHC-1:00:AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8gISIj:")
+	testDhchapCtrlrKey = []byte("DHHC-1:01:ZGVmZ2hpamtsbW5vcHFyc3R1dnd4eXp7fH1+f4CBgoOEhYaH:")
+)
+
+func TestBackEnd_SetNvmeRemoteControllerDhchap(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	tests := map[string]struct {
+		name       string
+		in         *bridgepb.Dhchap
+		spdk       []string
+		errCode    codes.Code
+		errMsg     string
+		withPath   bool
+		withKeys   bool
+		expectKeys *dhchapKeys
+		removedKey string
+	}{
+		"unknown controller": {
+			name:    server.ResourceIDToVolumeName("unknown"),
+			in:      &bridgepb.Dhchap{HostKey: testDhchapHostKey},
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  "unable to find NvmeRemoteController by key " + server.ResourceIDToVolumeName("unknown"),
+		},
+		"invalid key": {
+			name:    testNvmeCtrlName,
+			in:      &bridgepb.Dhchap{HostKey: []byte("key")},
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  "invalid dhchap host key: expected DHHC-1:xx:<base64>: format",
+		},
+		"host key without paths": {
+			name: testNvmeCtrlName,
+			in:   &bridgepb.Dhchap{HostKey: testDhchapHostKey},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode:    codes.OK,
+			expectKeys: &dhchapKeys{hostKey: testNvmeCtrlID + "-dhchap-host-0"},
+		},
+		"bidirectional keys with digests": {
+			name: testNvmeCtrlName,
+			in: &bridgepb.Dhchap{
+				HostKey:  testDhchapHostKey,
+				CtrlrKey: testDhchapCtrlrKey,
+				Digests:  []string{"sha512"},
+				DhGroups: []string{"ffdhe4096"},
+			},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode: codes.OK,
+			expectKeys: &dhchapKeys{
+				hostKey:  testNvmeCtrlID + "-dhchap-host-0",
+				ctrlrKey: testNvmeCtrlID + "-dhchap-ctrlr-0",
+			},
+		},
+		"digests failure removes keys": {
+			name: testNvmeCtrlName,
+			in: &bridgepb.Dhchap{
+				HostKey: testDhchapHostKey,
+				Digests: []string{"sha512"},
+			},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":false}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode:    codes.InvalidArgument,
+			errMsg:     "Could not set dhchap digests and dh groups",
+			removedKey: testNvmeCtrlID + "-dhchap-host-0",
+		},
+		"digests cannot be changed with paths": {
+			name: testNvmeCtrlName,
+			in: &bridgepb.Dhchap{
+				HostKey: testDhchapHostKey,
+				Digests: []string{"sha512"},
+			},
+			spdk:     []string{},
+			withPath: true,
+			errCode:  codes.FailedPrecondition,
+			errMsg:   "dhchap digests and dh groups cannot be changed while NvmePaths exist",
+		},
+		"rotate keys of connected paths": {
+			name: testNvmeCtrlName,
+			in:   &bridgepb.Dhchap{HostKey: testDhchapHostKey},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			withPath:   true,
+			withKeys:   true,
+			errCode:    codes.OK,
+			expectKeys: &dhchapKeys{hostKey: testNvmeCtrlID + "-dhchap-host-1", generation: 1},
+		},
+		"rotation failure keeps old keys": {
+			name: testNvmeCtrlName,
+			in:   &bridgepb.Dhchap{HostKey: testDhchapHostKey},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":false}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			withPath:   true,
+			withKeys:   true,
+			errCode:    codes.InvalidArgument,
+			errMsg:     "Could not set dhchap keys for: " + testNvmeCtrlID,
+			expectKeys: &dhchapKeys{hostKey: testNvmeCtrlID + "-dhchap-host-0"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			testEnv.opiSpdkServer.Volumes.NvmeControllers[testNvmeCtrlName] = server.ProtoClone(&testNvmeCtrl)
+			testEnv.opiSpdkServer.Volumes.NvmeControllers[testNvmeCtrlName].Name = testNvmeCtrlName
+			if tt.withPath {
+				testEnv.opiSpdkServer.Volumes.NvmePaths[testNvmePathName] = server.ProtoClone(&testNvmePath)
+			}
+			if tt.withKeys {
+				// first SPDK response is consumed by previously configured key
+				if err := testEnv.opiSpdkServer.keyring.Add(testNvmeCtrlID+"-dhchap-host-0", testDhchapHostKey); err != nil {
+					t.Fatal(err)
+				}
+				testEnv.opiSpdkServer.dhchap.keys[testNvmeCtrlName] = &dhchapKeys{hostKey: testNvmeCtrlID + "-dhchap-host-0"}
+			}
+
+			request := &bridgepb.SetNvmeRemoteControllerDhchapRequest{Name: tt.name, Dhchap: tt.in}
+			_, err := testEnv.client.SetNvmeRemoteControllerDhchap(testEnv.ctx, request)
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+
+			keys := testEnv.opiSpdkServer.dhchap.keys[testNvmeCtrlName]
+			if tt.expectKeys != nil && (keys == nil || *keys != *tt.expectKeys) {
+				t.Error("keys: expected", tt.expectKeys, "received", keys)
+			}
+			if tt.expectKeys == nil && keys != nil {
+				t.Error("keys: expected none, received", keys)
+			}
+			if tt.removedKey != "" && testEnv.opiSpdkServer.keyring.Contains(tt.removedKey) {
+				t.Error("keyring: expected", tt.removedKey, "to be removed")
+			}
+		})
+	}
+}
+
+func TestBackEnd_ClearNvmeRemoteControllerDhchap(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	tests := map[string]struct {
+		name     string
+		spdk     []string
+		errCode  codes.Code
+		errMsg   string
+		withPath bool
+	}{
+		"unknown keys": {
+			name:    server.ResourceIDToVolumeName("unknown"),
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode: codes.NotFound,
+			errMsg:  "unable to find dhchap keys for " + server.ResourceIDToVolumeName("unknown"),
+		},
+		"paths exist": {
+			name:     testNvmeCtrlName,
+			spdk:     []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode:  codes.FailedPrecondition,
+			errMsg:   "NvmePaths exist for controller",
+			withPath: true,
+		},
+		"no required field": {
+			name:    "",
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode: codes.Unknown,
+			errMsg:  "missing required field: name",
+		},
+		"valid request": {
+			name: testNvmeCtrlName,
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode: codes.OK,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			testEnv.opiSpdkServer.Volumes.NvmeControllers[testNvmeCtrlName] = server.ProtoClone(&testNvmeCtrl)
+			testEnv.opiSpdkServer.Volumes.NvmeControllers[testNvmeCtrlName].Name = testNvmeCtrlName
+			if tt.withPath {
+				testEnv.opiSpdkServer.Volumes.NvmePaths[testNvmePathName] = server.ProtoClone(&testNvmePath)
+			}
+			// first SPDK response is consumed by previously configured key
+			if err := testEnv.opiSpdkServer.keyring.Add(testNvmeCtrlID+"-dhchap-host-0", testDhchapHostKey); err != nil {
+				t.Fatal(err)
+			}
+			testEnv.opiSpdkServer.dhchap.keys[testNvmeCtrlName] = &dhchapKeys{hostKey: testNvmeCtrlID + "-dhchap-host-0"}
+
+			request := &bridgepb.ClearNvmeRemoteControllerDhchapRequest{Name: tt.name}
+			_, err := testEnv.client.ClearNvmeRemoteControllerDhchap(testEnv.ctx, request)
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+
+			_, ok := testEnv.opiSpdkServer.dhchap.keys[testNvmeCtrlName]
+			if ok == (tt.errCode == codes.OK) {
+				t.Error("unexpected dhchap keys presence", ok)
+			}
+		})
+	}
+}
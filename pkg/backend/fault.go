@@ -0,0 +1,504 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package backend implements the BackEnd APIs (network facing) of the storage Server
+package backend
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
+
+	"go.einride.tech/aip/fieldbehavior"
+	"go.einride.tech/aip/resourceid"
+	"go.einride.tech/aip/resourcename"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// errorBdevPrefix is prepended by SPDK to the base bdev name to form the
+// name of an error bdev
+const errorBdevPrefix = "EE_"
+
+// faultInjection keeps track of volumes injecting faults. The methods are
+// meant for resilience testing and are disabled unless enabled at startup
+type faultInjection struct {
+	enabled bool
+	volumes map[string]*bridgepb.FaultVolume
+}
+
+// bdevErrorCreateParams holds the parameters required to create an error Block Device
+type bdevErrorCreateParams struct {
+	BaseName string `json:"base_name"`
+}
+
+// bdevErrorCreateResult is the result of creating an error Block Device
+type bdevErrorCreateResult bool
+
+// bdevErrorDeleteParams holds the parameters required to delete an error Block Device
+type bdevErrorDeleteParams struct {
+	Name string `json:"name"`
+}
+
+// bdevErrorDeleteResult is the result of deleting an error Block Device
+type bdevErrorDeleteResult bool
+
+// bdevErrorInjectErrorParams holds the parameters required to inject errors
+// into an error Block Device
+type bdevErrorInjectErrorParams struct {
+	Name      string `json:"name"`
+	IoType    string `json:"io_type"`
+	ErrorType string `json:"error_type"`
+	Num       uint32 `json:"num"`
+}
+
+// bdevErrorInjectErrorResult is the result of injecting errors into an error Block Device
+type bdevErrorInjectErrorResult bool
+
+// bdevDelayCreateParams holds the parameters required to create a delay Block Device
+type bdevDelayCreateParams struct {
+	BaseBdevName    string `json:"base_bdev_name"`
+	Name            string `json:"name"`
+	AvgReadLatency  int64  `json:"avg_read_latency"`
+	P99ReadLatency  int64  `json:"p99_read_latency"`
+	AvgWriteLatency int64  `json:"avg_write_latency"`
+	P99WriteLatency int64  `json:"p99_write_latency"`
+}
+
+// bdevDelayCreateResult is the result of creating a delay Block Device
+type bdevDelayCreateResult string
+
+// bdevDelayDeleteParams holds the parameters required to delete a delay Block Device
+type bdevDelayDeleteParams struct {
+	Name string `json:"name"`
+}
+
+// bdevDelayDeleteResult is the result of deleting a delay Block Device
+type bdevDelayDeleteResult bool
+
+// bdevDelayUpdateLatencyParams holds the parameters required to change
+// latency of a delay Block Device
+type bdevDelayUpdateLatencyParams struct {
+	DelayBdevName string `json:"delay_bdev_name"`
+	LatencyType   string `json:"latency_type"`
+	LatencyUs     int64  `json:"latency_us"`
+}
+
+// bdevDelayUpdateLatencyResult is the result of changing latency of a delay Block Device
+type bdevDelayUpdateLatencyResult bool
+
+// faultVolumeTypes maps kinds of fault volumes to kinds of bdevs
+var faultVolumeTypes = map[bridgepb.FaultVolumeType]volume.Type{
+	bridgepb.FaultVolumeType_FAULT_VOLUME_TYPE_ERROR: volume.TypeError,
+	bridgepb.FaultVolumeType_FAULT_VOLUME_TYPE_DELAY: volume.TypeDelay,
+}
+
+// faultIoTypes maps I/O types to I/O types supported by SPDK error bdevs
+var faultIoTypes = map[bridgepb.FaultIoType]string{
+	bridgepb.FaultIoType_FAULT_IO_TYPE_ALL:   "all",
+	bridgepb.FaultIoType_FAULT_IO_TYPE_READ:  "read",
+	bridgepb.FaultIoType_FAULT_IO_TYPE_WRITE: "write",
+	bridgepb.FaultIoType_FAULT_IO_TYPE_UNMAP: "unmap",
+	bridgepb.FaultIoType_FAULT_IO_TYPE_FLUSH: "flush",
+	bridgepb.FaultIoType_FAULT_IO_TYPE_CLEAR: "clear",
+}
+
+// faultErrorTypes maps error types to error types supported by SPDK error bdevs
+var faultErrorTypes = map[bridgepb.FaultErrorType]string{
+	bridgepb.FaultErrorType_FAULT_ERROR_TYPE_FAILURE: "failure",
+	bridgepb.FaultErrorType_FAULT_ERROR_TYPE_PENDING: "pending",
+}
+
+// verifyFaultLatency checks latency the same way SPDK does
+func verifyFaultLatency(l *bridgepb.FaultLatency) error {
+	switch {
+	case l.GetAvgReadUs() < 0 || l.GetP99ReadUs() < 0 || l.GetAvgWriteUs() < 0 || l.GetP99WriteUs() < 0:
+		return fmt.Errorf("latency cannot be negative")
+	case l.GetP99ReadUs() < l.GetAvgReadUs():
+		return fmt.Errorf("p99 read latency %d is less than average read latency %d", l.GetP99ReadUs(), l.GetAvgReadUs())
+	case l.GetP99WriteUs() < l.GetAvgWriteUs():
+		return fmt.Errorf("p99 write latency %d is less than average write latency %d", l.GetP99WriteUs(), l.GetAvgWriteUs())
+	}
+	return nil
+}
+
+// EnableFaultInjection allows creating volumes which fail or delay I/O
+func (s *Server) EnableFaultInjection() {
+	s.fault.enabled = true
+}
+
+func (s *Server) checkFaultInjectionEnabled() error {
+	if !s.fault.enabled {
+		err := status.Error(codes.Unimplemented, "fault injection is disabled")
+		log.Printf("error: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (s *Server) getFaultVolume(name string) (*bridgepb.FaultVolume, error) {
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	faultVolume, ok := s.fault.volumes[name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	return faultVolume, nil
+}
+
+// CreateFaultVolume creates an error or a delay volume on top of a
+// registered volume. Created volume can be referenced as any other volume
+func (s *Server) CreateFaultVolume(_ context.Context, in *bridgepb.CreateFaultVolumeRequest) (*bridgepb.FaultVolume, error) {
+	log.Printf("CreateFaultVolume: Received from client: %v", server.Redact(in))
+	if err := s.checkFaultInjectionEnabled(); err != nil {
+		return nil, err
+	}
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// see https://google.aip.dev/133#user-specified-ids
+	resourceID := resourceid.NewSystemGenerated()
+	if in.FaultVolumeId != "" {
+		err := resourceid.ValidateUserSettable(in.FaultVolumeId)
+		if err != nil {
+			log.Printf("error: %v", err)
+			return nil, err
+		}
+		log.Printf("client provided the ID of a resource %v, ignoring the name field %v", in.FaultVolumeId, in.FaultVolume.Name)
+		resourceID = in.FaultVolumeId
+	}
+	name := server.ResourceIDToVolumeName(resourceID)
+	// idempotent API when called with same key, should return same object
+	if faultVolume, ok := s.fault.volumes[name]; ok {
+		log.Printf("Already existing FaultVolume with id %v", name)
+		return server.ProtoClone(faultVolume), nil
+	}
+	volumeType, ok := faultVolumeTypes[in.FaultVolume.Type]
+	if !ok {
+		err := status.Errorf(codes.InvalidArgument, "fault volume type %v is not supported", in.FaultVolume.Type)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	base, err := s.registry.Get(in.FaultVolume.VolumeNameRef)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	response := server.ProtoClone(in.FaultVolume)
+	response.Name = name
+	var bdevName string
+	switch response.Type {
+	case bridgepb.FaultVolumeType_FAULT_VOLUME_TYPE_ERROR:
+		response.Latency = nil
+		bdevName, err = s.createErrorBdev(base.BdevName)
+	default:
+		if response.Latency == nil {
+			response.Latency = &bridgepb.FaultLatency{}
+		}
+		bdevName, err = s.createDelayBdev(base.BdevName, resourceID, response.Latency)
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.fault.volumes[name] = response
+	err = s.registry.Register(volume.Volume{
+		Name:        name,
+		BdevName:    bdevName,
+		Type:        volumeType,
+		Owner:       bridgepb.FaultVolumeService_ServiceDesc.ServiceName,
+		BlockSize:   base.BlockSize,
+		BlocksCount: base.BlocksCount,
+	})
+	if err != nil {
+		log.Printf("error: %v", err)
+	}
+	if _, err := s.registry.Acquire(base.Name, name); err != nil {
+		log.Printf("error: %v", err)
+	}
+	log.Printf("CreateFaultVolume: Sending to client: %v", server.Redact(response))
+	return server.ProtoClone(response), nil
+}
+
+func (s *Server) createErrorBdev(baseBdevName string) (string, error) {
+	params := bdevErrorCreateParams{
+		BaseName: baseBdevName,
+	}
+	var result bdevErrorCreateResult
+	err := s.rpc.Call("bdev_error_create", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return "", err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not create Error Dev: %s", params.BaseName)
+		log.Print(msg)
+		return "", status.Errorf(codes.InvalidArgument, msg)
+	}
+	return errorBdevPrefix + baseBdevName, nil
+}
+
+func (s *Server) createDelayBdev(baseBdevName string, name string, latency *bridgepb.FaultLatency) (string, error) {
+	if err := verifyFaultLatency(latency); err != nil {
+		log.Printf("error: %v", err)
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	params := bdevDelayCreateParams{
+		BaseBdevName:    baseBdevName,
+		Name:            name,
+		AvgReadLatency:  latency.AvgReadUs,
+		P99ReadLatency:  latency.P99ReadUs,
+		AvgWriteLatency: latency.AvgWriteUs,
+		P99WriteLatency: latency.P99WriteUs,
+	}
+	var result bdevDelayCreateResult
+	err := s.rpc.Call("bdev_delay_create", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return "", err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if result == "" {
+		msg := fmt.Sprintf("Could not create Delay Dev: %s", params.Name)
+		log.Print(msg)
+		return "", status.Errorf(codes.InvalidArgument, msg)
+	}
+	return string(result), nil
+}
+
+// DeleteFaultVolume deletes an error or a delay volume. The volume
+// receiving I/O is kept
+func (s *Server) DeleteFaultVolume(_ context.Context, in *bridgepb.DeleteFaultVolumeRequest) (*emptypb.Empty, error) {
+	log.Printf("DeleteFaultVolume: Received from client: %v", server.Redact(in))
+	if err := s.checkFaultInjectionEnabled(); err != nil {
+		return nil, err
+	}
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	name := in.Name
+	faultVolume, err := s.getFaultVolume(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.registry.CheckUnused(name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	vol, err := s.registry.Get(name)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	var ok bool
+	switch faultVolume.Type {
+	case bridgepb.FaultVolumeType_FAULT_VOLUME_TYPE_ERROR:
+		params := bdevErrorDeleteParams{Name: vol.BdevName}
+		var result bdevErrorDeleteResult
+		err = s.rpc.Call("bdev_error_delete", &params, &result)
+		ok = bool(result)
+	default:
+		params := bdevDelayDeleteParams{Name: vol.BdevName}
+		var result bdevDelayDeleteResult
+		err = s.rpc.Call("bdev_delay_delete", &params, &result)
+		ok = bool(result)
+	}
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", ok)
+	if !ok {
+		msg := fmt.Sprintf("Could not delete Fault Dev: %s", vol.BdevName)
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	delete(s.fault.volumes, name)
+	s.unregisterVolume(name)
+	s.registry.Release(faultVolume.VolumeNameRef, name)
+	return &emptypb.Empty{}, nil
+}
+
+// GetFaultVolume returns an error or a delay volume
+func (s *Server) GetFaultVolume(_ context.Context, in *bridgepb.GetFaultVolumeRequest) (*bridgepb.FaultVolume, error) {
+	log.Printf("GetFaultVolume: Received from client: %v", server.Redact(in))
+	if err := s.checkFaultInjectionEnabled(); err != nil {
+		return nil, err
+	}
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	faultVolume, err := s.getFaultVolume(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	return server.ProtoClone(faultVolume), nil
+}
+
+// InjectFaultVolumeError makes an error volume fail next I/O of the given
+// type. FAULT_IO_TYPE_CLEAR stops failing I/O
+func (s *Server) InjectFaultVolumeError(_ context.Context, in *bridgepb.InjectFaultVolumeErrorRequest) (*emptypb.Empty, error) {
+	log.Printf("InjectFaultVolumeError: Received from client: %v", server.Redact(in))
+	if err := s.checkFaultInjectionEnabled(); err != nil {
+		return nil, err
+	}
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	faultVolume, err := s.getFaultVolume(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	if faultVolume.Type != bridgepb.FaultVolumeType_FAULT_VOLUME_TYPE_ERROR {
+		err := status.Errorf(codes.FailedPrecondition, "volume %v does not inject errors", in.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	ioType, ok := faultIoTypes[in.IoType]
+	if !ok {
+		err := status.Errorf(codes.InvalidArgument, "io type %v is not supported", in.IoType)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	errorType, ok := faultErrorTypes[in.ErrorType]
+	if !ok {
+		err := status.Errorf(codes.InvalidArgument, "error type %v is not supported", in.ErrorType)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	vol, err := s.registry.Get(in.Name)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	params := bdevErrorInjectErrorParams{
+		Name:      vol.BdevName,
+		IoType:    ioType,
+		ErrorType: errorType,
+		Num:       in.Count,
+	}
+	if params.Num == 0 {
+		params.Num = 1
+	}
+	var result bdevErrorInjectErrorResult
+	err = s.rpc.Call("bdev_error_inject_error", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not inject error into Error Dev: %s", params.Name)
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// UpdateFaultVolumeLatency changes latency added by a delay volume. Only
+// changed values are sent to SPDK, ordered so that p99 latency never gets
+// below average latency
+func (s *Server) UpdateFaultVolumeLatency(_ context.Context, in *bridgepb.UpdateFaultVolumeLatencyRequest) (*bridgepb.FaultVolume, error) {
+	log.Printf("UpdateFaultVolumeLatency: Received from client: %v", server.Redact(in))
+	if err := s.checkFaultInjectionEnabled(); err != nil {
+		return nil, err
+	}
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	faultVolume, err := s.getFaultVolume(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	if faultVolume.Type != bridgepb.FaultVolumeType_FAULT_VOLUME_TYPE_DELAY {
+		err := status.Errorf(codes.FailedPrecondition, "volume %v does not add latency", in.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if err := verifyFaultLatency(in.Latency); err != nil {
+		log.Printf("error: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	vol, err := s.registry.Get(in.Name)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	current := faultVolume.Latency
+	latency := in.Latency
+	updates := append(
+		latencyUpdates("read", &current.AvgReadUs, &current.P99ReadUs, latency.AvgReadUs, latency.P99ReadUs),
+		latencyUpdates("write", &current.AvgWriteUs, &current.P99WriteUs, latency.AvgWriteUs, latency.P99WriteUs)...,
+	)
+	for _, update := range updates {
+		params := bdevDelayUpdateLatencyParams{
+			DelayBdevName: vol.BdevName,
+			LatencyType:   update.latencyType,
+			LatencyUs:     update.value,
+		}
+		var result bdevDelayUpdateLatencyResult
+		err := s.rpc.Call("bdev_delay_update_latency", &params, &result)
+		if err != nil {
+			log.Printf("error: %v", err)
+			return nil, err
+		}
+		log.Printf("Received from SPDK: %v", result)
+		if !result {
+			msg := fmt.Sprintf("Could not update %s latency of Delay Dev: %s", params.LatencyType, params.DelayBdevName)
+			log.Print(msg)
+			return nil, status.Errorf(codes.InvalidArgument, msg)
+		}
+		// keep what SPDK has applied if a later update fails
+		*update.current = update.value
+	}
+	response := server.ProtoClone(faultVolume)
+	log.Printf("UpdateFaultVolumeLatency: Sending to client: %v", server.Redact(response))
+	return response, nil
+}
+
+type latencyUpdate struct {
+	latencyType string
+	current     *int64
+	value       int64
+}
+
+// latencyUpdates lists changes of average and p99 latency of one direction.
+// SPDK rejects average latency above p99 latency, so p99 latency is raised
+// first and lowered last
+func latencyUpdates(direction string, avg *int64, p99 *int64, newAvg int64, newP99 int64) []latencyUpdate {
+	avgUpdate := latencyUpdate{latencyType: "avg_" + direction, current: avg, value: newAvg}
+	p99Update := latencyUpdate{latencyType: "p99_" + direction, current: p99, value: newP99}
+	var updates []latencyUpdate
+	if newAvg > *p99 {
+		updates = []latencyUpdate{p99Update, avgUpdate}
+	} else {
+		updates = []latencyUpdate{avgUpdate, p99Update}
+	}
+	changed := updates[:0]
+	for _, update := range updates {
+		if *update.current != update.value {
+			changed = append(changed, update)
+		}
+	}
+	return changed
+}
@@ -0,0 +1,469 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package backend implements the BackEnd APIs (network facing) of the storage Server
+package backend
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
+)
+
+var (
+	testFaultVolumeID   = "mytest-fault"
+	testFaultVolumeName = server.ResourceIDToVolumeName(testFaultVolumeID)
+	testErrorVolume     = bridgepb.FaultVolume{
+		Name:          testFaultVolumeName,
+		Type:          bridgepb.FaultVolumeType_FAULT_VOLUME_TYPE_ERROR,
+		VolumeNameRef: testAioVolumeName,
+	}
+	testDelayVolume = bridgepb.FaultVolume{
+		Name:          testFaultVolumeName,
+		Type:          bridgepb.FaultVolumeType_FAULT_VOLUME_TYPE_DELAY,
+		VolumeNameRef: testAioVolumeName,
+		Latency:       &bridgepb.FaultLatency{AvgReadUs: 100, P99ReadUs: 200, AvgWriteUs: 300, P99WriteUs: 400},
+	}
+)
+
+func createTestFaultEnvironment(spdk []string, faultVolume *bridgepb.FaultVolume) *testEnv {
+	testEnv := createTestEnvironment(spdk)
+	testEnv.opiSpdkServer.EnableFaultInjection()
+	testEnv.opiSpdkServer.registerVolume(testAioVolumeName, volume.TypeAio, pb.AioVolumeService_ServiceDesc.ServiceName, 512, 12)
+	if faultVolume != nil {
+		fv := server.ProtoClone(faultVolume)
+		testEnv.opiSpdkServer.fault.volumes[testFaultVolumeName] = fv
+		bdevName := testFaultVolumeID
+		if fv.Type == bridgepb.FaultVolumeType_FAULT_VOLUME_TYPE_ERROR {
+			bdevName = errorBdevPrefix + testAioVolumeID
+		}
+		_ = testEnv.opiSpdkServer.registry.Register(volume.Volume{
+			Name:     testFaultVolumeName,
+			BdevName: bdevName,
+			Type:     faultVolumeTypes[fv.Type],
+			Owner:    bridgepb.FaultVolumeService_ServiceDesc.ServiceName,
+		})
+		_, _ = testEnv.opiSpdkServer.registry.Acquire(testAioVolumeName, testFaultVolumeName)
+	}
+	return testEnv
+}
+
+func TestBackEnd_CreateFaultVolume(t *testing.T) {
+	tests := map[string]struct {
+		in       *bridgepb.FaultVolume
+		out      *bridgepb.FaultVolume
+		disabled bool
+		spdk     []string
+		errCode  codes.Code
+		errMsg   string
+		bdevName string
+	}{
+		"fault injection disabled": {
+			in:       &testErrorVolume,
+			out:      nil,
+			disabled: true,
+			spdk:     []string{},
+			errCode:  codes.Unimplemented,
+			errMsg:   "fault injection is disabled",
+		},
+		"unknown volume": {
+			in:      &bridgepb.FaultVolume{Type: bridgepb.FaultVolumeType_FAULT_VOLUME_TYPE_ERROR, VolumeNameRef: "unknown"},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  "unable to find volume unknown",
+		},
+		"missing volume": {
+			in:      &bridgepb.FaultVolume{Type: bridgepb.FaultVolumeType_FAULT_VOLUME_TYPE_ERROR},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.Unknown,
+			errMsg:  "missing required field: fault_volume.volume_name_ref",
+		},
+		"missing type": {
+			in:      &bridgepb.FaultVolume{VolumeNameRef: testAioVolumeName},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.Unknown,
+			errMsg:  "missing required field: fault_volume.type",
+		},
+		"unsupported type": {
+			in:      &bridgepb.FaultVolume{Type: 7, VolumeNameRef: testAioVolumeName},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  "fault volume type 7 is not supported",
+		},
+		"error volume with invalid SPDK response": {
+			in:      &testErrorVolume,
+			out:     nil,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("Could not create Error Dev: %v", testAioVolumeID),
+		},
+		"error volume with error code from SPDK response": {
+			in:      &testErrorVolume,
+			out:     nil,
+			spdk:    []string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":false}`},
+			errCode: codes.Unknown,
+			errMsg:  fmt.Sprintf("bdev_error_create: %v", "json response error: myopierr"),
+		},
+		"error volume": {
+			in:       &testErrorVolume,
+			out:      &testErrorVolume,
+			spdk:     []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode:  codes.OK,
+			errMsg:   "",
+			bdevName: errorBdevPrefix + testAioVolumeID,
+		},
+		"delay volume with invalid latency": {
+			in:      &bridgepb.FaultVolume{Type: bridgepb.FaultVolumeType_FAULT_VOLUME_TYPE_DELAY, VolumeNameRef: testAioVolumeName, Latency: &bridgepb.FaultLatency{AvgReadUs: 200, P99ReadUs: 100}},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  "p99 read latency 100 is less than average read latency 200",
+		},
+		"delay volume with invalid SPDK response": {
+			in:      &testDelayVolume,
+			out:     nil,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":""}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("Could not create Delay Dev: %v", testFaultVolumeID),
+		},
+		"delay volume": {
+			in:       &testDelayVolume,
+			out:      &testDelayVolume,
+			spdk:     []string{`{"id":%d,"error":{"code":0,"message":""},"result":"mytest-fault"}`},
+			errCode:  codes.OK,
+			errMsg:   "",
+			bdevName: testFaultVolumeID,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestFaultEnvironment(tt.spdk, nil)
+			defer testEnv.Close()
+			testEnv.opiSpdkServer.fault.enabled = !tt.disabled
+
+			request := &bridgepb.CreateFaultVolumeRequest{FaultVolume: tt.in, FaultVolumeId: testFaultVolumeID}
+			response, err := testEnv.client.CreateFaultVolume(testEnv.ctx, request)
+
+			if !proto.Equal(response, tt.out) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+
+			if tt.bdevName != "" {
+				vol, err := testEnv.opiSpdkServer.registry.Get(testFaultVolumeID)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if vol.BdevName != tt.bdevName || vol.BlocksCount != 12 {
+					t.Error("expected registered volume with bdev", tt.bdevName, "received", vol)
+				}
+				base, _ := testEnv.opiSpdkServer.registry.Get(testAioVolumeName)
+				if !reflect.DeepEqual(base.Users, []string{testFaultVolumeName}) {
+					t.Error("expected base volume used by", testFaultVolumeName, "received", base.Users)
+				}
+			}
+		})
+	}
+}
+
+func TestBackEnd_DeleteFaultVolume(t *testing.T) {
+	tests := map[string]struct {
+		in          string
+		faultVolume *bridgepb.FaultVolume
+		inUse       bool
+		spdk        []string
+		errCode     codes.Code
+		errMsg      string
+	}{
+		"unknown key": {
+			in:          server.ResourceIDToVolumeName("unknown-id"),
+			faultVolume: &testErrorVolume,
+			spdk:        []string{},
+			errCode:     codes.NotFound,
+			errMsg:      fmt.Sprintf("unable to find key %v", server.ResourceIDToVolumeName("unknown-id")),
+		},
+		"volume in use": {
+			in:          testFaultVolumeName,
+			faultVolume: &testErrorVolume,
+			inUse:       true,
+			spdk:        []string{},
+			errCode:     codes.FailedPrecondition,
+			errMsg:      fmt.Sprintf("volume %v is in use by [namespace]", testFaultVolumeName),
+		},
+		"error volume with invalid SPDK response": {
+			in:          testFaultVolumeName,
+			faultVolume: &testErrorVolume,
+			spdk:        []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			errCode:     codes.InvalidArgument,
+			errMsg:      fmt.Sprintf("Could not delete Fault Dev: %v", errorBdevPrefix+testAioVolumeID),
+		},
+		"error volume": {
+			in:          testFaultVolumeName,
+			faultVolume: &testErrorVolume,
+			spdk:        []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode:     codes.OK,
+			errMsg:      "",
+		},
+		"delay volume with error code from SPDK response": {
+			in:          testFaultVolumeName,
+			faultVolume: &testDelayVolume,
+			spdk:        []string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":false}`},
+			errCode:     codes.Unknown,
+			errMsg:      fmt.Sprintf("bdev_delay_delete: %v", "json response error: myopierr"),
+		},
+		"delay volume": {
+			in:          testFaultVolumeName,
+			faultVolume: &testDelayVolume,
+			spdk:        []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode:     codes.OK,
+			errMsg:      "",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestFaultEnvironment(tt.spdk, tt.faultVolume)
+			defer testEnv.Close()
+			if tt.inUse {
+				_, _ = testEnv.opiSpdkServer.registry.Acquire(testFaultVolumeName, "namespace")
+			}
+
+			request := &bridgepb.DeleteFaultVolumeRequest{Name: tt.in}
+			_, err := testEnv.client.DeleteFaultVolume(testEnv.ctx, request)
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+
+			base, _ := testEnv.opiSpdkServer.registry.Get(testAioVolumeName)
+			if released := len(base.Users) == 0; released != (tt.errCode == codes.OK) {
+				t.Error("unexpected users of base volume", base.Users)
+			}
+		})
+	}
+}
+
+func TestBackEnd_InjectFaultVolumeError(t *testing.T) {
+	tests := map[string]struct {
+		faultVolume *bridgepb.FaultVolume
+		in          *bridgepb.InjectFaultVolumeErrorRequest
+		spdk        []string
+		errCode     codes.Code
+		errMsg      string
+	}{
+		"not an error volume": {
+			faultVolume: &testDelayVolume,
+			in:          &bridgepb.InjectFaultVolumeErrorRequest{Name: testFaultVolumeName, IoType: bridgepb.FaultIoType_FAULT_IO_TYPE_READ, ErrorType: bridgepb.FaultErrorType_FAULT_ERROR_TYPE_FAILURE},
+			spdk:        []string{},
+			errCode:     codes.FailedPrecondition,
+			errMsg:      fmt.Sprintf("volume %v does not inject errors", testFaultVolumeName),
+		},
+		"unsupported io type": {
+			faultVolume: &testErrorVolume,
+			in:          &bridgepb.InjectFaultVolumeErrorRequest{Name: testFaultVolumeName, IoType: 9, ErrorType: bridgepb.FaultErrorType_FAULT_ERROR_TYPE_FAILURE},
+			spdk:        []string{},
+			errCode:     codes.InvalidArgument,
+			errMsg:      "io type 9 is not supported",
+		},
+		"unsupported error type": {
+			faultVolume: &testErrorVolume,
+			in:          &bridgepb.InjectFaultVolumeErrorRequest{Name: testFaultVolumeName, IoType: bridgepb.FaultIoType_FAULT_IO_TYPE_WRITE, ErrorType: 9},
+			spdk:        []string{},
+			errCode:     codes.InvalidArgument,
+			errMsg:      "error type 9 is not supported",
+		},
+		"missing io type": {
+			faultVolume: &testErrorVolume,
+			in:          &bridgepb.InjectFaultVolumeErrorRequest{Name: testFaultVolumeName, ErrorType: bridgepb.FaultErrorType_FAULT_ERROR_TYPE_FAILURE},
+			spdk:        []string{},
+			errCode:     codes.Unknown,
+			errMsg:      "missing required field: io_type",
+		},
+		"valid request with invalid SPDK response": {
+			faultVolume: &testErrorVolume,
+			in:          &bridgepb.InjectFaultVolumeErrorRequest{Name: testFaultVolumeName, IoType: bridgepb.FaultIoType_FAULT_IO_TYPE_WRITE, ErrorType: bridgepb.FaultErrorType_FAULT_ERROR_TYPE_FAILURE, Count: 5},
+			spdk:        []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			errCode:     codes.InvalidArgument,
+			errMsg:      fmt.Sprintf("Could not inject error into Error Dev: %v", errorBdevPrefix+testAioVolumeID),
+		},
+		"valid request with error code from SPDK response": {
+			faultVolume: &testErrorVolume,
+			in:          &bridgepb.InjectFaultVolumeErrorRequest{Name: testFaultVolumeName, IoType: bridgepb.FaultIoType_FAULT_IO_TYPE_WRITE, ErrorType: bridgepb.FaultErrorType_FAULT_ERROR_TYPE_FAILURE, Count: 5},
+			spdk:        []string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":false}`},
+			errCode:     codes.Unknown,
+			errMsg:      fmt.Sprintf("bdev_error_inject_error: %v", "json response error: myopierr"),
+		},
+		"valid request": {
+			faultVolume: &testErrorVolume,
+			in:          &bridgepb.InjectFaultVolumeErrorRequest{Name: testFaultVolumeName, IoType: bridgepb.FaultIoType_FAULT_IO_TYPE_CLEAR, ErrorType: bridgepb.FaultErrorType_FAULT_ERROR_TYPE_PENDING},
+			spdk:        []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode:     codes.OK,
+			errMsg:      "",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestFaultEnvironment(tt.spdk, tt.faultVolume)
+			defer testEnv.Close()
+
+			_, err := testEnv.client.InjectFaultVolumeError(testEnv.ctx, tt.in)
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
+
+func TestBackEnd_UpdateFaultVolumeLatency(t *testing.T) {
+	tests := map[string]struct {
+		faultVolume *bridgepb.FaultVolume
+		in          *bridgepb.FaultLatency
+		out         *bridgepb.FaultLatency
+		spdk        []string
+		errCode     codes.Code
+		errMsg      string
+	}{
+		"not a delay volume": {
+			faultVolume: &testErrorVolume,
+			in:          testDelayVolume.Latency,
+			out:         nil,
+			spdk:        []string{},
+			errCode:     codes.FailedPrecondition,
+			errMsg:      fmt.Sprintf("volume %v does not add latency", testFaultVolumeName),
+		},
+		"negative latency": {
+			faultVolume: &testDelayVolume,
+			in:          &bridgepb.FaultLatency{AvgReadUs: -1},
+			out:         testDelayVolume.Latency,
+			spdk:        []string{},
+			errCode:     codes.InvalidArgument,
+			errMsg:      "latency cannot be negative",
+		},
+		"p99 below average": {
+			faultVolume: &testDelayVolume,
+			in:          &bridgepb.FaultLatency{AvgWriteUs: 10, P99WriteUs: 5},
+			out:         testDelayVolume.Latency,
+			spdk:        []string{},
+			errCode:     codes.InvalidArgument,
+			errMsg:      "p99 write latency 5 is less than average write latency 10",
+		},
+		"nothing changed": {
+			faultVolume: &testDelayVolume,
+			in:          testDelayVolume.Latency,
+			out:         testDelayVolume.Latency,
+			spdk:        []string{},
+			errCode:     codes.OK,
+			errMsg:      "",
+		},
+		"raise read latency": {
+			faultVolume: &testDelayVolume,
+			in:          &bridgepb.FaultLatency{AvgReadUs: 1000, P99ReadUs: 2000, AvgWriteUs: 300, P99WriteUs: 400},
+			out:         &bridgepb.FaultLatency{AvgReadUs: 1000, P99ReadUs: 2000, AvgWriteUs: 300, P99WriteUs: 400},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"second update fails": {
+			faultVolume: &testDelayVolume,
+			in:          &bridgepb.FaultLatency{AvgReadUs: 1000, P99ReadUs: 2000, AvgWriteUs: 300, P99WriteUs: 400},
+			out:         &bridgepb.FaultLatency{AvgReadUs: 100, P99ReadUs: 2000, AvgWriteUs: 300, P99WriteUs: 400},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":false}`,
+			},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("Could not update avg_read latency of Delay Dev: %v", testFaultVolumeID),
+		},
+		"error code from SPDK response": {
+			faultVolume: &testDelayVolume,
+			in:          &bridgepb.FaultLatency{AvgReadUs: 100, P99ReadUs: 200, AvgWriteUs: 0, P99WriteUs: 0},
+			out:         testDelayVolume.Latency,
+			spdk:        []string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":false}`},
+			errCode:     codes.Unknown,
+			errMsg:      fmt.Sprintf("bdev_delay_update_latency: %v", "json response error: myopierr"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestFaultEnvironment(tt.spdk, tt.faultVolume)
+			defer testEnv.Close()
+
+			request := &bridgepb.UpdateFaultVolumeLatencyRequest{Name: testFaultVolumeName, Latency: tt.in}
+			_, err := testEnv.client.UpdateFaultVolumeLatency(testEnv.ctx, request)
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+
+			latency := testEnv.opiSpdkServer.fault.volumes[testFaultVolumeName].Latency
+			if !proto.Equal(latency, tt.out) {
+				t.Error("latency: expected", tt.out, "received", latency)
+			}
+		})
+	}
+}
+
+func TestLatencyUpdates(t *testing.T) {
+	tests := map[string]struct {
+		avg, p99       int64
+		newAvg, newP99 int64
+		out            []string
+	}{
+		"raise above p99":  {avg: 10, p99: 20, newAvg: 30, newP99: 40, out: []string{"p99_read", "avg_read"}},
+		"lower below avg":  {avg: 10, p99: 20, newAvg: 1, newP99: 5, out: []string{"avg_read", "p99_read"}},
+		"only p99 changed": {avg: 10, p99: 20, newAvg: 10, newP99: 50, out: []string{"p99_read"}},
+		"nothing changed":  {avg: 10, p99: 20, newAvg: 10, newP99: 20, out: []string{}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			avg, p99 := tt.avg, tt.p99
+			types := []string{}
+			for _, update := range latencyUpdates("read", &avg, &p99, tt.newAvg, tt.newP99) {
+				types = append(types, update.latencyType)
+			}
+			if !reflect.DeepEqual(types, tt.out) {
+				t.Error("updates: expected", tt.out, "received", types)
+			}
+		})
+	}
+}
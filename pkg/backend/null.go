@@ -15,6 +15,7 @@ import (
 	"github.com/opiproject/gospdk/spdk"
 	pc "github.com/opiproject/opi-api/common/v1/gen/go"
 	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
 	"github.com/opiproject/opi-spdk-bridge/pkg/server"
 
 	"github.com/google/uuid"
@@ -25,6 +26,19 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	// nullBlockSizeUnit is the granularity of Null bdev block size in bytes
+	nullBlockSizeUnit = 512
+	// defaultNullBlockSize is the block size of Null bdevs created without one
+	defaultNullBlockSize = 512
+	// defaultNullBlocksCount is the blocks count of Null bdevs created
+	// without one
+	defaultNullBlocksCount = 64
+	// nullResizeUnit is the granularity of Null bdev resize in bytes
+	nullResizeUnit = 1024 * 1024
 )
 
 func sortNullVolumes(volumes []*pb.NullVolume) {
@@ -34,10 +48,19 @@ func sortNullVolumes(volumes []*pb.NullVolume) {
 }
 
 // CreateNullVolume creates a Null volume instance
-func (s *Server) CreateNullVolume(_ context.Context, in *pb.CreateNullVolumeRequest) (*pb.NullVolume, error) {
-	log.Printf("CreateNullVolume: Received from client: %v", in)
+func (s *Server) CreateNullVolume(ctx context.Context, in *pb.CreateNullVolumeRequest) (*pb.NullVolume, error) {
+	log.Printf("CreateNullVolume: Received from client: %v", server.Redact(in))
+	return s.createNullVolume(ctx, in, nil)
+}
+
+// createNullVolumeRequiredFields are the required fields of requests to
+// create Null volumes. block_size and blocks_count are required by the OPI
+// model, but have defaults
+var createNullVolumeRequiredFields = &fieldmaskpb.FieldMask{Paths: []string{"null_volume"}}
+
+func (s *Server) createNullVolume(_ context.Context, in *pb.CreateNullVolumeRequest, metadata *bridgepb.NullVolumeMetadata) (*pb.NullVolume, error) {
 	// check required fields
-	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+	if err := fieldbehavior.ValidateRequiredFieldsWithMask(in, createNullVolumeRequiredFields); err != nil {
 		log.Printf("error: %v", err)
 		return nil, err
 	}
@@ -60,32 +83,17 @@ func (s *Server) CreateNullVolume(_ context.Context, in *pb.CreateNullVolumeRequ
 		return volume, nil
 	}
 	// not found, so create a new one
-	params := spdk.BdevNullCreateParams{
-		Name:      resourceID,
-		BlockSize: 512,
-		NumBlocks: 64,
-	}
-	var result spdk.BdevNullCreateResult
-	err := s.rpc.Call("bdev_null_create", &params, &result)
+	response, err := s.createNullBdev(resourceID, in.NullVolume, metadata)
 	if err != nil {
-		log.Printf("error: %v", err)
 		return nil, err
 	}
-	log.Printf("Received from SPDK: %v", result)
-	if result == "" {
-		msg := fmt.Sprintf("Could not create Null Dev: %s", params.Name)
-		log.Print(msg)
-		return nil, status.Errorf(codes.InvalidArgument, msg)
-	}
-	response := server.ProtoClone(in.NullVolume)
-	s.Volumes.NullVolumes[in.NullVolume.Name] = response
-	log.Printf("CreateNullVolume: Sending to client: %v", response)
+	log.Printf("CreateNullVolume: Sending to client: %v", server.Redact(response))
 	return response, nil
 }
 
 // DeleteNullVolume deletes a Null volume instance
 func (s *Server) DeleteNullVolume(_ context.Context, in *pb.DeleteNullVolumeRequest) (*emptypb.Empty, error) {
-	log.Printf("DeleteNullVolume: Received from client: %v", in)
+	log.Printf("DeleteNullVolume: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -106,6 +114,10 @@ func (s *Server) DeleteNullVolume(_ context.Context, in *pb.DeleteNullVolumeRequ
 		log.Printf("error: %v", err)
 		return nil, err
 	}
+	if err := s.registry.CheckUnused(volume.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
 	resourceID := path.Base(volume.Name)
 	params := spdk.BdevNullDeleteParams{
 		Name: resourceID,
@@ -123,12 +135,14 @@ func (s *Server) DeleteNullVolume(_ context.Context, in *pb.DeleteNullVolumeRequ
 		return nil, status.Errorf(codes.InvalidArgument, msg)
 	}
 	delete(s.Volumes.NullVolumes, volume.Name)
+	s.unregisterVolume(volume.Name)
 	return &emptypb.Empty{}, nil
 }
 
-// UpdateNullVolume updates a Null volume instance
+// UpdateNullVolume updates a Null volume instance. Only blocks_count can be
+// changed, the bdev is grown in place
 func (s *Server) UpdateNullVolume(_ context.Context, in *pb.UpdateNullVolumeRequest) (*pb.NullVolume, error) {
-	log.Printf("UpdateNullVolume: Received from client: %v", in)
+	log.Printf("UpdateNullVolume: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -144,26 +158,11 @@ func (s *Server) UpdateNullVolume(_ context.Context, in *pb.UpdateNullVolumeRequ
 	if !ok {
 		if in.AllowMissing {
 			log.Printf("Got AllowMissing, create a new resource, don't return error when resource not found")
-			params := spdk.BdevNullCreateParams{
-				Name:      path.Base(in.NullVolume.Name),
-				BlockSize: 512,
-				NumBlocks: 64,
-			}
-			var result spdk.BdevNullCreateResult
-			err := s.rpc.Call("bdev_null_create", &params, &result)
+			response, err := s.createNullBdev(path.Base(in.NullVolume.Name), in.NullVolume, nil)
 			if err != nil {
-				log.Printf("error: %v", err)
 				return nil, err
 			}
-			log.Printf("Received from SPDK: %v", result)
-			if result == "" {
-				msg := fmt.Sprintf("Could not create Null Dev: %s", params.Name)
-				log.Print(msg)
-				return nil, status.Errorf(codes.InvalidArgument, msg)
-			}
-			response := server.ProtoClone(in.NullVolume)
-			s.Volumes.NullVolumes[in.NullVolume.Name] = response
-			log.Printf("CreateNullVolume: Sending to client: %v", response)
+			log.Printf("CreateNullVolume: Sending to client: %v", server.Redact(response))
 			return response, nil
 		}
 		err := status.Errorf(codes.NotFound, "unable to find key %s", in.NullVolume.Name)
@@ -176,46 +175,87 @@ func (s *Server) UpdateNullVolume(_ context.Context, in *pb.UpdateNullVolumeRequ
 		log.Printf("error: %v", err)
 		return nil, err
 	}
-	params1 := spdk.BdevNullDeleteParams{
-		Name: resourceID,
+	updated := func(field string) bool {
+		if in.UpdateMask == nil || len(in.UpdateMask.Paths) == 0 {
+			return true
+		}
+		for _, p := range in.UpdateMask.Paths {
+			if p == "*" || p == field {
+				return true
+			}
+		}
+		return false
+	}
+	if updated("block_size") && in.NullVolume.BlockSize != 0 && in.NullVolume.BlockSize != volume.BlockSize {
+		err := status.Error(codes.InvalidArgument, "block_size of NullVolume cannot be changed")
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if updated("uuid") && in.NullVolume.Uuid != nil && in.NullVolume.Uuid.Value != volume.Uuid.GetValue() {
+		err := status.Error(codes.InvalidArgument, "uuid of NullVolume cannot be changed")
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if !updated("blocks_count") || in.NullVolume.BlocksCount == 0 || in.NullVolume.BlocksCount == volume.BlocksCount {
+		response := server.ProtoClone(volume)
+		log.Printf("UpdateNullVolume: Sending to client: %v", server.Redact(response))
+		return response, nil
+	}
+	if in.NullVolume.BlocksCount < volume.BlocksCount {
+		err := status.Error(codes.InvalidArgument, "blocks_count of NullVolume cannot be decreased")
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// SPDK resizes Null bdevs in MiB
+	newSize := in.NullVolume.BlocksCount * volume.BlockSize
+	if newSize%nullResizeUnit != 0 {
+		msg := fmt.Sprintf("size of NullVolume must be a multiple of %d bytes, got %d", nullResizeUnit, newSize)
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	params := bdevNullResizeParams{
+		Name:    resourceID,
+		NewSize: uint64(newSize / nullResizeUnit),
 	}
-	var result1 spdk.BdevNullDeleteResult
-	err1 := s.rpc.Call("bdev_null_delete", &params1, &result1)
-	if err1 != nil {
-		log.Printf("error: %v", err1)
-		return nil, err1
+	var result bdevNullResizeResult
+	err := s.rpc.Call("bdev_null_resize", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
 	}
-	log.Printf("Received from SPDK: %v", result1)
-	if !result1 {
-		msg := fmt.Sprintf("Could not delete Null Dev: %s", params1.Name)
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not resize Null Dev: %s", params.Name)
 		log.Print(msg)
 		return nil, status.Errorf(codes.InvalidArgument, msg)
 	}
-	params2 := spdk.BdevNullCreateParams{
-		Name:      resourceID,
-		BlockSize: 512,
-		NumBlocks: 64,
+	params2 := spdk.BdevGetBdevsParams{
+		Name: resourceID,
 	}
-	var result2 spdk.BdevNullCreateResult
-	err2 := s.rpc.Call("bdev_null_create", &params2, &result2)
-	if err2 != nil {
-		log.Printf("error: %v", err2)
-		return nil, err2
+	var result2 []spdk.BdevGetBdevsResult
+	err = s.rpc.Call("bdev_get_bdevs", &params2, &result2)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
 	}
 	log.Printf("Received from SPDK: %v", result2)
-	if result2 == "" {
-		msg := fmt.Sprintf("Could not create Null Dev: %s", params2.Name)
+	if len(result2) != 1 {
+		msg := fmt.Sprintf("expecting exactly 1 result, got %d", len(result2))
 		log.Print(msg)
 		return nil, status.Errorf(codes.InvalidArgument, msg)
 	}
-	response := server.ProtoClone(in.NullVolume)
-	s.Volumes.NullVolumes[in.NullVolume.Name] = response
+	volume.BlocksCount = result2[0].NumBlocks
+	if err := s.registry.SetSize(volume.Name, volume.BlockSize, volume.BlocksCount); err != nil {
+		log.Printf("error: %v", err)
+	}
+	response := server.ProtoClone(volume)
+	log.Printf("UpdateNullVolume: Sending to client: %v", server.Redact(response))
 	return response, nil
 }
 
 // ListNullVolumes lists Null volume instances
 func (s *Server) ListNullVolumes(_ context.Context, in *pb.ListNullVolumesRequest) (*pb.ListNullVolumesResponse, error) {
-	log.Printf("ListNullVolumes: Received from client: %v", in)
+	log.Printf("ListNullVolumes: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -252,7 +292,7 @@ func (s *Server) ListNullVolumes(_ context.Context, in *pb.ListNullVolumesReques
 
 // GetNullVolume gets a a Null volume instance
 func (s *Server) GetNullVolume(_ context.Context, in *pb.GetNullVolumeRequest) (*pb.NullVolume, error) {
-	log.Printf("GetNullVolume: Received from client: %v", in)
+	log.Printf("GetNullVolume: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -289,9 +329,11 @@ func (s *Server) GetNullVolume(_ context.Context, in *pb.GetNullVolumeRequest) (
 	return &pb.NullVolume{Name: result[0].Name, Uuid: &pc.Uuid{Value: result[0].UUID}, BlockSize: result[0].BlockSize, BlocksCount: result[0].NumBlocks}, nil
 }
 
-// StatsNullVolume gets a Null volume instance stats
+// StatsNullVolume gets a Null volume instance stats. Counters saturate at
+// math.MaxInt32 and latencies are in microseconds, see
+// server.VolumeStats.ToProto. StatsVolume reports them in 64 bits
 func (s *Server) StatsNullVolume(_ context.Context, in *pb.StatsNullVolumeRequest) (*pb.StatsNullVolumeResponse, error) {
-	log.Printf("StatsNullVolume: Received from client: %v", in)
+	log.Printf("StatsNullVolume: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -326,15 +368,62 @@ func (s *Server) StatsNullVolume(_ context.Context, in *pb.StatsNullVolumeReques
 		log.Print(msg)
 		return nil, status.Errorf(codes.InvalidArgument, msg)
 	}
-	return &pb.StatsNullVolumeResponse{Stats: &pb.VolumeStats{
-		ReadBytesCount:    int32(result.Bdevs[0].BytesRead),
-		ReadOpsCount:      int32(result.Bdevs[0].NumReadOps),
-		WriteBytesCount:   int32(result.Bdevs[0].BytesWritten),
-		WriteOpsCount:     int32(result.Bdevs[0].NumWriteOps),
-		UnmapBytesCount:   int32(result.Bdevs[0].BytesUnmapped),
-		UnmapOpsCount:     int32(result.Bdevs[0].NumUnmapOps),
-		ReadLatencyTicks:  int32(result.Bdevs[0].ReadLatencyTicks),
-		WriteLatencyTicks: int32(result.Bdevs[0].WriteLatencyTicks),
-		UnmapLatencyTicks: int32(result.Bdevs[0].UnmapLatencyTicks),
-	}}, nil
+	stats := server.NewVolumeStats(&result)
+	if err := s.volumeHistogram(volume.Name, stats); err != nil {
+		return nil, err
+	}
+	s.stats.Update(resourceID, stats)
+	log.Printf("Rates of %v: %+v", resourceID, stats.Rates)
+	return &pb.StatsNullVolumeResponse{Stats: stats.ToProto()}, nil
+}
+
+// createNullBdev creates a Null bdev with the given name and stores the volume.
+// A block size or blocks count of zero is replaced by its default
+func (s *Server) createNullBdev(resourceID string, nullVolume *pb.NullVolume, metadata *bridgepb.NullVolumeMetadata) (*pb.NullVolume, error) {
+	response := server.ProtoClone(nullVolume)
+	if response.BlockSize == 0 {
+		response.BlockSize = defaultNullBlockSize
+	}
+	if response.BlocksCount == 0 {
+		response.BlocksCount = defaultNullBlocksCount
+	}
+	blockSize := response.BlockSize
+	blocksCount := response.BlocksCount
+	if blockSize <= 0 || blockSize%nullBlockSizeUnit != 0 {
+		msg := fmt.Sprintf("block_size must be a multiple of %d, got %d", nullBlockSizeUnit, blockSize)
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	if blocksCount <= 0 {
+		msg := fmt.Sprintf("blocks_count must be positive, got %d", blocksCount)
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	params := bdevNullCreateParams{
+		BdevNullCreateParams: spdk.BdevNullCreateParams{
+			Name:      resourceID,
+			BlockSize: int(blockSize),
+			NumBlocks: int(blocksCount),
+		},
+	}
+	if metadata != nil {
+		params.MdSize = metadata.MdSize
+		params.DifType = int32(metadata.DifType)
+		params.DifIsHeadOfMd = metadata.DifIsHeadOfMd
+	}
+	var result spdk.BdevNullCreateResult
+	err := s.rpc.Call("bdev_null_create", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if result == "" {
+		msg := fmt.Sprintf("Could not create Null Dev: %s", params.Name)
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	s.Volumes.NullVolumes[response.Name] = response
+	s.registerNullVolume(response.Name, blockSize, blocksCount)
+	return server.ProtoClone(response), nil
 }
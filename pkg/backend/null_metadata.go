@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package backend implements the BackEnd APIs (network facing) of the storage Server
+package backend
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+
+	"github.com/opiproject/gospdk/spdk"
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+
+	"go.einride.tech/aip/fieldbehavior"
+	"go.einride.tech/aip/resourcename"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// bdevNullCreateParams extends the Null bdev creation parameters with per
+// block metadata and protection information
+type bdevNullCreateParams struct {
+	spdk.BdevNullCreateParams
+	MdSize        int32 `json:"md_size,omitempty"`
+	DifType       int32 `json:"dif_type,omitempty"`
+	DifIsHeadOfMd bool  `json:"dif_is_head_of_md,omitempty"`
+}
+
+// bdevNullResizeParams holds the parameters required to resize a Null Block Device
+type bdevNullResizeParams struct {
+	Name string `json:"name"`
+	// NewSize is the new capacity in MiB
+	NewSize uint64 `json:"new_size"`
+}
+
+// bdevNullResizeResult is the result of resizing a Null Block Device
+type bdevNullResizeResult bool
+
+// bdevGetBdevsMetadataResult extends bdev_get_bdevs result with metadata
+// and protection information of a bdev
+type bdevGetBdevsMetadataResult struct {
+	spdk.BdevGetBdevsResult
+	MdSize        int32 `json:"md_size"`
+	DifType       int32 `json:"dif_type"`
+	DifIsHeadOfMd bool  `json:"dif_is_head_of_md"`
+}
+
+// nullMdSizes are metadata sizes supported by Null bdevs
+var nullMdSizes = map[int32]bool{0: true, 8: true, 16: true, 32: true, 64: true, 128: true}
+
+// verifyNullVolumeMetadata checks the metadata configuration the same way
+// SPDK does
+func verifyNullVolumeMetadata(m *bridgepb.NullVolumeMetadata) error {
+	switch {
+	case !nullMdSizes[m.GetMdSize()]:
+		return fmt.Errorf("md_size %d is not supported, must be one of 0, 8, 16, 32, 64, 128", m.GetMdSize())
+	case m.GetDifType() < bridgepb.NullDifType_NULL_DIF_TYPE_DISABLED || m.GetDifType() > bridgepb.NullDifType_NULL_DIF_TYPE_3:
+		return fmt.Errorf("dif_type %d is not supported, must be 0-3", m.GetDifType())
+	case m.GetDifType() != bridgepb.NullDifType_NULL_DIF_TYPE_DISABLED && m.GetMdSize() == 0:
+		return fmt.Errorf("dif_type requires md_size to be set")
+	case m.GetDifIsHeadOfMd() && m.GetDifType() == bridgepb.NullDifType_NULL_DIF_TYPE_DISABLED:
+		return fmt.Errorf("dif_is_head_of_md requires dif_type to be set")
+	}
+	return nil
+}
+
+// CreateNullVolumeWithMetadata creates a Null volume with per block metadata
+// and protection information, which cannot be expressed in NullVolume
+func (s *Server) CreateNullVolumeWithMetadata(ctx context.Context, in *bridgepb.CreateNullVolumeWithMetadataRequest) (*pb.NullVolume, error) {
+	log.Printf("CreateNullVolumeWithMetadata: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if err := verifyNullVolumeMetadata(in.Metadata); err != nil {
+		log.Printf("error: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	request := &pb.CreateNullVolumeRequest{NullVolume: in.NullVolume, NullVolumeId: in.NullVolumeId}
+	return s.createNullVolume(ctx, request, in.Metadata)
+}
+
+// GetNullVolumeMetadata returns metadata and protection information of a
+// Null volume as reported by SPDK
+func (s *Server) GetNullVolumeMetadata(_ context.Context, in *bridgepb.GetNullVolumeMetadataRequest) (*bridgepb.NullVolumeMetadata, error) {
+	log.Printf("GetNullVolumeMetadata: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	volume, ok := s.Volumes.NullVolumes[in.Name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	params := spdk.BdevGetBdevsParams{
+		Name: path.Base(volume.Name),
+	}
+	var result []bdevGetBdevsMetadataResult
+	err := s.rpc.Call("bdev_get_bdevs", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if len(result) != 1 {
+		msg := fmt.Sprintf("expecting exactly 1 result, got %d", len(result))
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	return &bridgepb.NullVolumeMetadata{
+		MdSize:        result[0].MdSize,
+		DifType:       bridgepb.NullDifType(result[0].DifType),
+		DifIsHeadOfMd: result[0].DifIsHeadOfMd,
+	}, nil
+}
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package backend implements the BackEnd APIs (network facing) of the storage Server
+package backend
+
+import (
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+)
+
+func TestBackEnd_CreateNullVolumeWithMetadata(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	testNullVolumeWithName := server.ProtoClone(&testNullVolume)
+	testNullVolumeWithName.Name = testNullVolumeName
+	tests := map[string]struct {
+		metadata *bridgepb.NullVolumeMetadata
+		out      *pb.NullVolume
+		spdk     []string
+		errCode  codes.Code
+		errMsg   string
+	}{
+		"no metadata": {
+			metadata: nil,
+			out:      testNullVolumeWithName,
+			spdk:     []string{`{"id":%d,"error":{"code":0,"message":""},"result":"mytest"}`},
+			errCode:  codes.OK,
+			errMsg:   "",
+		},
+		"metadata with protection information": {
+			metadata: &bridgepb.NullVolumeMetadata{MdSize: 16, DifType: bridgepb.NullDifType_NULL_DIF_TYPE_1, DifIsHeadOfMd: true},
+			out:      testNullVolumeWithName,
+			spdk:     []string{`{"id":%d,"error":{"code":0,"message":""},"result":"mytest"}`},
+			errCode:  codes.OK,
+			errMsg:   "",
+		},
+		"unsupported metadata size": {
+			metadata: &bridgepb.NullVolumeMetadata{MdSize: 12},
+			out:      nil,
+			spdk:     []string{},
+			errCode:  codes.InvalidArgument,
+			errMsg:   "md_size 12 is not supported, must be one of 0, 8, 16, 32, 64, 128",
+		},
+		"unsupported dif type": {
+			metadata: &bridgepb.NullVolumeMetadata{MdSize: 8, DifType: 4},
+			out:      nil,
+			spdk:     []string{},
+			errCode:  codes.InvalidArgument,
+			errMsg:   "dif_type 4 is not supported, must be 0-3",
+		},
+		"dif type without metadata": {
+			metadata: &bridgepb.NullVolumeMetadata{DifType: bridgepb.NullDifType_NULL_DIF_TYPE_3},
+			out:      nil,
+			spdk:     []string{},
+			errCode:  codes.InvalidArgument,
+			errMsg:   "dif_type requires md_size to be set",
+		},
+		"dif location without dif type": {
+			metadata: &bridgepb.NullVolumeMetadata{MdSize: 8, DifIsHeadOfMd: true},
+			out:      nil,
+			spdk:     []string{},
+			errCode:  codes.InvalidArgument,
+			errMsg:   "dif_is_head_of_md requires dif_type to be set",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			request := &bridgepb.CreateNullVolumeWithMetadataRequest{NullVolume: server.ProtoClone(&testNullVolume), NullVolumeId: testNullVolumeID, Metadata: tt.metadata}
+			response, err := testEnv.client.CreateNullVolumeWithMetadata(testEnv.ctx, request)
+
+			if !proto.Equal(response, tt.out) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
+
+func TestBackEnd_GetNullVolumeMetadata(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	tests := map[string]struct {
+		in      string
+		out     *bridgepb.NullVolumeMetadata
+		spdk    []string
+		errCode codes.Code
+		errMsg  string
+	}{
+		"valid request with valid SPDK response": {
+			in:      testNullVolumeName,
+			out:     &bridgepb.NullVolumeMetadata{MdSize: 16, DifType: bridgepb.NullDifType_NULL_DIF_TYPE_2, DifIsHeadOfMd: true},
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":[{"name":"mytest","block_size":512,"num_blocks":64,"md_size":16,"md_interleave":true,"dif_type":2,"dif_is_head_of_md":true}]}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"valid request without metadata": {
+			in:      testNullVolumeName,
+			out:     &bridgepb.NullVolumeMetadata{},
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":[{"name":"mytest","block_size":512,"num_blocks":64}]}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"valid request with invalid SPDK response": {
+			in:      testNullVolumeName,
+			out:     nil,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":[]}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("expecting exactly 1 result, got %v", "0"),
+		},
+		"valid request with error code from SPDK response": {
+			in:      testNullVolumeName,
+			out:     nil,
+			spdk:    []string{`{"id":%d,"error":{"code":1,"message":"myopierr"}}`},
+			errCode: codes.Unknown,
+			errMsg:  fmt.Sprintf("bdev_get_bdevs: %v", "json response error: myopierr"),
+		},
+		"valid request with unknown key": {
+			in:      server.ResourceIDToVolumeName("unknown-id"),
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %v", server.ResourceIDToVolumeName("unknown-id")),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			testEnv.opiSpdkServer.Volumes.NullVolumes[testNullVolumeName] = server.ProtoClone(&testNullVolume)
+			testEnv.opiSpdkServer.Volumes.NullVolumes[testNullVolumeName].Name = testNullVolumeName
+
+			request := &bridgepb.GetNullVolumeMetadataRequest{Name: tt.in}
+			metadata, err := testEnv.client.GetNullVolumeMetadata(testEnv.ctx, request)
+
+			if !proto.Equal(metadata, tt.out) {
+				t.Error("response: expected", tt.out, "received", metadata)
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
@@ -96,6 +96,33 @@ func TestBackEnd_CreateNullVolume(t *testing.T) {
 			"",
 			false,
 		},
+		"default block size and blocks count": {
+			testNullVolumeID,
+			&pb.NullVolume{},
+			&testNullVolume,
+			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":"mytest"}`},
+			codes.OK,
+			"",
+			false,
+		},
+		"invalid block size": {
+			testNullVolumeID,
+			&pb.NullVolume{BlockSize: 520, BlocksCount: 64},
+			nil,
+			[]string{},
+			codes.InvalidArgument,
+			fmt.Sprintf("block_size must be a multiple of %d, got %d", 512, 520),
+			false,
+		},
+		"invalid blocks count": {
+			testNullVolumeID,
+			&pb.NullVolume{BlockSize: 512, BlocksCount: -1},
+			nil,
+			[]string{},
+			codes.InvalidArgument,
+			fmt.Sprintf("blocks_count must be positive, got %d", -1),
+			false,
+		},
 		"already exists": {
 			testNullVolumeID,
 			&testNullVolume,
@@ -155,7 +182,9 @@ func TestBackEnd_CreateNullVolume(t *testing.T) {
 func TestBackEnd_UpdateNullVolume(t *testing.T) {
 	testNullVolumeWithName := server.ProtoClone(&testNullVolume)
 	testNullVolumeWithName.Name = testNullVolumeName
-	t.Cleanup(server.CheckTestProtoObjectsNotChanged(testNullVolumeWithName)(t, t.Name()))
+	testResizedNullVolume := server.ProtoClone(testNullVolumeWithName)
+	testResizedNullVolume.BlocksCount = 4096
+	t.Cleanup(server.CheckTestProtoObjectsNotChanged(testNullVolumeWithName, testResizedNullVolume)(t, t.Name()))
 	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
 
 	tests := map[string]struct {
@@ -176,83 +205,104 @@ func TestBackEnd_UpdateNullVolume(t *testing.T) {
 			fmt.Sprintf("invalid field path: %s", "'*' must not be used with other paths"),
 			false,
 		},
-		"delete fails": {
+		"block size cannot be changed": {
 			nil,
-			testNullVolumeWithName,
+			&pb.NullVolume{
+				Name:        testNullVolumeName,
+				BlockSize:   4096,
+				BlocksCount: testNullVolume.BlocksCount,
+			},
 			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			[]string{},
 			codes.InvalidArgument,
-			fmt.Sprintf("Could not delete Null Dev: %s", testNullVolumeID),
+			"block_size of NullVolume cannot be changed",
 			false,
 		},
-		"delete empty": {
+		"blocks count cannot be decreased": {
 			nil,
-			testNullVolumeWithName,
+			&pb.NullVolume{
+				Name:        testNullVolumeName,
+				BlockSize:   testNullVolume.BlockSize,
+				BlocksCount: 32,
+			},
 			nil,
-			[]string{""},
-			codes.Unknown,
-			fmt.Sprintf("bdev_null_delete: %v", "EOF"),
+			[]string{},
+			codes.InvalidArgument,
+			"blocks_count of NullVolume cannot be decreased",
 			false,
 		},
-		"delete ID mismatch": {
+		"size is not a multiple of MiB": {
 			nil,
-			testNullVolumeWithName,
+			&pb.NullVolume{
+				Name:        testNullVolumeName,
+				BlockSize:   testNullVolume.BlockSize,
+				BlocksCount: 2049,
+			},
 			nil,
-			[]string{`{"id":0,"error":{"code":0,"message":""},"result":false}`},
-			codes.Unknown,
-			fmt.Sprintf("bdev_null_delete: %v", "json response ID mismatch"),
+			[]string{},
+			codes.InvalidArgument,
+			fmt.Sprintf("size of NullVolume must be a multiple of %d bytes, got %d", 1024*1024, 2049*512),
 			false,
 		},
-		"delete exception": {
-			nil,
+		"blocks count not in update mask": {
+			&fieldmaskpb.FieldMask{Paths: []string{"uuid"}},
+			testResizedNullVolume,
 			testNullVolumeWithName,
-			nil,
-			[]string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":false}`},
-			codes.Unknown,
-			fmt.Sprintf("bdev_null_delete: %v", "json response error: myopierr"),
+			[]string{},
+			codes.OK,
+			"",
 			false,
 		},
-		"delete ok create fails": {
+		"resize fails": {
 			nil,
-			testNullVolumeWithName,
+			testResizedNullVolume,
 			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":""}`},
+			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
 			codes.InvalidArgument,
-			fmt.Sprintf("Could not create Null Dev: %v", "mytest"),
+			fmt.Sprintf("Could not resize Null Dev: %s", testNullVolumeID),
 			false,
 		},
-		"delete ok create empty": {
+		"resize empty": {
 			nil,
-			testNullVolumeWithName,
+			testResizedNullVolume,
 			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, ""},
+			[]string{""},
 			codes.Unknown,
-			fmt.Sprintf("bdev_null_create: %v", "EOF"),
+			fmt.Sprintf("bdev_null_resize: %v", "EOF"),
 			false,
 		},
-		"delete ok create ID mismatch": {
+		"resize ID mismatch": {
 			nil,
-			testNullVolumeWithName,
+			testResizedNullVolume,
 			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":0,"error":{"code":0,"message":""},"result":""}`},
+			[]string{`{"id":0,"error":{"code":0,"message":""},"result":false}`},
 			codes.Unknown,
-			fmt.Sprintf("bdev_null_create: %v", "json response ID mismatch"),
+			fmt.Sprintf("bdev_null_resize: %v", "json response ID mismatch"),
 			false,
 		},
-		"delete ok create exception": {
+		"resize exception": {
 			nil,
-			testNullVolumeWithName,
+			testResizedNullVolume,
 			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":1,"message":"myopierr"},"result":""}`},
+			[]string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":false}`},
 			codes.Unknown,
-			fmt.Sprintf("bdev_null_create: %v", "json response error: myopierr"),
+			fmt.Sprintf("bdev_null_resize: %v", "json response error: myopierr"),
+			false,
+		},
+		"resize ok get bdevs fails": {
+			nil,
+			testResizedNullVolume,
+			nil,
+			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":[]}`},
+			codes.InvalidArgument,
+			fmt.Sprintf("expecting exactly 1 result, got %v", "0"),
 			false,
 		},
 		"valid request with valid SPDK response": {
 			nil,
-			testNullVolumeWithName,
-			testNullVolumeWithName,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":"mytest"}`},
+			testResizedNullVolume,
+			testResizedNullVolume,
+			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":[{"name":"mytest","block_size":512,"num_blocks":4096}]}`},
 			codes.OK,
 			"",
 			false,
@@ -691,7 +741,7 @@ func TestBackEnd_StatsNullVolume(t *testing.T) {
 				ReadLatencyTicks:  7,
 				WriteLatencyTicks: 8,
 			},
-			[]string{`{"jsonrpc":"2.0","id":%d,"result":{"tick_rate":2490000000,"ticks":18787040917434338,"bdevs":[{"name":"mytest","bytes_read":1,"num_read_ops":2,"bytes_written":3,"num_write_ops":4,"bytes_unmapped":0,"num_unmap_ops":0,"read_latency_ticks":7,"write_latency_ticks":8,"unmap_latency_ticks":0}]}}`},
+			[]string{`{"jsonrpc":"2.0","id":%d,"result":{"tick_rate":2490000000,"ticks":18787040917434338,"bdevs":[{"name":"mytest","bytes_read":1,"num_read_ops":2,"bytes_written":3,"num_write_ops":4,"bytes_unmapped":0,"num_unmap_ops":0,"read_latency_ticks":17430,"write_latency_ticks":19920,"unmap_latency_ticks":0}]}}`},
 			codes.OK,
 			"",
 		},
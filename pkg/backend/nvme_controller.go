@@ -31,7 +31,7 @@ func sortNvmeRemoteControllers(controllers []*pb.NvmeRemoteController) {
 
 // CreateNvmeRemoteController creates an Nvme remote controller
 func (s *Server) CreateNvmeRemoteController(_ context.Context, in *pb.CreateNvmeRemoteControllerRequest) (*pb.NvmeRemoteController, error) {
-	log.Printf("CreateNvmeRemoteController: Received from client: %v", in)
+	log.Printf("CreateNvmeRemoteController: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -58,13 +58,13 @@ func (s *Server) CreateNvmeRemoteController(_ context.Context, in *pb.CreateNvme
 	// not found, so create a new one
 	response := server.ProtoClone(in.NvmeRemoteController)
 	s.Volumes.NvmeControllers[in.NvmeRemoteController.Name] = response
-	log.Printf("CreateNvmeRemoteController: Sending to client: %v", response)
+	log.Printf("CreateNvmeRemoteController: Sending to client: %v", server.Redact(response))
 	return response, nil
 }
 
 // DeleteNvmeRemoteController deletes an Nvme remote controller
 func (s *Server) DeleteNvmeRemoteController(_ context.Context, in *pb.DeleteNvmeRemoteControllerRequest) (*emptypb.Empty, error) {
-	log.Printf("DeleteNvmeRemoteController: Received from client: %v", in)
+	log.Printf("DeleteNvmeRemoteController: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -82,12 +82,17 @@ func (s *Server) DeleteNvmeRemoteController(_ context.Context, in *pb.DeleteNvme
 			return &emptypb.Empty{}, nil
 		}
 		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
-		log.Printf("error: %v -> %v", err, volume)
+		log.Printf("error: %v -> %v", err, server.Redact(volume))
 		return nil, err
 	}
 	if s.numberOfPathsForController(in.Name) > 0 {
 		return nil, status.Error(codes.FailedPrecondition, "NvmePaths exist for controller")
 	}
+	if keys, ok := s.dhchap.keys[volume.Name]; ok {
+		s.removeDhchapKeys(keys)
+		delete(s.dhchap.keys, volume.Name)
+	}
+	delete(s.reconnect, volume.Name)
 	delete(s.Volumes.NvmeControllers, volume.Name)
 	return &emptypb.Empty{}, nil
 }
@@ -110,7 +115,7 @@ func (s *Server) ResetNvmeRemoteController(_ context.Context, in *pb.ResetNvmeRe
 
 // ListNvmeRemoteControllers lists an Nvme remote controllers
 func (s *Server) ListNvmeRemoteControllers(_ context.Context, in *pb.ListNvmeRemoteControllersRequest) (*pb.ListNvmeRemoteControllersResponse, error) {
-	log.Printf("ListNvmeRemoteControllers: Received from client: %v", in)
+	log.Printf("ListNvmeRemoteControllers: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -141,7 +146,7 @@ func (s *Server) ListNvmeRemoteControllers(_ context.Context, in *pb.ListNvmeRem
 
 // GetNvmeRemoteController gets an Nvme remote controller
 func (s *Server) GetNvmeRemoteController(_ context.Context, in *pb.GetNvmeRemoteControllerRequest) (*pb.NvmeRemoteController, error) {
-	log.Printf("GetNvmeRemoteController: Received from client: %v", in)
+	log.Printf("GetNvmeRemoteController: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
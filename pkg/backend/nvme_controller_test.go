@@ -6,8 +6,12 @@
 package backend
 
 import (
+	"bytes"
 	"fmt"
+	"log"
+	"os"
 	"reflect"
+	"strings"
 	"testing"
 
 	"google.golang.org/protobuf/proto"
@@ -515,3 +519,47 @@ func TestBackEnd_DeleteNvmeRemoteController(t *testing.T) {
 		})
 	}
 }
+
+func TestBackEnd_NvmeRemoteControllerPskNotLogged(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	psk := "NVMeTLSkey-1:01:MDAxMTIyMzM0NDU1NjY3Nzg4OTlhYWJiY2NkZGVlZmZwJEiQ:"
+	controllerWithPsk := server.ProtoClone(&testNvmeCtrl)
+	controllerWithPsk.Psk = []byte(psk)
+	tests := map[string]struct {
+		call func(*testEnv) error
+	}{
+		"create": {
+			call: func(env *testEnv) error {
+				_, err := env.client.CreateNvmeRemoteController(env.ctx, &pb.CreateNvmeRemoteControllerRequest{
+					NvmeRemoteController: controllerWithPsk, NvmeRemoteControllerId: testNvmeCtrlID})
+				return err
+			},
+		},
+		"invalid id": {
+			call: func(env *testEnv) error {
+				_, err := env.client.CreateNvmeRemoteController(env.ctx, &pb.CreateNvmeRemoteControllerRequest{
+					NvmeRemoteController: controllerWithPsk, NvmeRemoteControllerId: "CapitalLettersNotAllowed"})
+				return err
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var logs bytes.Buffer
+			log.SetOutput(server.NewRedactingWriter(&logs))
+			defer log.SetOutput(os.Stderr)
+			testEnv := createTestEnvironment([]string{})
+			defer testEnv.Close()
+
+			err := tt.call(testEnv)
+
+			if strings.Contains(logs.String(), psk) {
+				t.Errorf("psk found in logs:\n%v", logs.String())
+			}
+			if err != nil && strings.Contains(err.Error(), psk) {
+				t.Errorf("psk found in error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package backend implements the BackEnd APIs (network facing) of the storage Server
+package backend
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/opiproject/gospdk/spdk"
+	pc "github.com/opiproject/opi-api/common/v1/gen/go"
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
+
+	"github.com/google/uuid"
+	"go.einride.tech/aip/fieldbehavior"
+	"go.einride.tech/aip/resourcename"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func sortNvmeRemoteNamespaces(namespaces []*pb.NvmeRemoteNamespace) {
+	sort.Slice(namespaces, func(i int, j int) bool {
+		return namespaces[i].Name < namespaces[j].Name
+	})
+}
+
+// ListNvmeRemoteNamespaces lists namespaces of an Nvme remote controller
+func (s *Server) ListNvmeRemoteNamespaces(_ context.Context, in *pb.ListNvmeRemoteNamespacesRequest) (*pb.ListNvmeRemoteNamespacesResponse, error) {
+	log.Printf("ListNvmeRemoteNamespaces: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Parent); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if _, ok := s.Volumes.NvmeControllers[in.Parent]; !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Parent)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// fetch object from the database
+	size, offset, perr := server.ExtractPagination(in.PageSize, in.PageToken, s.Pagination)
+	if perr != nil {
+		log.Printf("error: %v", perr)
+		return nil, perr
+	}
+	if err := s.refreshNvmeRemoteNamespaces(in.Parent); err != nil {
+		return nil, err
+	}
+
+	Blobarray := []*pb.NvmeRemoteNamespace{}
+	for _, namespace := range s.Volumes.NvmeNamespaces {
+		if namespace.ControllerNameRef == in.Parent {
+			Blobarray = append(Blobarray, server.ProtoClone(namespace))
+		}
+	}
+	sortNvmeRemoteNamespaces(Blobarray)
+
+	token := ""
+	log.Printf("Limiting result len(%d) to [%d:%d]", len(Blobarray), offset, size)
+	Blobarray, hasMoreElements := server.LimitPagination(Blobarray, offset, size)
+	if hasMoreElements {
+		token = uuid.New().String()
+		s.Pagination[token] = offset + size
+	}
+	return &pb.ListNvmeRemoteNamespacesResponse{NvmeRemoteNamespaces: Blobarray, NextPageToken: token}, nil
+}
+
+// GetNvmeRemoteNamespace gets a namespace of an Nvme remote controller
+func (s *Server) GetNvmeRemoteNamespace(_ context.Context, in *pb.GetNvmeRemoteNamespaceRequest) (*pb.NvmeRemoteNamespace, error) {
+	log.Printf("GetNvmeRemoteNamespace: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// fetch object from the database
+	namespace, ok := s.Volumes.NvmeNamespaces[in.Name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if err := s.refreshNvmeRemoteNamespaces(namespace.ControllerNameRef); err != nil {
+		return nil, err
+	}
+	namespace, ok = s.Volumes.NvmeNamespaces[in.Name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+
+	response := server.ProtoClone(namespace)
+	return response, nil
+}
+
+// refreshNvmeRemoteNamespaces synchronizes namespaces of a controller with
+// bdevs in SPDK to catch namespaces added or removed on the remote target
+func (s *Server) refreshNvmeRemoteNamespaces(controllerName string) error {
+	if s.numberOfPathsForController(controllerName) == 0 {
+		s.setNvmeRemoteNamespaces(controllerName, nil)
+		return nil
+	}
+
+	var result []spdk.BdevGetBdevsResult
+	err := s.rpc.Call("bdev_get_bdevs", nil, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+
+	bdevs := []spdk.BdevGetBdevsResult{}
+	for i := range result {
+		if s.newNvmeRemoteNamespace(controllerName, result[i].Name) != nil {
+			bdevs = append(bdevs, result[i])
+		}
+	}
+	s.setNvmeRemoteNamespaces(controllerName, bdevs)
+	return nil
+}
+
+// nvmeRemoteNamespaceBdev gets the bdev SPDK created for a remote namespace
+func (s *Server) nvmeRemoteNamespaceBdev(bdevName string) (*spdk.BdevGetBdevsResult, error) {
+	params := spdk.BdevGetBdevsParams{
+		Name: bdevName,
+	}
+	var result []spdk.BdevGetBdevsResult
+	err := s.rpc.Call("bdev_get_bdevs", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if len(result) != 1 {
+		msg := fmt.Sprintf("expecting exactly 1 result, got %d", len(result))
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	return &result[0], nil
+}
+
+// setNvmeRemoteNamespaces replaces all namespaces known for a controller by
+// the namespaces of bdevs
+func (s *Server) setNvmeRemoteNamespaces(controllerName string, bdevs []spdk.BdevGetBdevsResult) {
+	known := make(map[string]bool)
+	for name, namespace := range s.Volumes.NvmeNamespaces {
+		if namespace.ControllerNameRef == controllerName {
+			delete(s.Volumes.NvmeNamespaces, name)
+			known[name] = true
+		}
+	}
+	for i := range bdevs {
+		namespace := s.addNvmeRemoteNamespace(controllerName, &bdevs[i])
+		delete(known, namespace.Name)
+	}
+	for name := range known {
+		// a namespace removed on the remote target stays registered while in use
+		s.unregisterVolume(name)
+	}
+}
+
+// addNvmeRemoteNamespace stores the namespace of a bdev of a controller and
+// registers it as a volume with the size of the bdev. The size of a known
+// namespace is updated, as it may be resized on the remote target
+func (s *Server) addNvmeRemoteNamespace(controllerName string, bdev *spdk.BdevGetBdevsResult) *pb.NvmeRemoteNamespace {
+	namespace := s.newNvmeRemoteNamespace(controllerName, bdev.Name)
+	if bdev.UUID != "" {
+		namespace.Uuid = &pc.Uuid{Value: bdev.UUID}
+	}
+	if _, err := s.registry.Get(namespace.Name); err != nil {
+		s.registerVolume(namespace.Name, volume.TypeNvmeRemoteNamespace, pb.NvmeRemoteControllerService_ServiceDesc.ServiceName, bdev.BlockSize, bdev.NumBlocks)
+	} else if err := s.registry.SetSize(namespace.Name, bdev.BlockSize, bdev.NumBlocks); err != nil {
+		log.Printf("error: %v", err)
+	}
+	s.Volumes.NvmeNamespaces[namespace.Name] = namespace
+	return namespace
+}
+
+// checkNvmeRemoteNamespacesUnused returns an error if any namespace of a
+// controller is in use
+func (s *Server) checkNvmeRemoteNamespacesUnused(controllerName string) error {
+	for name, namespace := range s.Volumes.NvmeNamespaces {
+		if namespace.ControllerNameRef != controllerName {
+			continue
+		}
+		if err := s.registry.CheckUnused(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newNvmeRemoteNamespace creates a namespace object for a bdev created by
+// SPDK for a remote namespace. SPDK names such bdevs <controller>n<nsid>,
+// nil is returned for bdevs which do not belong to the controller
+func (s *Server) newNvmeRemoteNamespace(controllerName string, bdevName string) *pb.NvmeRemoteNamespace {
+	prefix := path.Base(controllerName) + "n"
+	if !strings.HasPrefix(bdevName, prefix) {
+		return nil
+	}
+	nsid, err := strconv.ParseInt(strings.TrimPrefix(bdevName, prefix), 10, 32)
+	if err != nil || nsid <= 0 {
+		return nil
+	}
+	return &pb.NvmeRemoteNamespace{
+		Name:              server.ResourceIDToVolumeName(bdevName),
+		ControllerNameRef: controllerName,
+		Nsid:              int32(nsid),
+	}
+}
@@ -0,0 +1,302 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package backend implements the BackEnd APIs (network facing) of the storage Server
+package backend
+
+import (
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pc "github.com/opiproject/opi-api/common/v1/gen/go"
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+)
+
+var (
+	testNvmeNamespaceID   = testNvmeCtrlID + "n1"
+	testNvmeNamespaceName = server.ResourceIDToVolumeName(testNvmeNamespaceID)
+	testNvmeNamespace     = pb.NvmeRemoteNamespace{
+		Name:              testNvmeNamespaceName,
+		ControllerNameRef: testNvmeCtrlName,
+		Nsid:              1,
+	}
+)
+
+func TestBackEnd_ListNvmeRemoteNamespaces(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	tests := map[string]struct {
+		in       string
+		out      []*pb.NvmeRemoteNamespace
+		spdk     []string
+		errCode  codes.Code
+		errMsg   string
+		withPath bool
+	}{
+		"valid request with added namespace": {
+			in: testNvmeCtrlName,
+			out: []*pb.NvmeRemoteNamespace{
+				{Name: testNvmeNamespaceName, ControllerNameRef: testNvmeCtrlName, Nsid: 1},
+				{
+					Name:              server.ResourceIDToVolumeName(testNvmeCtrlID + "n2"),
+					ControllerNameRef: testNvmeCtrlName,
+					Nsid:              2,
+					Uuid:              &pc.Uuid{Value: "8f0b4b1d-8d7c-4b7b-9f0e-2d6b3b0f6a11"},
+				},
+			},
+			spdk: []string{`{"id":%d,"error":{"code":0,"message":""},"result":[` +
+				`{"name":"Malloc0"},` +
+				`{"name":"` + testNvmeCtrlID + `n1"},` +
+				`{"name":"` + testNvmeCtrlID + `n2","uuid":"8f0b4b1d-8d7c-4b7b-9f0e-2d6b3b0f6a11"}]}`},
+			errCode:  codes.OK,
+			errMsg:   "",
+			withPath: true,
+		},
+		"valid request with removed namespace": {
+			in:       testNvmeCtrlName,
+			out:      []*pb.NvmeRemoteNamespace{},
+			spdk:     []string{`{"id":%d,"error":{"code":0,"message":""},"result":[{"name":"Malloc0"}]}`},
+			errCode:  codes.OK,
+			errMsg:   "",
+			withPath: true,
+		},
+		"valid request without paths": {
+			in:      testNvmeCtrlName,
+			out:     []*pb.NvmeRemoteNamespace{},
+			spdk:    []string{},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"valid request with error code from SPDK response": {
+			in:       testNvmeCtrlName,
+			out:      nil,
+			spdk:     []string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":[]}`},
+			errCode:  codes.Unknown,
+			errMsg:   fmt.Sprintf("bdev_get_bdevs: %v", "json response error: myopierr"),
+			withPath: true,
+		},
+		"unknown controller": {
+			in:      server.ResourceIDToVolumeName("unknown"),
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %v", server.ResourceIDToVolumeName("unknown")),
+		},
+		"no required field": {
+			in:      "",
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.Unknown,
+			errMsg:  "missing required field: parent",
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			testEnv.opiSpdkServer.Volumes.NvmeControllers[testNvmeCtrlName] = server.ProtoClone(&testNvmeCtrl)
+			testEnv.opiSpdkServer.Volumes.NvmeControllers[testNvmeCtrlName].Name = testNvmeCtrlName
+			testEnv.opiSpdkServer.Volumes.NvmeNamespaces[testNvmeNamespaceName] = server.ProtoClone(&testNvmeNamespace)
+			if tt.withPath {
+				testEnv.opiSpdkServer.Volumes.NvmePaths[testNvmePathName] = server.ProtoClone(&testNvmePath)
+			}
+
+			request := &pb.ListNvmeRemoteNamespacesRequest{Parent: tt.in}
+			response, err := testEnv.client.ListNvmeRemoteNamespaces(testEnv.ctx, request)
+
+			if !server.EqualProtoSlices(response.GetNvmeRemoteNamespaces(), tt.out) {
+				t.Error("response: expected", tt.out, "received", response.GetNvmeRemoteNamespaces())
+			}
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+		})
+	}
+}
+
+func TestBackEnd_GetNvmeRemoteNamespace(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	tests := map[string]struct {
+		in      string
+		out     *pb.NvmeRemoteNamespace
+		spdk    []string
+		errCode codes.Code
+		errMsg  string
+	}{
+		"valid request": {
+			in:      testNvmeNamespaceName,
+			out:     &testNvmeNamespace,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":[{"name":"` + testNvmeNamespaceID + `"}]}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"namespace removed on remote target": {
+			in:      testNvmeNamespaceName,
+			out:     nil,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":[]}`},
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %v", testNvmeNamespaceName),
+		},
+		"valid request with unknown key": {
+			in:      server.ResourceIDToVolumeName("unknown"),
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %v", server.ResourceIDToVolumeName("unknown")),
+		},
+		"malformed name": {
+			in:      "-ABC-DEF",
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.Unknown,
+			errMsg:  fmt.Sprintf("segment '%s': not a valid DNS name", "-ABC-DEF"),
+		},
+		"no required field": {
+			in:      "",
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.Unknown,
+			errMsg:  "missing required field: name",
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			testEnv.opiSpdkServer.Volumes.NvmeControllers[testNvmeCtrlName] = server.ProtoClone(&testNvmeCtrl)
+			testEnv.opiSpdkServer.Volumes.NvmeControllers[testNvmeCtrlName].Name = testNvmeCtrlName
+			testEnv.opiSpdkServer.Volumes.NvmePaths[testNvmePathName] = server.ProtoClone(&testNvmePath)
+			testEnv.opiSpdkServer.Volumes.NvmeNamespaces[testNvmeNamespaceName] = server.ProtoClone(&testNvmeNamespace)
+
+			request := &pb.GetNvmeRemoteNamespaceRequest{Name: tt.in}
+			response, err := testEnv.client.GetNvmeRemoteNamespace(testEnv.ctx, request)
+
+			if !proto.Equal(response, tt.out) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+		})
+	}
+}
+
+func TestBackEnd_NvmeRemoteNamespaceSize(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	tests := map[string]struct {
+		call        string
+		spdk        []string
+		errCode     codes.Code
+		errMsg      string
+		blockSize   int64
+		blocksCount int64
+	}{
+		"path attaching namespace": {
+			call: "create",
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":["` + testNvmeNamespaceID + `"]}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":[{"name":"` + testNvmeNamespaceID + `","block_size":4096,"num_blocks":1024}]}`,
+			},
+			errCode:     codes.OK,
+			errMsg:      "",
+			blockSize:   4096,
+			blocksCount: 1024,
+		},
+		"path attaching namespace without bdev": {
+			call: "create",
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":["` + testNvmeNamespaceID + `"]}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":[]}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode: codes.InvalidArgument,
+			errMsg:  "expecting exactly 1 result, got 0",
+		},
+		"namespace resized on remote target": {
+			call: "list",
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":[{"name":"` + testNvmeNamespaceID + `","block_size":512,"num_blocks":2048}]}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":[{"name":"` + testNvmeNamespaceID + `","block_size":512,"num_blocks":4096}]}`,
+			},
+			errCode:     codes.OK,
+			errMsg:      "",
+			blockSize:   512,
+			blocksCount: 4096,
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			testEnv.opiSpdkServer.Volumes.NvmeControllers[testNvmeCtrlName] = server.ProtoClone(&testNvmeCtrl)
+			testEnv.opiSpdkServer.Volumes.NvmeControllers[testNvmeCtrlName].Name = testNvmeCtrlName
+
+			var err error
+			switch tt.call {
+			case "create":
+				request := &pb.CreateNvmePathRequest{NvmePath: &testNvmePath, NvmePathId: testNvmePathID}
+				_, err = testEnv.client.CreateNvmePath(testEnv.ctx, request)
+			case "list":
+				testEnv.opiSpdkServer.Volumes.NvmePaths[testNvmePathName] = server.ProtoClone(&testNvmePath)
+				request := &pb.ListNvmeRemoteNamespacesRequest{Parent: testNvmeCtrlName}
+				if _, err = testEnv.client.ListNvmeRemoteNamespaces(testEnv.ctx, request); err == nil {
+					_, err = testEnv.client.ListNvmeRemoteNamespaces(testEnv.ctx, request)
+				}
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+
+			vol, err := testEnv.opiSpdkServer.registry.Get(testNvmeNamespaceName)
+			if tt.errCode != codes.OK {
+				if err == nil {
+					t.Error("volume: expected none, received", vol)
+				}
+				if _, ok := testEnv.opiSpdkServer.Volumes.NvmePaths[testNvmePathName]; ok {
+					t.Error("path: expected none after failure")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if vol.BlockSize != tt.blockSize || vol.BlocksCount != tt.blocksCount {
+				t.Error("size: expected", tt.blockSize, tt.blocksCount, "received", vol.BlockSize, vol.BlocksCount)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package backend implements the BackEnd APIs (network facing) of the storage Server
+package backend
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+
+	"go.einride.tech/aip/fieldbehavior"
+	"go.einride.tech/aip/resourcename"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// bdevNvmeSetOptionsParams holds the global bdev_nvme options set by the bridge.
+// Omitted fields keep their current values in SPDK
+type bdevNvmeSetOptionsParams struct {
+	CtrlrLossTimeoutSec  *int32   `json:"ctrlr_loss_timeout_sec,omitempty"`
+	ReconnectDelaySec    *uint32  `json:"reconnect_delay_sec,omitempty"`
+	FastIoFailTimeoutSec *uint32  `json:"fast_io_fail_timeout_sec,omitempty"`
+	KeepAliveTimeoutMs   *uint32  `json:"keep_alive_timeout_ms,omitempty"`
+	DhchapDigests        []string `json:"dhchap_digests,omitempty"`
+	DhchapDhgroups       []string `json:"dhchap_dhgroups,omitempty"`
+}
+
+// bdevNvmeSetOptionsResult is the result of setting global bdev_nvme options
+type bdevNvmeSetOptionsResult bool
+
+// verifyNvmeReconnectOptions checks relations between the reconnect options
+// the same way SPDK does
+func verifyNvmeReconnectOptions(o *bridgepb.NvmeReconnectOptions) error {
+	ctrlrLossTimeoutSec := o.GetCtrlrLossTimeoutSec()
+	reconnectDelaySec := o.GetReconnectDelaySec()
+	fastIoFailTimeoutSec := o.GetFastIoFailTimeoutSec()
+	switch {
+	case ctrlrLossTimeoutSec < -1:
+		return fmt.Errorf("ctrlr_loss_timeout_sec cannot be less than -1")
+	case ctrlrLossTimeoutSec == 0:
+		if reconnectDelaySec != 0 || fastIoFailTimeoutSec != 0 {
+			return fmt.Errorf("reconnect_delay_sec and fast_io_fail_timeout_sec must be 0 if ctrlr_loss_timeout_sec is 0")
+		}
+		return nil
+	case reconnectDelaySec == 0:
+		return fmt.Errorf("reconnect_delay_sec cannot be 0 if ctrlr_loss_timeout_sec is not 0")
+	}
+
+	if ctrlrLossTimeoutSec > 0 && reconnectDelaySec > uint32(ctrlrLossTimeoutSec) {
+		return fmt.Errorf("reconnect_delay_sec cannot be more than ctrlr_loss_timeout_sec")
+	}
+	if fastIoFailTimeoutSec != 0 {
+		if fastIoFailTimeoutSec < reconnectDelaySec {
+			return fmt.Errorf("reconnect_delay_sec cannot be more than fast_io_fail_timeout_sec")
+		}
+		if ctrlrLossTimeoutSec > 0 && fastIoFailTimeoutSec > uint32(ctrlrLossTimeoutSec) {
+			return fmt.Errorf("fast_io_fail_timeout_sec cannot be more than ctrlr_loss_timeout_sec")
+		}
+	}
+	return nil
+}
+
+// SetNvmeOptions sets defaults for all remote controllers. SPDK accepts
+// them only while no remote controllers are attached
+func (s *Server) SetNvmeOptions(_ context.Context, in *bridgepb.SetNvmeOptionsRequest) (*emptypb.Empty, error) {
+	log.Printf("SetNvmeOptions: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if err := verifyNvmeReconnectOptions(in.Reconnect); err != nil {
+		log.Printf("error: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(s.Volumes.NvmePaths) > 0 {
+		msg := "nvme options cannot be changed while NvmePaths exist"
+		log.Print(msg)
+		return nil, status.Error(codes.FailedPrecondition, msg)
+	}
+
+	ctrlrLossTimeoutSec := in.Reconnect.GetCtrlrLossTimeoutSec()
+	reconnectDelaySec := in.Reconnect.GetReconnectDelaySec()
+	fastIoFailTimeoutSec := in.Reconnect.GetFastIoFailTimeoutSec()
+	params := bdevNvmeSetOptionsParams{
+		CtrlrLossTimeoutSec:  &ctrlrLossTimeoutSec,
+		ReconnectDelaySec:    &reconnectDelaySec,
+		FastIoFailTimeoutSec: &fastIoFailTimeoutSec,
+		KeepAliveTimeoutMs:   &in.KeepAliveTimeoutMs,
+	}
+	var result bdevNvmeSetOptionsResult
+	err := s.rpc.Call("bdev_nvme_set_options", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := "Could not set nvme options"
+		log.Print(msg)
+		return nil, status.Error(codes.InvalidArgument, msg)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// SetNvmeRemoteControllerReconnect sets reconnect options passed on every
+// path attach of a remote controller instead of the global defaults. The
+// options cannot be changed while the controller has paths
+func (s *Server) SetNvmeRemoteControllerReconnect(_ context.Context, in *bridgepb.SetNvmeRemoteControllerReconnectRequest) (*emptypb.Empty, error) {
+	log.Printf("SetNvmeRemoteControllerReconnect: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	controller, ok := s.Volumes.NvmeControllers[in.Name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find NvmeRemoteController by key %s", in.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if err := verifyNvmeReconnectOptions(in.Reconnect); err != nil {
+		log.Printf("error: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if s.numberOfPathsForController(controller.Name) > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "NvmePaths exist for controller")
+	}
+
+	if in.Reconnect == nil {
+		delete(s.reconnect, controller.Name)
+		return &emptypb.Empty{}, nil
+	}
+	s.reconnect[controller.Name] = server.ProtoClone(in.Reconnect)
+	return &emptypb.Empty{}, nil
+}
+
+// GetNvmeRemoteControllerReconnect returns reconnect options of a remote
+// controller, which are not set if it uses the global defaults
+func (s *Server) GetNvmeRemoteControllerReconnect(_ context.Context, in *bridgepb.GetNvmeRemoteControllerReconnectRequest) (*bridgepb.GetNvmeRemoteControllerReconnectResponse, error) {
+	log.Printf("GetNvmeRemoteControllerReconnect: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if _, ok := s.Volumes.NvmeControllers[in.Name]; !ok {
+		err := status.Errorf(codes.NotFound, "unable to find NvmeRemoteController by key %s", in.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	response := &bridgepb.GetNvmeRemoteControllerReconnectResponse{}
+	if options, ok := s.reconnect[in.Name]; ok {
+		response.Reconnect = server.ProtoClone(options)
+	}
+	return response, nil
+}
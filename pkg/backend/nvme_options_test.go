@@ -0,0 +1,221 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package backend implements the BackEnd APIs (network facing) of the storage Server
+package backend
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+)
+
+func TestBackEnd_verifyNvmeReconnectOptions(t *testing.T) {
+	tests := map[string]struct {
+		in     *bridgepb.NvmeReconnectOptions
+		errMsg string
+	}{
+		"no options": {
+			in:     nil,
+			errMsg: "",
+		},
+		"reconnect disabled": {
+			in:     &bridgepb.NvmeReconnectOptions{},
+			errMsg: "",
+		},
+		"infinite reconnect": {
+			in:     &bridgepb.NvmeReconnectOptions{CtrlrLossTimeoutSec: -1, ReconnectDelaySec: 5, FastIoFailTimeoutSec: 10},
+			errMsg: "",
+		},
+		"bounded reconnect": {
+			in:     &bridgepb.NvmeReconnectOptions{CtrlrLossTimeoutSec: 30, ReconnectDelaySec: 5, FastIoFailTimeoutSec: 10},
+			errMsg: "",
+		},
+		"ctrlr loss timeout less than -1": {
+			in:     &bridgepb.NvmeReconnectOptions{CtrlrLossTimeoutSec: -2, ReconnectDelaySec: 5},
+			errMsg: "ctrlr_loss_timeout_sec cannot be less than -1",
+		},
+		"reconnect delay without ctrlr loss timeout": {
+			in:     &bridgepb.NvmeReconnectOptions{ReconnectDelaySec: 5},
+			errMsg: "reconnect_delay_sec and fast_io_fail_timeout_sec must be 0 if ctrlr_loss_timeout_sec is 0",
+		},
+		"zero reconnect delay": {
+			in:     &bridgepb.NvmeReconnectOptions{CtrlrLossTimeoutSec: 30},
+			errMsg: "reconnect_delay_sec cannot be 0 if ctrlr_loss_timeout_sec is not 0",
+		},
+		"reconnect delay above ctrlr loss timeout": {
+			in:     &bridgepb.NvmeReconnectOptions{CtrlrLossTimeoutSec: 3, ReconnectDelaySec: 5},
+			errMsg: "reconnect_delay_sec cannot be more than ctrlr_loss_timeout_sec",
+		},
+		"fast io fail below reconnect delay": {
+			in:     &bridgepb.NvmeReconnectOptions{CtrlrLossTimeoutSec: 30, ReconnectDelaySec: 5, FastIoFailTimeoutSec: 2},
+			errMsg: "reconnect_delay_sec cannot be more than fast_io_fail_timeout_sec",
+		},
+		"fast io fail above ctrlr loss timeout": {
+			in:     &bridgepb.NvmeReconnectOptions{CtrlrLossTimeoutSec: 30, ReconnectDelaySec: 5, FastIoFailTimeoutSec: 60},
+			errMsg: "fast_io_fail_timeout_sec cannot be more than ctrlr_loss_timeout_sec",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := verifyNvmeReconnectOptions(tt.in)
+			errMsg := ""
+			if err != nil {
+				errMsg = err.Error()
+			}
+			if errMsg != tt.errMsg {
+				t.Error("error: expected", tt.errMsg, "received", errMsg)
+			}
+		})
+	}
+}
+
+func TestBackEnd_SetNvmeOptions(t *testing.T) {
+	tests := map[string]struct {
+		in       *bridgepb.SetNvmeOptionsRequest
+		spdk     []string
+		errCode  codes.Code
+		errMsg   string
+		withPath bool
+	}{
+		"valid request": {
+			in: &bridgepb.SetNvmeOptionsRequest{
+				Reconnect:          &bridgepb.NvmeReconnectOptions{CtrlrLossTimeoutSec: -1, ReconnectDelaySec: 2},
+				KeepAliveTimeoutMs: 10000,
+			},
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode: codes.OK,
+		},
+		"invalid reconnect options": {
+			in:      &bridgepb.SetNvmeOptionsRequest{Reconnect: &bridgepb.NvmeReconnectOptions{CtrlrLossTimeoutSec: 30}},
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  "reconnect_delay_sec cannot be 0 if ctrlr_loss_timeout_sec is not 0",
+		},
+		"paths exist": {
+			in:       &bridgepb.SetNvmeOptionsRequest{},
+			spdk:     []string{},
+			errCode:  codes.FailedPrecondition,
+			errMsg:   "nvme options cannot be changed while NvmePaths exist",
+			withPath: true,
+		},
+		"SPDK false result": {
+			in:      &bridgepb.SetNvmeOptionsRequest{},
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  "Could not set nvme options",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			if tt.withPath {
+				testEnv.opiSpdkServer.Volumes.NvmePaths[testNvmePathName] = server.ProtoClone(&testNvmePath)
+			}
+
+			_, err := testEnv.client.SetNvmeOptions(testEnv.ctx, tt.in)
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+		})
+	}
+}
+
+func TestBackEnd_SetNvmeRemoteControllerReconnect(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	tests := map[string]struct {
+		name     string
+		in       *bridgepb.NvmeReconnectOptions
+		errCode  codes.Code
+		errMsg   string
+		withPath bool
+		expected *bridgepb.NvmeReconnectOptions
+	}{
+		"unknown controller": {
+			name:    server.ResourceIDToVolumeName("unknown"),
+			in:      &bridgepb.NvmeReconnectOptions{},
+			errCode: codes.NotFound,
+			errMsg:  "unable to find NvmeRemoteController by key " + server.ResourceIDToVolumeName("unknown"),
+		},
+		"invalid options": {
+			name:    testNvmeCtrlName,
+			in:      &bridgepb.NvmeReconnectOptions{CtrlrLossTimeoutSec: -2},
+			errCode: codes.InvalidArgument,
+			errMsg:  "ctrlr_loss_timeout_sec cannot be less than -1",
+		},
+		"paths exist": {
+			name:     testNvmeCtrlName,
+			in:       &bridgepb.NvmeReconnectOptions{CtrlrLossTimeoutSec: 30, ReconnectDelaySec: 5},
+			errCode:  codes.FailedPrecondition,
+			errMsg:   "NvmePaths exist for controller",
+			withPath: true,
+		},
+		"valid request": {
+			name:     testNvmeCtrlName,
+			in:       &bridgepb.NvmeReconnectOptions{CtrlrLossTimeoutSec: 30, ReconnectDelaySec: 5, FastIoFailTimeoutSec: 10},
+			errCode:  codes.OK,
+			expected: &bridgepb.NvmeReconnectOptions{CtrlrLossTimeoutSec: 30, ReconnectDelaySec: 5, FastIoFailTimeoutSec: 10},
+		},
+		"reset to defaults": {
+			name:     testNvmeCtrlName,
+			in:       nil,
+			errCode:  codes.OK,
+			expected: nil,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment([]string{})
+			defer testEnv.Close()
+
+			testEnv.opiSpdkServer.Volumes.NvmeControllers[testNvmeCtrlName] = server.ProtoClone(&testNvmeCtrl)
+			testEnv.opiSpdkServer.Volumes.NvmeControllers[testNvmeCtrlName].Name = testNvmeCtrlName
+			if tt.withPath {
+				testEnv.opiSpdkServer.Volumes.NvmePaths[testNvmePathName] = server.ProtoClone(&testNvmePath)
+			}
+
+			request := &bridgepb.SetNvmeRemoteControllerReconnectRequest{Name: tt.name, Reconnect: tt.in}
+			_, err := testEnv.client.SetNvmeRemoteControllerReconnect(testEnv.ctx, request)
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+
+			if tt.errCode == codes.OK {
+				request := &bridgepb.GetNvmeRemoteControllerReconnectRequest{Name: tt.name}
+				response, err := testEnv.client.GetNvmeRemoteControllerReconnect(testEnv.ctx, request)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !proto.Equal(response.Reconnect, tt.expected) {
+					t.Error("options: expected", tt.expected, "received", response.Reconnect)
+				}
+			}
+		})
+	}
+}
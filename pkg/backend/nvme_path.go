@@ -28,6 +28,18 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+// bdevNvmeAttachControllerParams extends spdk.BdevNvmeAttachControllerParams
+// with parameters not yet available there
+type bdevNvmeAttachControllerParams struct {
+	spdk.BdevNvmeAttachControllerParams
+	DhchapKey      string `json:"dhchap_key,omitempty"`
+	DhchapCtrlrKey string `json:"dhchap_ctrlr_key,omitempty"`
+
+	CtrlrLossTimeoutSec  *int32  `json:"ctrlr_loss_timeout_sec,omitempty"`
+	ReconnectDelaySec    *uint32 `json:"reconnect_delay_sec,omitempty"`
+	FastIoFailTimeoutSec *uint32 `json:"fast_io_fail_timeout_sec,omitempty"`
+}
+
 func sortNvmePaths(paths []*pb.NvmePath) {
 	sort.Slice(paths, func(i int, j int) bool {
 		return paths[i].Subnqn < paths[j].Subnqn
@@ -36,7 +48,7 @@ func sortNvmePaths(paths []*pb.NvmePath) {
 
 // CreateNvmePath creates a new Nvme path
 func (s *Server) CreateNvmePath(_ context.Context, in *pb.CreateNvmePathRequest) (*pb.NvmePath, error) {
-	log.Printf("CreateNvmePath: Received from client: %v", in)
+	log.Printf("CreateNvmePath: Received from client: %v", server.Redact(in))
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
 		return nil, err
@@ -74,7 +86,7 @@ func (s *Server) CreateNvmePath(_ context.Context, in *pb.CreateNvmePathRequest)
 	}
 	psk := ""
 	if len(controller.Psk) > 0 {
-		log.Printf("Notice, TLS is used to establish connection: to %v", in.NvmePath)
+		log.Printf("Notice, TLS is used to establish connection: to %v", server.Redact(in.NvmePath))
 		keyFile, err := s.keyToTemporaryFile(controller.Psk)
 		if err != nil {
 			return nil, err
@@ -86,18 +98,30 @@ func (s *Server) CreateNvmePath(_ context.Context, in *pb.CreateNvmePathRequest)
 
 		psk = keyFile
 	}
-	params := spdk.BdevNvmeAttachControllerParams{
-		Name:      path.Base(controller.Name),
-		Trtype:    s.opiTransportToSpdk(in.NvmePath.Trtype),
-		Traddr:    in.NvmePath.Traddr,
-		Adrfam:    s.opiAdressFamilyToSpdk(in.NvmePath.Adrfam),
-		Trsvcid:   fmt.Sprint(in.NvmePath.Trsvcid),
-		Subnqn:    in.NvmePath.Subnqn,
-		Hostnqn:   in.NvmePath.Hostnqn,
-		Multipath: multipath,
-		Hdgst:     controller.Hdgst,
-		Ddgst:     controller.Ddgst,
-		Psk:       psk,
+	params := bdevNvmeAttachControllerParams{
+		BdevNvmeAttachControllerParams: spdk.BdevNvmeAttachControllerParams{
+			Name:      path.Base(controller.Name),
+			Trtype:    s.opiTransportToSpdk(in.NvmePath.Trtype),
+			Traddr:    in.NvmePath.Traddr,
+			Adrfam:    s.opiAdressFamilyToSpdk(in.NvmePath.Adrfam),
+			Trsvcid:   fmt.Sprint(in.NvmePath.Trsvcid),
+			Subnqn:    in.NvmePath.Subnqn,
+			Hostnqn:   in.NvmePath.Hostnqn,
+			Multipath: multipath,
+			Hdgst:     controller.Hdgst,
+			Ddgst:     controller.Ddgst,
+			Psk:       psk,
+		},
+	}
+	if keys, ok := s.dhchap.keys[controller.Name]; ok {
+		log.Printf("Notice, DH-HMAC-CHAP is used to authenticate: to %v", server.Redact(in.NvmePath))
+		params.DhchapKey = keys.hostKey
+		params.DhchapCtrlrKey = keys.ctrlrKey
+	}
+	if options, ok := s.reconnect[controller.Name]; ok {
+		params.CtrlrLossTimeoutSec = &options.CtrlrLossTimeoutSec
+		params.ReconnectDelaySec = &options.ReconnectDelaySec
+		params.FastIoFailTimeoutSec = &options.FastIoFailTimeoutSec
 	}
 	var result []spdk.BdevNvmeAttachControllerResult
 	err := s.rpc.Call("bdev_nvme_attach_controller", &params, &result)
@@ -107,15 +131,35 @@ func (s *Server) CreateNvmePath(_ context.Context, in *pb.CreateNvmePathRequest)
 	}
 	log.Printf("Received from SPDK: %v", result)
 
+	// namespaces are registered with their size, so the path is detached
+	// again if the size cannot be found
+	bdevs := []spdk.BdevGetBdevsResult{}
+	for _, bdevName := range result {
+		if s.newNvmeRemoteNamespace(controller.Name, string(bdevName)) == nil {
+			continue
+		}
+		bdev, err := s.nvmeRemoteNamespaceBdev(string(bdevName))
+		if err != nil {
+			if err := s.detachNvmePath(in.NvmePath.Name, controller, in.NvmePath); err != nil {
+				log.Printf("error: failed to detach %v: %v", in.NvmePath.Name, err)
+			}
+			return nil, err
+		}
+		bdevs = append(bdevs, *bdev)
+	}
+	for i := range bdevs {
+		s.addNvmeRemoteNamespace(controller.Name, &bdevs[i])
+	}
+
 	response := server.ProtoClone(in.NvmePath)
 	s.Volumes.NvmePaths[in.NvmePath.Name] = response
-	log.Printf("CreateNvmePath: Sending to client: %v", response)
+	log.Printf("CreateNvmePath: Sending to client: %v", server.Redact(response))
 	return response, nil
 }
 
 // DeleteNvmePath deletes a Nvme path
 func (s *Server) DeleteNvmePath(_ context.Context, in *pb.DeleteNvmePathRequest) (*emptypb.Empty, error) {
-	log.Printf("DeleteNvmePath: Received from client: %v", in)
+	log.Printf("DeleteNvmePath: Received from client: %v", server.Redact(in))
 
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
@@ -144,7 +188,29 @@ func (s *Server) DeleteNvmePath(_ context.Context, in *pb.DeleteNvmePathRequest)
 		log.Printf("error: %v", err)
 		return nil, err
 	}
+	if s.numberOfPathsForController(controller.Name) == 1 {
+		// the last path takes all namespaces of the controller with it
+		if err := s.checkNvmeRemoteNamespacesUnused(controller.Name); err != nil {
+			log.Printf("error: %v", err)
+			return nil, err
+		}
+	}
 
+	if err := s.detachNvmePath(in.Name, controller, nvmePath); err != nil {
+		return nil, err
+	}
+
+	delete(s.Volumes.NvmePaths, in.Name)
+	if s.numberOfPathsForController(controller.Name) == 0 {
+		// SPDK deletes the controller with all its bdevs along with the last path
+		s.setNvmeRemoteNamespaces(controller.Name, nil)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// detachNvmePath detaches path name of a controller in SPDK
+func (s *Server) detachNvmePath(name string, controller *pb.NvmeRemoteController, nvmePath *pb.NvmePath) error {
 	params := spdk.BdevNvmeDetachControllerParams{
 		Name:    path.Base(controller.Name),
 		Trtype:  s.opiTransportToSpdk(nvmePath.Trtype),
@@ -158,23 +224,20 @@ func (s *Server) DeleteNvmePath(_ context.Context, in *pb.DeleteNvmePathRequest)
 	err := s.rpc.Call("bdev_nvme_detach_controller", &params, &result)
 	if err != nil {
 		log.Printf("error: %v", err)
-		return nil, err
+		return err
 	}
 	log.Printf("Received from SPDK: %v", result)
 	if !result {
-		msg := fmt.Sprintf("Could not delete Nvme Path: %s", path.Base(in.Name))
+		msg := fmt.Sprintf("Could not delete Nvme Path: %s", path.Base(name))
 		log.Print(msg)
-		return nil, status.Errorf(codes.InvalidArgument, msg)
+		return status.Errorf(codes.InvalidArgument, msg)
 	}
-
-	delete(s.Volumes.NvmePaths, in.Name)
-
-	return &emptypb.Empty{}, nil
+	return nil
 }
 
 // UpdateNvmePath updates an Nvme path
 func (s *Server) UpdateNvmePath(_ context.Context, in *pb.UpdateNvmePathRequest) (*pb.NvmePath, error) {
-	log.Printf("UpdateNvmePath: Received from client: %v", in)
+	log.Printf("UpdateNvmePath: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -209,7 +272,7 @@ func (s *Server) UpdateNvmePath(_ context.Context, in *pb.UpdateNvmePathRequest)
 
 // ListNvmePaths lists Nvme path
 func (s *Server) ListNvmePaths(_ context.Context, in *pb.ListNvmePathsRequest) (*pb.ListNvmePathsResponse, error) {
-	log.Printf("ListNvmePaths: Received from client: %v", in)
+	log.Printf("ListNvmePaths: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -246,7 +309,7 @@ func (s *Server) ListNvmePaths(_ context.Context, in *pb.ListNvmePathsRequest) (
 
 // GetNvmePath gets Nvme path
 func (s *Server) GetNvmePath(_ context.Context, in *pb.GetNvmePathRequest) (*pb.NvmePath, error) {
-	log.Printf("GetNvmePath: Received from client: %v", in)
+	log.Printf("GetNvmePath: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -286,7 +349,7 @@ func (s *Server) GetNvmePath(_ context.Context, in *pb.GetNvmePathRequest) (*pb.
 
 // StatsNvmePath gets Nvme path stats
 func (s *Server) StatsNvmePath(_ context.Context, in *pb.StatsNvmePathRequest) (*pb.StatsNvmePathResponse, error) {
-	log.Printf("StatsNvmePath: Received from client: %v", in)
+	log.Printf("StatsNvmePath: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
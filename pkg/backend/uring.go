@@ -0,0 +1,366 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package backend implements the BackEnd APIs (network facing) of the storage Server
+package backend
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+
+	"github.com/opiproject/gospdk/spdk"
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+
+	"github.com/google/uuid"
+	"go.einride.tech/aip/fieldbehavior"
+	"go.einride.tech/aip/fieldmask"
+	"go.einride.tech/aip/resourceid"
+	"go.einride.tech/aip/resourcename"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// aio keeps track of Aio volumes created as io_uring bdevs
+type aio struct {
+	overUring   bool
+	uringBacked map[string]bool
+}
+
+// bdevUringDeleteParams holds the parameters required to delete an io_uring Block Device
+type bdevUringDeleteParams struct {
+	Name string `json:"name"`
+}
+
+// bdevUringDeleteResult is the result of deleting an io_uring Block Device
+type bdevUringDeleteResult bool
+
+// rpcGetMethodsResult is the list of RPC methods supported by SPDK
+type rpcGetMethodsResult []string
+
+// EnableAioOverUring makes Aio volumes be created as io_uring bdevs if
+// SPDK supports them. Returns if io_uring is used. Volumes created
+// before keep their bdev type
+func (s *Server) EnableAioOverUring() (bool, error) {
+	var result rpcGetMethodsResult
+	err := s.rpc.Call("rpc_get_methods", nil, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return false, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	s.aio.overUring = false
+	for _, method := range result {
+		if method == "bdev_uring_create" {
+			s.aio.overUring = true
+			break
+		}
+	}
+	if !s.aio.overUring {
+		log.Print("bdev_uring_create is not supported by SPDK, using bdev_aio_create for Aio volumes")
+	}
+	return s.aio.overUring, nil
+}
+
+// opi-api has no dedicated io_uring messages. Uring volumes are described
+// by the Aio messages, since both are bdevs on top of a file or a block device
+
+// CreateUringVolume creates an io_uring volume
+func (s *Server) CreateUringVolume(_ context.Context, in *pb.CreateAioVolumeRequest) (*pb.AioVolume, error) {
+	log.Printf("CreateUringVolume: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// see https://google.aip.dev/133#user-specified-ids
+	resourceID := resourceid.NewSystemGenerated()
+	if in.AioVolumeId != "" {
+		err := resourceid.ValidateUserSettable(in.AioVolumeId)
+		if err != nil {
+			log.Printf("error: %v", err)
+			return nil, err
+		}
+		log.Printf("client provided the ID of a resource %v, ignoring the name field %v", in.AioVolumeId, in.AioVolume.Name)
+		resourceID = in.AioVolumeId
+	}
+	in.AioVolume.Name = server.ResourceIDToVolumeName(resourceID)
+	// idempotent API when called with same key, should return same object
+	volume, ok := s.Volumes.UringVolumes[in.AioVolume.Name]
+	if ok {
+		log.Printf("Already existing UringVolume with id %v", in.AioVolume.Name)
+		return volume, nil
+	}
+	if err := s.checkVolumeNameUnused(in.AioVolume.Name); err != nil {
+		return nil, err
+	}
+	// not found, so create a new one
+	blockSize, err := fileBdevBlockSize(in.AioVolume)
+	if err != nil {
+		return nil, err
+	}
+	params := spdk.BdevAioCreateParams{
+		Name:      resourceID,
+		BlockSize: int(blockSize),
+		Filename:  in.AioVolume.Filename,
+	}
+	var result spdk.BdevAioCreateResult
+	err = s.rpc.Call("bdev_uring_create", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if result == "" {
+		msg := fmt.Sprintf("Could not create Uring Dev: %s", params.Name)
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	response := server.ProtoClone(in.AioVolume)
+	response.BlockSize = blockSize
+	s.Volumes.UringVolumes[in.AioVolume.Name] = response
+	s.registerUringVolume(response.Name, response.BlockSize, response.BlocksCount)
+	log.Printf("CreateUringVolume: Sending to client: %v", server.Redact(response))
+	return server.ProtoClone(response), nil
+}
+
+// DeleteUringVolume deletes an io_uring volume
+func (s *Server) DeleteUringVolume(_ context.Context, in *pb.DeleteAioVolumeRequest) (*emptypb.Empty, error) {
+	log.Printf("DeleteUringVolume: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// fetch object from the database
+	volume, ok := s.Volumes.UringVolumes[in.Name]
+	if !ok {
+		if in.AllowMissing {
+			return &emptypb.Empty{}, nil
+		}
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if err := s.registry.CheckUnused(volume.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	resourceID := path.Base(volume.Name)
+	params := bdevUringDeleteParams{
+		Name: resourceID,
+	}
+	var result bdevUringDeleteResult
+	err := s.rpc.Call("bdev_uring_delete", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not delete Uring Dev: %s", params.Name)
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	delete(s.Volumes.UringVolumes, volume.Name)
+	s.unregisterVolume(volume.Name)
+	return &emptypb.Empty{}, nil
+}
+
+// UpdateUringVolume updates an io_uring volume. Only a grown backing file
+// is picked up, other fields cannot be changed
+func (s *Server) UpdateUringVolume(_ context.Context, in *pb.UpdateAioVolumeRequest) (*pb.AioVolume, error) {
+	log.Printf("UpdateUringVolume: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.AioVolume.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// fetch object from the database
+	volume, ok := s.Volumes.UringVolumes[in.AioVolume.Name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.AioVolume.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	resourceID := path.Base(volume.Name)
+	// update_mask = 2
+	if err := fieldmask.Validate(in.UpdateMask, in.AioVolume); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if err := s.checkAioVolumeImmutableFields(volume, in.AioVolume, in.UpdateMask); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	params := bdevAioRescanParams{
+		Name: resourceID,
+	}
+	var result bdevAioRescanResult
+	err := s.rpc.Call("bdev_uring_rescan", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not rescan Uring Dev: %s", params.Name)
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	params2 := spdk.BdevGetBdevsParams{
+		Name: resourceID,
+	}
+	var result2 []spdk.BdevGetBdevsResult
+	err = s.rpc.Call("bdev_get_bdevs", &params2, &result2)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result2)
+	if len(result2) != 1 {
+		msg := fmt.Sprintf("expecting exactly 1 result, got %d", len(result2))
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	volume.BlocksCount = result2[0].NumBlocks
+	if err := s.registry.SetSize(volume.Name, volume.BlockSize, volume.BlocksCount); err != nil {
+		log.Printf("error: %v", err)
+	}
+	response := server.ProtoClone(volume)
+	log.Printf("UpdateUringVolume: Sending to client: %v", server.Redact(response))
+	return response, nil
+}
+
+// ListUringVolumes lists io_uring volumes
+func (s *Server) ListUringVolumes(_ context.Context, in *pb.ListAioVolumesRequest) (*pb.ListAioVolumesResponse, error) {
+	log.Printf("ListUringVolumes: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// fetch object from the database
+	size, offset, perr := server.ExtractPagination(in.PageSize, in.PageToken, s.Pagination)
+	if perr != nil {
+		log.Printf("error: %v", perr)
+		return nil, perr
+	}
+
+	Blobarray := []*pb.AioVolume{}
+	for _, volume := range s.Volumes.UringVolumes {
+		Blobarray = append(Blobarray, server.ProtoClone(volume))
+	}
+	sortAioVolumes(Blobarray)
+
+	token := ""
+	log.Printf("Limiting result len(%d) to [%d:%d]", len(Blobarray), offset, size)
+	Blobarray, hasMoreElements := server.LimitPagination(Blobarray, offset, size)
+	if hasMoreElements {
+		token = uuid.New().String()
+		s.Pagination[token] = offset + size
+	}
+	return &pb.ListAioVolumesResponse{AioVolumes: Blobarray, NextPageToken: token}, nil
+}
+
+// GetUringVolume gets an io_uring volume
+func (s *Server) GetUringVolume(_ context.Context, in *pb.GetAioVolumeRequest) (*pb.AioVolume, error) {
+	log.Printf("GetUringVolume: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// fetch object from the database
+	volume, ok := s.Volumes.UringVolumes[in.Name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	resourceID := path.Base(volume.Name)
+	params := spdk.BdevGetBdevsParams{
+		Name: resourceID,
+	}
+	var result []spdk.BdevGetBdevsResult
+	err := s.rpc.Call("bdev_get_bdevs", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if len(result) != 1 {
+		msg := fmt.Sprintf("expecting exactly 1 result, got %d", len(result))
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	response := server.ProtoClone(volume)
+	response.BlockSize = result[0].BlockSize
+	response.BlocksCount = result[0].NumBlocks
+	return response, nil
+}
+
+// StatsUringVolume gets an io_uring volume stats. Counters saturate at
+// math.MaxInt32 and latencies are in microseconds, see
+// server.VolumeStats.ToProto. StatsVolume reports them in 64 bits
+func (s *Server) StatsUringVolume(_ context.Context, in *pb.StatsAioVolumeRequest) (*pb.StatsAioVolumeResponse, error) {
+	log.Printf("StatsUringVolume: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// fetch object from the database
+	volume, ok := s.Volumes.UringVolumes[in.Name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	resourceID := path.Base(volume.Name)
+	params := spdk.BdevGetIostatParams{
+		Name: resourceID,
+	}
+	var result spdk.BdevGetIostatResult
+	err := s.rpc.Call("bdev_get_iostat", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if len(result.Bdevs) != 1 {
+		msg := fmt.Sprintf("expecting exactly 1 result, got %d", len(result.Bdevs))
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	stats := server.NewVolumeStats(&result)
+	if err := s.volumeHistogram(volume.Name, stats); err != nil {
+		return nil, err
+	}
+	s.stats.Update(resourceID, stats)
+	log.Printf("Rates of %v: %+v", resourceID, stats.Rates)
+	return &pb.StatsAioVolumeResponse{Stats: stats.ToProto()}, nil
+}
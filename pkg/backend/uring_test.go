@@ -0,0 +1,517 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package backend implements the BackEnd APIs (network facing) of the storage Server
+package backend
+
+import (
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
+)
+
+func TestBackEnd_CreateUringVolume(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	tests := map[string]struct {
+		id      string
+		in      *pb.AioVolume
+		out     *pb.AioVolume
+		spdk    []string
+		errCode codes.Code
+		errMsg  string
+		exist   bool
+		taken   bool
+	}{
+		"illegal resource_id": {
+			id:      "CapitalLettersNotAllowed",
+			in:      &testAioVolume,
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.Unknown,
+			errMsg:  fmt.Sprintf("user-settable ID must only contain lowercase, numbers and hyphens (%v)", "got: 'C' in position 0"),
+		},
+		"valid request with invalid SPDK response": {
+			id:      testAioVolumeID,
+			in:      &testAioVolume,
+			out:     nil,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":""}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("Could not create Uring Dev: %v", testAioVolumeID),
+		},
+		"valid request with error code from SPDK response": {
+			id:      testAioVolumeID,
+			in:      &testAioVolume,
+			out:     nil,
+			spdk:    []string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":""}`},
+			errCode: codes.Unknown,
+			errMsg:  fmt.Sprintf("bdev_uring_create: %v", "json response error: myopierr"),
+		},
+		"invalid block size": {
+			id:      testAioVolumeID,
+			in:      &pb.AioVolume{BlockSize: 1000, Filename: testAioVolume.Filename},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("block_size must be a power of 2 not less than %d, got %d", 512, 1000),
+		},
+		"valid request with valid SPDK response": {
+			id:      testAioVolumeID,
+			in:      &testAioVolume,
+			out:     &testAioVolume,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":"mytest"}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"already exists": {
+			id:      testAioVolumeID,
+			in:      &testAioVolume,
+			out:     &testAioVolume,
+			spdk:    []string{},
+			errCode: codes.OK,
+			errMsg:  "",
+			exist:   true,
+		},
+		"name used by Aio volume": {
+			id:      testAioVolumeID,
+			in:      &testAioVolume,
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.AlreadyExists,
+			errMsg:  fmt.Sprintf("volume %v already exists", testAioVolumeName),
+			taken:   true,
+		},
+		"no required field": {
+			id:      testAioVolumeID,
+			in:      nil,
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.Unknown,
+			errMsg:  "missing required field: aio_volume",
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			if tt.taken {
+				testEnv.opiSpdkServer.registerVolume(testAioVolumeName, volume.TypeAio, pb.AioVolumeService_ServiceDesc.ServiceName, 512, 64)
+			}
+			if tt.exist {
+				testEnv.opiSpdkServer.Volumes.UringVolumes[testAioVolumeName] = server.ProtoClone(&testAioVolume)
+				testEnv.opiSpdkServer.Volumes.UringVolumes[testAioVolumeName].Name = testAioVolumeName
+			}
+			if tt.out != nil {
+				tt.out = server.ProtoClone(tt.out)
+				tt.out.Name = testAioVolumeName
+			}
+
+			var in *pb.AioVolume
+			if tt.in != nil {
+				in = server.ProtoClone(tt.in)
+			}
+			request := &pb.CreateAioVolumeRequest{AioVolume: in, AioVolumeId: tt.id}
+			response, err := testEnv.client.CreateUringVolume(testEnv.ctx, request)
+
+			if !proto.Equal(response, tt.out) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
+
+func TestBackEnd_DeleteUringVolume(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	tests := map[string]struct {
+		in      string
+		out     *emptypb.Empty
+		spdk    []string
+		errCode codes.Code
+		errMsg  string
+		missing bool
+	}{
+		"valid request with invalid SPDK response": {
+			in:      testAioVolumeName,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("Could not delete Uring Dev: %s", testAioVolumeID),
+		},
+		"valid request with error code from SPDK response": {
+			in:      testAioVolumeName,
+			spdk:    []string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":false}`},
+			errCode: codes.Unknown,
+			errMsg:  fmt.Sprintf("bdev_uring_delete: %v", "json response error: myopierr"),
+		},
+		"valid request with valid SPDK response": {
+			in:      testAioVolumeName,
+			out:     &emptypb.Empty{},
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode: codes.OK,
+		},
+		"valid request with unknown key": {
+			in:      server.ResourceIDToVolumeName("unknown-id"),
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %v", server.ResourceIDToVolumeName("unknown-id")),
+		},
+		"unknown key with missing allowed": {
+			in:      server.ResourceIDToVolumeName("unknown-id"),
+			out:     &emptypb.Empty{},
+			spdk:    []string{},
+			errCode: codes.OK,
+			missing: true,
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			testEnv.opiSpdkServer.Volumes.UringVolumes[testAioVolumeName] = server.ProtoClone(&testAioVolume)
+			testEnv.opiSpdkServer.Volumes.UringVolumes[testAioVolumeName].Name = testAioVolumeName
+
+			request := &pb.DeleteAioVolumeRequest{Name: tt.in, AllowMissing: tt.missing}
+			response, err := testEnv.client.DeleteUringVolume(testEnv.ctx, request)
+
+			if !proto.Equal(response, tt.out) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
+
+func TestBackEnd_UpdateUringVolume(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	testAioVolumeWithName := server.ProtoClone(&testAioVolume)
+	testAioVolumeWithName.Name = testAioVolumeName
+	tests := map[string]struct {
+		in      *pb.AioVolume
+		out     *pb.AioVolume
+		spdk    []string
+		errCode codes.Code
+		errMsg  string
+	}{
+		"filename cannot be changed": {
+			in:      &pb.AioVolume{Name: testAioVolumeName, Filename: "/tmp/other_file"},
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  "filename of AioVolume cannot be changed",
+		},
+		"rescan fails": {
+			in:      testAioVolumeWithName,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("Could not rescan Uring Dev: %s", testAioVolumeID),
+		},
+		"resize after backing file grew": {
+			in: testAioVolumeWithName,
+			out: &pb.AioVolume{
+				Name:        testAioVolumeName,
+				BlockSize:   512,
+				BlocksCount: 24,
+				Filename:    "/tmp/aio_bdev_file",
+			},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":[{"name":"mytest","block_size":512,"num_blocks":24}]}`,
+			},
+			errCode: codes.OK,
+		},
+		"valid request with unknown key": {
+			in:      &pb.AioVolume{Name: server.ResourceIDToVolumeName("unknown-id"), Filename: "/tmp/aio_bdev_file"},
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %v", server.ResourceIDToVolumeName("unknown-id")),
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			testEnv.opiSpdkServer.Volumes.UringVolumes[testAioVolumeName] = server.ProtoClone(testAioVolumeWithName)
+
+			request := &pb.UpdateAioVolumeRequest{AioVolume: tt.in}
+			response, err := testEnv.client.UpdateUringVolume(testEnv.ctx, request)
+
+			if !proto.Equal(response, tt.out) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
+
+func TestBackEnd_ListUringVolumes(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	testAioVolumeWithName := server.ProtoClone(&testAioVolume)
+	testAioVolumeWithName.Name = testAioVolumeName
+	tests := map[string]struct {
+		out     []*pb.AioVolume
+		errCode codes.Code
+		errMsg  string
+		size    int32
+		token   string
+	}{
+		"valid request": {
+			out:     []*pb.AioVolume{testAioVolumeWithName},
+			errCode: codes.OK,
+		},
+		"pagination negative": {
+			out:     nil,
+			errCode: codes.InvalidArgument,
+			errMsg:  "negative PageSize is not allowed",
+			size:    -10,
+		},
+		"pagination error": {
+			out:     nil,
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find pagination token %s", "unknown-pagination-token"),
+			token:   "unknown-pagination-token",
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment([]string{})
+			defer testEnv.Close()
+
+			testEnv.opiSpdkServer.Volumes.UringVolumes[testAioVolumeName] = server.ProtoClone(testAioVolumeWithName)
+
+			request := &pb.ListAioVolumesRequest{Parent: testAioVolumeID, PageSize: tt.size, PageToken: tt.token}
+			response, err := testEnv.client.ListUringVolumes(testEnv.ctx, request)
+
+			if !server.EqualProtoSlices(response.GetAioVolumes(), tt.out) {
+				t.Error("response: expected", tt.out, "received", response.GetAioVolumes())
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
+
+func TestBackEnd_GetUringVolume(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	tests := map[string]struct {
+		in      string
+		out     *pb.AioVolume
+		spdk    []string
+		errCode codes.Code
+		errMsg  string
+	}{
+		"valid request with valid SPDK response": {
+			in: testAioVolumeName,
+			out: &pb.AioVolume{
+				Name:        testAioVolumeName,
+				BlockSize:   4096,
+				BlocksCount: 3,
+				Filename:    "/tmp/aio_bdev_file",
+			},
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":[{"name":"mytest","block_size":4096,"num_blocks":3}]}`},
+			errCode: codes.OK,
+		},
+		"valid request with empty SPDK response": {
+			in:      testAioVolumeName,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":[]}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("expecting exactly 1 result, got %d", 0),
+		},
+		"valid request with unknown key": {
+			in:      server.ResourceIDToVolumeName("unknown-id"),
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %v", server.ResourceIDToVolumeName("unknown-id")),
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			testEnv.opiSpdkServer.Volumes.UringVolumes[testAioVolumeName] = server.ProtoClone(&testAioVolume)
+			testEnv.opiSpdkServer.Volumes.UringVolumes[testAioVolumeName].Name = testAioVolumeName
+
+			request := &pb.GetAioVolumeRequest{Name: tt.in}
+			response, err := testEnv.client.GetUringVolume(testEnv.ctx, request)
+
+			if !proto.Equal(response, tt.out) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
+
+func TestBackEnd_StatsUringVolume(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	tests := map[string]struct {
+		in      string
+		out     *pb.VolumeStats
+		spdk    []string
+		errCode codes.Code
+		errMsg  string
+	}{
+		"valid request with valid SPDK response": {
+			in: testAioVolumeName,
+			out: &pb.VolumeStats{
+				ReadBytesCount:  1,
+				ReadOpsCount:    2,
+				WriteBytesCount: 3,
+				WriteOpsCount:   4,
+			},
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":{"tick_rate":0,"ticks":0,"bdevs":[{"name":"mytest","bytes_read":1,"num_read_ops":2,"bytes_written":3,"num_write_ops":4}]}}`},
+			errCode: codes.OK,
+		},
+		"valid request with empty SPDK response": {
+			in:      testAioVolumeName,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":{"tick_rate":0,"ticks":0,"bdevs":[]}}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("expecting exactly 1 result, got %d", 0),
+		},
+		"valid request with unknown key": {
+			in:      server.ResourceIDToVolumeName("unknown-id"),
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %v", server.ResourceIDToVolumeName("unknown-id")),
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			testEnv.opiSpdkServer.Volumes.UringVolumes[testAioVolumeName] = server.ProtoClone(&testAioVolume)
+			testEnv.opiSpdkServer.Volumes.UringVolumes[testAioVolumeName].Name = testAioVolumeName
+
+			request := &pb.StatsAioVolumeRequest{Name: tt.in}
+			response, err := testEnv.client.StatsUringVolume(testEnv.ctx, request)
+
+			if !proto.Equal(response.GetStats(), tt.out) {
+				t.Error("response: expected", tt.out, "received", response.GetStats())
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
+
+func TestBackEnd_EnableAioOverUring(t *testing.T) {
+	tests := map[string]struct {
+		spdk   []string
+		out    bool
+		errMsg string
+	}{
+		"uring supported": {
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":["bdev_aio_create","bdev_uring_create"]}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":"mytest"}`,
+			},
+			out: true,
+		},
+		"uring not supported": {
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":["bdev_aio_create"]}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":"mytest"}`,
+			},
+			out: false,
+		},
+		"SPDK error": {
+			spdk:   []string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":[]}`},
+			out:    false,
+			errMsg: fmt.Sprintf("rpc_get_methods: %v", "json response error: myopierr"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			uring, err := testEnv.opiSpdkServer.EnableAioOverUring()
+
+			errMsg := ""
+			if err != nil {
+				errMsg = err.Error()
+			}
+			if errMsg != tt.errMsg {
+				t.Error("error: expected", tt.errMsg, "received", errMsg)
+			}
+			if uring != tt.out {
+				t.Error("uring: expected", tt.out, "received", uring)
+			}
+			if err != nil {
+				return
+			}
+
+			volume := server.ProtoClone(&testAioVolume)
+			volume.Name = testAioVolumeName
+			if _, err := testEnv.opiSpdkServer.createAioBdev(testAioVolumeID, volume); err != nil {
+				t.Fatal(err)
+			}
+			if testEnv.opiSpdkServer.aio.uringBacked[testAioVolumeName] != tt.out {
+				t.Error("expected Aio volume backed by io_uring", tt.out)
+			}
+		})
+	}
+}
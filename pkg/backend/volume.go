@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package backend implememnts the BackEnd APIs (network facing) of the storage Server
+package backend
+
+import (
+	"log"
+	"path"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// registerVolume makes a created bdev resolvable by services referencing
+// volumes
+func (s *Server) registerVolume(name string, volumeType volume.Type, owner string, blockSize int64, blocksCount int64) {
+	err := s.registry.Register(volume.Volume{
+		Name:        name,
+		BdevName:    path.Base(name),
+		Type:        volumeType,
+		Owner:       owner,
+		BlockSize:   blockSize,
+		BlocksCount: blocksCount,
+	})
+	if err != nil {
+		log.Printf("error: %v", err)
+	}
+}
+
+// registerNullVolume registers a created Null bdev
+func (s *Server) registerNullVolume(name string, blockSize int64, blocksCount int64) {
+	s.registerVolume(name, volume.TypeNull, pb.NullVolumeService_ServiceDesc.ServiceName, blockSize, blocksCount)
+}
+
+// registerUringVolume registers a created io_uring bdev
+func (s *Server) registerUringVolume(name string, blockSize int64, blocksCount int64) {
+	s.registerVolume(name, volume.TypeUring, bridgepb.UringVolumeService_ServiceDesc.ServiceName, blockSize, blocksCount)
+}
+
+// checkVolumeNameUnused checks that no other kind of volume uses the name.
+// Aio and io_uring volumes are tracked separately, but share the names of
+// volumes and bdevs
+func (s *Server) checkVolumeNameUnused(name string) error {
+	if _, err := s.registry.Get(name); err == nil {
+		err := status.Errorf(codes.AlreadyExists, "volume %s already exists", name)
+		log.Printf("error: %v", err)
+		return err
+	}
+	return nil
+}
+
+// unregisterVolume removes a deleted bdev from the volume registry
+func (s *Server) unregisterVolume(name string) {
+	if err := s.registry.Unregister(name); err != nil {
+		log.Printf("error: %v", err)
+	}
+}
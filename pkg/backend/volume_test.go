@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package backend implememnts the BackEnd APIs (network facing) of the storage Server
+package backend
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
+)
+
+func TestBackEnd_RegisterCreatedVolumes(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	tests := map[string]struct {
+		create func(*testEnv) error
+		out    volume.Volume
+	}{
+		"aio volume": {
+			create: func(env *testEnv) error {
+				_, err := env.client.CreateAioVolume(env.ctx, &pb.CreateAioVolumeRequest{AioVolume: &testAioVolume, AioVolumeId: testAioVolumeID})
+				return err
+			},
+			out: volume.Volume{
+				Name:        testAioVolumeName,
+				BdevName:    testAioVolumeID,
+				Type:        volume.TypeAio,
+				Owner:       pb.AioVolumeService_ServiceDesc.ServiceName,
+				BlockSize:   testAioVolume.BlockSize,
+				BlocksCount: testAioVolume.BlocksCount,
+				Status:      volume.StatusAvailable,
+				Users:       []string{},
+			},
+		},
+		"null volume": {
+			create: func(env *testEnv) error {
+				_, err := env.client.CreateNullVolume(env.ctx, &pb.CreateNullVolumeRequest{NullVolume: &testNullVolume, NullVolumeId: testNullVolumeID})
+				return err
+			},
+			out: volume.Volume{
+				Name:        testNullVolumeName,
+				BdevName:    testNullVolumeID,
+				Type:        volume.TypeNull,
+				Owner:       pb.NullVolumeService_ServiceDesc.ServiceName,
+				BlockSize:   512,
+				BlocksCount: 64,
+				Status:      volume.StatusAvailable,
+				Users:       []string{},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment([]string{`{"id":%d,"error":{"code":0,"message":""},"result":"mytest"}`})
+			defer testEnv.Close()
+
+			if err := tt.create(testEnv); err != nil {
+				t.Fatal(err)
+			}
+
+			vol, err := testEnv.opiSpdkServer.registry.Get(tt.out.Name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(vol, tt.out) {
+				t.Error("volume: expected", tt.out, "received", vol)
+			}
+		})
+	}
+}
+
+func TestBackEnd_DeleteVolumeInUse(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	tests := map[string]struct {
+		inUse   bool
+		spdk    []string
+		errCode codes.Code
+		errMsg  string
+	}{
+		"volume in use": {
+			inUse:   true,
+			spdk:    []string{},
+			errCode: codes.FailedPrecondition,
+			errMsg:  fmt.Sprintf("volume %v is in use by [namespace]", testAioVolumeName),
+		},
+		"volume not in use": {
+			inUse:   false,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			testEnv.opiSpdkServer.Volumes.AioVolumes[testAioVolumeName] = server.ProtoClone(&testAioVolume)
+			testEnv.opiSpdkServer.Volumes.AioVolumes[testAioVolumeName].Name = testAioVolumeName
+			testEnv.opiSpdkServer.registerVolume(testAioVolumeName, volume.TypeAio, pb.AioVolumeService_ServiceDesc.ServiceName, 512, 12)
+			if tt.inUse {
+				if _, err := testEnv.opiSpdkServer.registry.Acquire(testAioVolumeName, "namespace"); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			request := &pb.DeleteAioVolumeRequest{Name: testAioVolumeName}
+			_, err := testEnv.client.DeleteAioVolume(testEnv.ctx, request)
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+
+			_, err = testEnv.opiSpdkServer.registry.Get(testAioVolumeName)
+			if registered := err == nil; registered != tt.inUse {
+				t.Error("volume registered: expected", tt.inUse, "received", registered)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package bridgepb contains gRPC services of SPDK features which are not
+// covered by the OPI storage API. They are served next to the OPI services.
+// The google/api and OPI storage protos are expected in the protoc include
+// path
+package bridgepb
+
+//go:generate protoc --proto_path=../../proto --go_out=../.. --go_opt=module=github.com/opiproject/opi-spdk-bridge --go-grpc_out=../.. --go-grpc_opt=module=github.com/opiproject/opi-spdk-bridge opi_spdk_bridge/v1alpha1/dhchap.proto opi_spdk_bridge/v1alpha1/frontend_nvme_host.proto opi_spdk_bridge/v1alpha1/backend_nvme_remote_controller.proto opi_spdk_bridge/v1alpha1/backend_null_metadata.proto opi_spdk_bridge/v1alpha1/backend_fault.proto opi_spdk_bridge/v1alpha1/backend_uring.proto opi_spdk_bridge/v1alpha1/middleend_cache.proto opi_spdk_bridge/v1alpha1/middleend_compression.proto opi_spdk_bridge/v1alpha1/middleend_encryption_key.proto opi_spdk_bridge/v1alpha1/middleend_qos_burst.proto opi_spdk_bridge/v1alpha1/middleend_qos_policy.proto opi_spdk_bridge/v1alpha1/middleend_qos_group.proto opi_spdk_bridge/v1alpha1/middleend_volume_stats.proto opi_spdk_bridge/v1alpha1/frontend_nvme_controller.proto opi_spdk_bridge/v1alpha1/frontend_nvme_subsystem.proto
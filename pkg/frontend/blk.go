@@ -73,7 +73,9 @@ func (v vhostUserBlkTransport) verifyTransportSpecificParams(virtioBlk *pb.Virti
 
 // CreateVirtioBlk creates a Virtio block device
 func (s *Server) CreateVirtioBlk(_ context.Context, in *pb.CreateVirtioBlkRequest) (*pb.VirtioBlk, error) {
-	log.Printf("CreateVirtioBlk: Received from client: %v", in)
+	log.Printf("CreateVirtioBlk: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -104,7 +106,15 @@ func (s *Server) CreateVirtioBlk(_ context.Context, in *pb.CreateVirtioBlkReques
 		return controller, nil
 	}
 	// not found, so create a new one
-	params, err := s.Virt.transport.CreateParams(in.VirtioBlk)
+	volume, err := s.registry.Get(in.VirtioBlk.VolumeNameRef)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// transports expect the name of the bdev to expose
+	virtioBlk := server.ProtoClone(in.VirtioBlk)
+	virtioBlk.VolumeNameRef = volume.BdevName
+	params, err := s.Virt.transport.CreateParams(virtioBlk)
 	if err != nil {
 		log.Printf("error: failed to create params for spdk call: %v", err)
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -125,12 +135,15 @@ func (s *Server) CreateVirtioBlk(_ context.Context, in *pb.CreateVirtioBlkReques
 	response := server.ProtoClone(in.VirtioBlk)
 	// response.Status = &pb.NvmeControllerStatus{Active: true}
 	s.Virt.BlkCtrls[in.VirtioBlk.Name] = response
+	s.acquireVolume(volume.Name, response.Name)
 	return response, nil
 }
 
 // DeleteVirtioBlk deletes a Virtio block device
 func (s *Server) DeleteVirtioBlk(_ context.Context, in *pb.DeleteVirtioBlkRequest) (*emptypb.Empty, error) {
-	log.Printf("DeleteVirtioBlk: Received from client: %v", in)
+	log.Printf("DeleteVirtioBlk: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -171,12 +184,15 @@ func (s *Server) DeleteVirtioBlk(_ context.Context, in *pb.DeleteVirtioBlkReques
 		return nil, status.Errorf(codes.InvalidArgument, msg)
 	}
 	delete(s.Virt.BlkCtrls, controller.Name)
+	s.registry.Release(controller.VolumeNameRef, controller.Name)
 	return &emptypb.Empty{}, nil
 }
 
 // UpdateVirtioBlk updates a Virtio block device
 func (s *Server) UpdateVirtioBlk(_ context.Context, in *pb.UpdateVirtioBlkRequest) (*pb.VirtioBlk, error) {
-	log.Printf("UpdateVirtioBlk: Received from client: %v", in)
+	log.Printf("UpdateVirtioBlk: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -214,7 +230,9 @@ func (s *Server) UpdateVirtioBlk(_ context.Context, in *pb.UpdateVirtioBlkReques
 
 // ListVirtioBlks lists Virtio block devices
 func (s *Server) ListVirtioBlks(_ context.Context, in *pb.ListVirtioBlksRequest) (*pb.ListVirtioBlksResponse, error) {
-	log.Printf("ListVirtioBlks: Received from client: %v", in)
+	log.Printf("ListVirtioBlks: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -259,7 +277,9 @@ func (s *Server) ListVirtioBlks(_ context.Context, in *pb.ListVirtioBlksRequest)
 
 // GetVirtioBlk gets a Virtio block device
 func (s *Server) GetVirtioBlk(_ context.Context, in *pb.GetVirtioBlkRequest) (*pb.VirtioBlk, error) {
-	log.Printf("GetVirtioBlk: Received from client: %v", in)
+	log.Printf("GetVirtioBlk: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -303,9 +323,12 @@ func (s *Server) GetVirtioBlk(_ context.Context, in *pb.GetVirtioBlkRequest) (*p
 		VolumeNameRef: "TBD"}, nil
 }
 
-// StatsVirtioBlk gets a Virtio block device stats
+// StatsVirtioBlk gets a Virtio block device stats. Stats are not collected
+// yet, StatsVolume reports them for the volume of the device
 func (s *Server) StatsVirtioBlk(_ context.Context, in *pb.StatsVirtioBlkRequest) (*pb.StatsVirtioBlkResponse, error) {
-	log.Printf("StatsVirtioBlk: Received from client: %v", in)
+	log.Printf("StatsVirtioBlk: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
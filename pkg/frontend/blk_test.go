@@ -76,6 +76,14 @@ func TestFrontEnd_CreateVirtioBlk(t *testing.T) {
 			errCode: codes.InvalidArgument,
 			errMsg:  fmt.Sprintf("Could not create virtio-blk: %s", testVirtioCtrlID),
 		},
+		"unknown volume": {
+			id:      testVirtioCtrlID,
+			in:      &pb.VirtioBlk{VolumeNameRef: "unknown-volume", PcieId: testVirtioCtrl.PcieId},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  "unable to find volume unknown-volume",
+		},
 		"no required field": {
 			id:      testVirtioCtrlID,
 			in:      nil,
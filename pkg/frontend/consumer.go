@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package frontend implements the FrontEnd APIs (host facing) of the storage Server
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/opiproject/gospdk/spdk"
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// nvmfSubsystemPauseParams holds the parameters required to pause or resume
+// an NVMe-oF subsystem
+type nvmfSubsystemPauseParams struct {
+	Nqn string `json:"nqn"`
+}
+
+// nvmfSubsystemPauseResult is the result of pausing or resuming an NVMe-oF
+// subsystem
+type nvmfSubsystemPauseResult bool
+
+// Consumes tells if user is an Nvme namespace or a virtio-blk controller of
+// the server. Those can be detached from and attached to another bdev of
+// their volume
+func (s *Server) Consumes(user string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.Nvme.Namespaces[user]; ok {
+		return true
+	}
+	_, ok := s.Virt.BlkCtrls[user]
+	return ok
+}
+
+// VolumesOf returns references of volumes exposed through namespaces of an
+// NVMe subsystem or LUNs of a virtio-scsi controller of the server
+func (s *Server) VolumesOf(object string) ([]string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	refs := []string{}
+	if _, ok := s.Nvme.Subsystems[object]; ok {
+		for _, namespace := range s.Nvme.Namespaces {
+			if namespace.Spec.SubsystemNameRef == object {
+				refs = append(refs, namespace.Spec.VolumeNameRef)
+			}
+		}
+		sort.Strings(refs)
+		return refs, true
+	}
+	if _, ok := s.Virt.ScsiCtrls[object]; ok {
+		for _, lun := range s.Virt.ScsiLuns {
+			if lun.TargetNameRef == object {
+				refs = append(refs, lun.VolumeNameRef)
+			}
+		}
+		sort.Strings(refs)
+		return refs, true
+	}
+	return nil, false
+}
+
+// Pause pauses the subsystem of user, so that I/O of hosts is queued
+// instead of failed while the namespace is detached and attached again.
+// vhost cannot queue I/O of a VM, so virtio-blk controllers are not paused
+// and their Detach fails while a VM is connected to them
+func (s *Server) Pause(_ context.Context, user string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.Virt.BlkCtrls[user]; ok {
+		return nil
+	}
+	_, subsys, err := s.consumedNamespace(user)
+	if err != nil {
+		return err
+	}
+	return s.changeNvmeSubsystemState("nvmf_subsystem_pause", subsys.Spec.Nqn)
+}
+
+// Resume resumes the subsystem of user paused with Pause
+func (s *Server) Resume(_ context.Context, user string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.Virt.BlkCtrls[user]; ok {
+		return nil
+	}
+	_, subsys, err := s.consumedNamespace(user)
+	if err != nil {
+		return err
+	}
+	return s.changeNvmeSubsystemState("nvmf_subsystem_resume", subsys.Spec.Nqn)
+}
+
+// Detach detaches user from the bdev of its volume. The namespace keeps its
+// Nsid when attached again. A virtio-blk controller is deleted in SPDK and
+// keeps its socket when attached again
+func (s *Server) Detach(_ context.Context, user string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if controller, ok := s.Virt.BlkCtrls[user]; ok {
+		return s.detachVirtioBlk(user, controller)
+	}
+	namespace, subsys, err := s.consumedNamespace(user)
+	if err != nil {
+		return err
+	}
+	params := spdk.NvmfSubsystemRemoveNsParams{
+		Nqn:  subsys.Spec.Nqn,
+		Nsid: int(namespace.Spec.HostNsid),
+	}
+	var result spdk.NvmfSubsystemRemoveNsResult
+	err = s.rpc.Call("nvmf_subsystem_remove_ns", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not detach NS: %s", user)
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+	return nil
+}
+
+// Attach attaches user detached with Detach to bdevName
+func (s *Server) Attach(_ context.Context, user string, bdevName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if controller, ok := s.Virt.BlkCtrls[user]; ok {
+		return s.attachVirtioBlk(user, controller, bdevName)
+	}
+	namespace, subsys, err := s.consumedNamespace(user)
+	if err != nil {
+		return err
+	}
+	return s.attachNvmeNamespace(user, namespace, subsys.Spec.Nqn, bdevName)
+}
+
+// attachNvmeNamespace adds namespace name to the subsystem nqn with its Nsid
+func (s *Server) attachNvmeNamespace(name string, namespace *pb.NvmeNamespace, nqn string, bdevName string) error {
+	params := spdk.NvmfSubsystemAddNsParams{
+		Nqn: nqn,
+	}
+	params.Namespace.Nsid = int(namespace.Spec.HostNsid)
+	params.Namespace.BdevName = bdevName
+	var result spdk.NvmfSubsystemAddNsResult
+	err := s.rpc.Call("nvmf_subsystem_add_ns", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if result < 0 {
+		msg := fmt.Sprintf("Could not attach NS: %s", name)
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+	return nil
+}
+
+// detachVirtioBlk deletes the SPDK controller of virtio-blk controller name
+// without forgetting it
+func (s *Server) detachVirtioBlk(name string, controller *pb.VirtioBlk) error {
+	params, err := s.Virt.transport.DeleteParams(controller)
+	if err != nil {
+		log.Printf("error: failed to create params for spdk call: %v", err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	var result spdk.VhostDeleteControllerResult
+	err = s.rpc.Call("vhost_delete_controller", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not detach virtio-blk: %s", name)
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+	return nil
+}
+
+// attachVirtioBlk creates the SPDK controller of virtio-blk controller name
+// detached with detachVirtioBlk, exposing bdevName
+func (s *Server) attachVirtioBlk(name string, controller *pb.VirtioBlk, bdevName string) error {
+	// transports expect the name of the bdev to expose
+	virtioBlk := server.ProtoClone(controller)
+	virtioBlk.VolumeNameRef = bdevName
+	params, err := s.Virt.transport.CreateParams(virtioBlk)
+	if err != nil {
+		log.Printf("error: failed to create params for spdk call: %v", err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	var result spdk.VhostCreateBlkControllerResult
+	err = s.rpc.Call("vhost_create_blk_controller", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not attach virtio-blk: %s", name)
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+	return nil
+}
+
+// consumedNamespace returns the namespace user and its subsystem
+func (s *Server) consumedNamespace(user string) (*pb.NvmeNamespace, *pb.NvmeSubsystem, error) {
+	namespace, ok := s.Nvme.Namespaces[user]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", user)
+		log.Printf("error: %v", err)
+		return nil, nil, err
+	}
+	subsys, ok := s.Nvme.Subsystems[namespace.Spec.SubsystemNameRef]
+	if !ok {
+		err := fmt.Errorf("unable to find subsystem %s", namespace.Spec.SubsystemNameRef)
+		log.Printf("error: %v", err)
+		return nil, nil, err
+	}
+	return namespace, subsys, nil
+}
+
+// changeNvmeSubsystemState pauses or resumes a subsystem with method
+func (s *Server) changeNvmeSubsystemState(method string, nqn string) error {
+	params := nvmfSubsystemPauseParams{
+		Nqn: nqn,
+	}
+	var result nvmfSubsystemPauseResult
+	err := s.rpc.Call(method, &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not %s subsystem: %s", strings.TrimPrefix(method, "nvmf_subsystem_"), nqn)
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+	return nil
+}
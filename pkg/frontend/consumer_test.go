@@ -0,0 +1,297 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package frontend implements the FrontEnd APIs (host facing) of the storage Server
+package frontend
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+)
+
+func TestFrontEnd_Consumes(t *testing.T) {
+	tests := map[string]struct {
+		user string
+		out  bool
+	}{
+		"namespace": {
+			user: testNamespaceName,
+			out:  true,
+		},
+		"virtio-blk": {
+			user: testVirtioCtrlName,
+			out:  true,
+		},
+		"unknown user": {
+			user: "unknown-id",
+			out:  false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment([]string{})
+			defer testEnv.Close()
+			testEnv.opiSpdkServer.Nvme.Namespaces[testNamespaceName] = server.ProtoClone(&testNamespace)
+			testEnv.opiSpdkServer.Virt.BlkCtrls[testVirtioCtrlName] = server.ProtoClone(&testVirtioCtrl)
+
+			consumer, ok := testEnv.opiSpdkServer.registry.ConsumerOf(tt.user)
+
+			if ok != tt.out {
+				t.Error("consumes: expected", tt.out, "received", ok)
+			}
+			if ok && consumer != testEnv.opiSpdkServer {
+				t.Error("consumer: expected", testEnv.opiSpdkServer, "received", consumer)
+			}
+		})
+	}
+}
+
+func TestFrontEnd_VolumesOf(t *testing.T) {
+	tests := map[string]struct {
+		object string
+		out    []string
+		found  bool
+	}{
+		"subsystem": {
+			object: testSubsystemName,
+			out:    []string{"volume-1", "volume-2"},
+			found:  true,
+		},
+		"scsi controller": {
+			object: "scsi-controller",
+			out:    []string{"volume-3"},
+			found:  true,
+		},
+		"unknown object": {
+			object: "unknown-id",
+			out:    nil,
+			found:  false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment([]string{})
+			defer testEnv.Close()
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName] = server.ProtoClone(&testSubsystem)
+			for i, ref := range []string{"volume-2", "volume-1"} {
+				namespace := server.ProtoClone(&testNamespace)
+				namespace.Spec.VolumeNameRef = ref
+				testEnv.opiSpdkServer.Nvme.Namespaces[fmt.Sprintf("namespace-%d", i)] = namespace
+			}
+			testEnv.opiSpdkServer.Virt.ScsiCtrls["scsi-controller"] = &pb.VirtioScsiController{Name: "scsi-controller"}
+			testEnv.opiSpdkServer.Virt.ScsiLuns["scsi-lun"] = &pb.VirtioScsiLun{
+				Name:          "scsi-lun",
+				TargetNameRef: "scsi-controller",
+				VolumeNameRef: "volume-3",
+			}
+
+			refs, err := testEnv.opiSpdkServer.registry.VolumesOf(tt.object)
+
+			if !reflect.DeepEqual(refs, tt.out) {
+				t.Error("volumes: expected", tt.out, "received", refs)
+			}
+			if (err == nil) != tt.found {
+				t.Error("found: expected", tt.found, "received", err)
+			}
+		})
+	}
+}
+
+func TestFrontEnd_VolumesOfWaitsForHandlers(t *testing.T) {
+	testEnv := createTestEnvironment([]string{})
+	defer testEnv.Close()
+	testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName] = server.ProtoClone(&testSubsystem)
+
+	// a handler changing the subsystems holds the lock
+	testEnv.opiSpdkServer.mu.Lock()
+	done := make(chan struct{})
+	go func() {
+		_, _ = testEnv.opiSpdkServer.registry.VolumesOf(testSubsystemName)
+		close(done)
+	}()
+	select {
+	case <-done:
+		t.Error("VolumesOf did not wait for the handler")
+	case <-time.After(50 * time.Millisecond):
+	}
+	testEnv.opiSpdkServer.mu.Unlock()
+	<-done
+}
+
+func TestFrontEnd_PauseDetachAttachResume(t *testing.T) {
+	tests := map[string]struct {
+		user    string
+		call    string
+		spdk    []string
+		errCode codes.Code
+		errMsg  string
+	}{
+		"pause namespace": {
+			user:    testNamespaceName,
+			call:    "pause",
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"pause namespace fails": {
+			user:    testNamespaceName,
+			call:    "pause",
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  "Could not pause subsystem: " + testSubsystem.Spec.Nqn,
+		},
+		"detach namespace": {
+			user:    testNamespaceName,
+			call:    "detach",
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"detach namespace fails": {
+			user:    testNamespaceName,
+			call:    "detach",
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  "Could not detach NS: " + testNamespaceName,
+		},
+		"attach namespace": {
+			user:    testNamespaceName,
+			call:    "attach",
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":22}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"attach namespace fails": {
+			user:    testNamespaceName,
+			call:    "attach",
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":-1}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  "Could not attach NS: " + testNamespaceName,
+		},
+		"resume namespace": {
+			user:    testNamespaceName,
+			call:    "resume",
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"resume namespace fails": {
+			user:    testNamespaceName,
+			call:    "resume",
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  "Could not resume subsystem: " + testSubsystem.Spec.Nqn,
+		},
+		"pause virtio-blk": {
+			user:    testVirtioCtrlName,
+			call:    "pause",
+			spdk:    []string{},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"detach virtio-blk": {
+			user:    testVirtioCtrlName,
+			call:    "detach",
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"detach virtio-blk fails": {
+			user:    testVirtioCtrlName,
+			call:    "detach",
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  "Could not detach virtio-blk: " + testVirtioCtrlName,
+		},
+		"attach virtio-blk": {
+			user:    testVirtioCtrlName,
+			call:    "attach",
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"attach virtio-blk fails": {
+			user:    testVirtioCtrlName,
+			call:    "attach",
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  "Could not attach virtio-blk: " + testVirtioCtrlName,
+		},
+		"resume virtio-blk": {
+			user:    testVirtioCtrlName,
+			call:    "resume",
+			spdk:    []string{},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"pause unknown user": {
+			user:    "unknown-id",
+			call:    "pause",
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  "unable to find key unknown-id",
+		},
+		"detach unknown user": {
+			user:    "unknown-id",
+			call:    "detach",
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  "unable to find key unknown-id",
+		},
+		"attach unknown user": {
+			user:    "unknown-id",
+			call:    "attach",
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  "unable to find key unknown-id",
+		},
+		"resume unknown user": {
+			user:    "unknown-id",
+			call:    "resume",
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  "unable to find key unknown-id",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName] = server.ProtoClone(&testSubsystem)
+			testEnv.opiSpdkServer.Nvme.Namespaces[testNamespaceName] = server.ProtoClone(&testNamespace)
+			testEnv.opiSpdkServer.Virt.BlkCtrls[testVirtioCtrlName] = server.ProtoClone(&testVirtioCtrl)
+
+			// methods are called directly, not over grpc
+			var err error
+			switch tt.call {
+			case "pause":
+				err = testEnv.opiSpdkServer.Pause(testEnv.ctx, tt.user)
+			case "detach":
+				err = testEnv.opiSpdkServer.Detach(testEnv.ctx, tt.user)
+			case "attach":
+				err = testEnv.opiSpdkServer.Attach(testEnv.ctx, tt.user, "crypto-test")
+			case "resume":
+				err = testEnv.opiSpdkServer.Resume(testEnv.ctx, tt.user)
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
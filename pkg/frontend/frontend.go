@@ -7,9 +7,13 @@ package frontend
 
 import (
 	"log"
+	"sync"
 
 	"github.com/opiproject/gospdk/spdk"
 	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/keyring"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
 )
 
 // SubsystemListener interface is used to provide SPDK call params to create/delete
@@ -24,6 +28,20 @@ type NvmeParameters struct {
 	Controllers    map[string]*pb.NvmeController
 	Namespaces     map[string]*pb.NvmeNamespace
 	subsysListener SubsystemListener
+	// hostKeys are allowlists of subsystems, keys of allowed hosts by their NQN
+	hostKeys map[string]map[string]*nvmeHostKeys
+	// allowAnyHost tells if subsystems can be connected by hosts which are
+	// not in their allowlists. Unset for subsystems created with the default
+	allowAnyHost map[string]bool
+	// hostAllowlist makes subsystems accept only hosts in their allowlists
+	// by default
+	hostAllowlist bool
+	// secureChannel tells if TCP controllers listen over a secure channel,
+	// which hosts with a pre-shared key require, by controller name
+	secureChannel map[string]bool
+	// anaStates are ANA states of listeners of subsystems, by subsystem
+	// name. Unset for subsystems with optimized listeners
+	anaStates map[string]bridgepb.NvmeAnaState
 }
 
 // VirtioBlkTransport interface is used to provide SPDK call params to create/delete
@@ -46,26 +64,42 @@ type Server struct {
 	pb.UnimplementedFrontendNvmeServiceServer
 	pb.UnimplementedFrontendVirtioBlkServiceServer
 	pb.UnimplementedFrontendVirtioScsiServiceServer
+	bridgepb.UnimplementedNvmeSubsystemHostServiceServer
+	bridgepb.UnimplementedNvmeControllerConnectionServiceServer
+	bridgepb.UnimplementedNvmeSubsystemSettingsServiceServer
 
+	// mu serializes access to Nvme and Virt, which are also used by the
+	// middleend through the volume registry, e.g. by the QoS controller
+	mu         sync.Mutex
 	rpc        spdk.JSONRPC
 	Nvme       NvmeParameters
 	Virt       VirtioParameters
 	Pagination map[string]int
+	keyring    *keyring.Keyring
+	registry   *volume.Registry
 }
 
 // NewServer creates initialized instance of FrontEnd server communicating
-// with provided jsonRPC
-func NewServer(jsonRPC spdk.JSONRPC) *Server {
+// with provided jsonRPC. Volumes are resolved through registry, in which the
+// server is added as a volume consumer
+func NewServer(jsonRPC spdk.JSONRPC, registry *volume.Registry) *Server {
 	if jsonRPC == nil {
 		log.Panic("nil for JSONRPC is not allowed")
 	}
-	return &Server{
+	if registry == nil {
+		log.Panic("nil for volume.Registry is not allowed")
+	}
+	s := &Server{
 		rpc: jsonRPC,
 		Nvme: NvmeParameters{
 			Subsystems:     make(map[string]*pb.NvmeSubsystem),
 			Controllers:    make(map[string]*pb.NvmeController),
 			Namespaces:     make(map[string]*pb.NvmeNamespace),
 			subsysListener: NewTCPSubsystemListener("127.0.0.1:4420"),
+			hostKeys:       make(map[string]map[string]*nvmeHostKeys),
+			allowAnyHost:   make(map[string]bool),
+			secureChannel:  make(map[string]bool),
+			anaStates:      make(map[string]bridgepb.NvmeAnaState),
 		},
 		Virt: VirtioParameters{
 			BlkCtrls:  make(map[string]*pb.VirtioBlk),
@@ -74,13 +108,19 @@ func NewServer(jsonRPC spdk.JSONRPC) *Server {
 			transport: NewVhostUserBlkTransport(),
 		},
 		Pagination: make(map[string]int),
+		keyring:    keyring.NewKeyring(jsonRPC, ""),
+		registry:   registry,
 	}
+	registry.AddConsumer(s)
+	return s
 }
 
 // NewCustomizedServer creates initialized instance of FrontEnd server communicating
-// with provided jsonRPC and externally created SubsystemListener and VirtioBlkTransport
+// with provided jsonRPC, volume registry and externally created SubsystemListener
+// and VirtioBlkTransport
 func NewCustomizedServer(
 	jsonRPC spdk.JSONRPC,
+	registry *volume.Registry,
 	sysListener SubsystemListener,
 	virtioBlkTransport VirtioBlkTransport,
 ) *Server {
@@ -92,8 +132,23 @@ func NewCustomizedServer(
 		log.Panic("nil for VirtioBlkTransport is not allowed")
 	}
 
-	server := NewServer(jsonRPC)
+	server := NewServer(jsonRPC, registry)
 	server.Nvme.subsysListener = sysListener
 	server.Virt.transport = virtioBlkTransport
 	return server
 }
+
+// RequireNvmeHostAllowlist makes subsystems created afterwards accept only
+// hosts added to their allowlists instead of any host
+func (s *Server) RequireNvmeHostAllowlist() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Nvme.hostAllowlist = true
+}
+
+// acquireVolume marks a resolved volume as used by a created object
+func (s *Server) acquireVolume(volumeName string, user string) {
+	if _, err := s.registry.Acquire(volumeName, user); err != nil {
+		log.Printf("error: %v", err)
+	}
+}
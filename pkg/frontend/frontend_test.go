@@ -18,7 +18,9 @@ import (
 
 	"github.com/opiproject/gospdk/spdk"
 	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
 	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
 )
 
 var checkGlobalTestProtoObjectsNotChanged = server.CheckTestProtoObjectsNotChanged(
@@ -28,12 +30,18 @@ var checkGlobalTestProtoObjectsNotChanged = server.CheckTestProtoObjectsNotChang
 	&testNamespace,
 )
 
+// testVolumeIDs are bdevs referenced by frontend objects in tests
+var testVolumeIDs = []string{"Malloc1", "Malloc42", "TBD"}
+
 // TODO: move test infrastructure code to a separate (test/server) package to avoid duplication
 
 type frontendClient struct {
 	pb.FrontendNvmeServiceClient
 	pb.FrontendVirtioBlkServiceClient
 	pb.FrontendVirtioScsiServiceClient
+	bridgepb.NvmeSubsystemHostServiceClient
+	bridgepb.NvmeControllerConnectionServiceClient
+	bridgepb.NvmeSubsystemSettingsServiceClient
 }
 
 type testEnv struct {
@@ -44,6 +52,19 @@ type testEnv struct {
 	ctx           context.Context
 	conn          *grpc.ClientConn
 	jsonRPC       spdk.JSONRPC
+	spdkCalls     *recordingJSONRPC
+}
+
+// recordingJSONRPC records methods called through a JSONRPC, so that tests
+// can check which SPDK calls a request triggers
+type recordingJSONRPC struct {
+	spdk.JSONRPC
+	methods []string
+}
+
+func (r *recordingJSONRPC) Call(method string, args, result interface{}) error {
+	r.methods = append(r.methods, method)
+	return r.JSONRPC.Call(method, args, result)
 }
 
 func (e *testEnv) Close() {
@@ -58,7 +79,8 @@ func createTestEnvironment(spdkResponses []string) *testEnv {
 	env := &testEnv{}
 	env.testSocket = server.GenerateSocketName("frontend")
 	env.ln, env.jsonRPC = server.CreateTestSpdkServer(env.testSocket, spdkResponses)
-	env.opiSpdkServer = NewServer(env.jsonRPC)
+	env.spdkCalls = &recordingJSONRPC{JSONRPC: env.jsonRPC}
+	env.opiSpdkServer = NewServer(env.spdkCalls, createTestVolumeRegistry())
 
 	ctx := context.Background()
 	conn, err := grpc.DialContext(ctx,
@@ -75,17 +97,38 @@ func createTestEnvironment(spdkResponses []string) *testEnv {
 		pb.NewFrontendNvmeServiceClient(env.conn),
 		pb.NewFrontendVirtioBlkServiceClient(env.conn),
 		pb.NewFrontendVirtioScsiServiceClient(env.conn),
+		bridgepb.NewNvmeSubsystemHostServiceClient(env.conn),
+		bridgepb.NewNvmeControllerConnectionServiceClient(env.conn),
+		bridgepb.NewNvmeSubsystemSettingsServiceClient(env.conn),
 	}
 
 	return env
 }
 
+func createTestVolumeRegistry() *volume.Registry {
+	registry := volume.NewRegistry()
+	for _, id := range testVolumeIDs {
+		err := registry.Register(volume.Volume{
+			Name:     server.ResourceIDToVolumeName(id),
+			BdevName: id,
+			Type:     volume.TypeNull,
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	return registry
+}
+
 func dialer(opiSpdkServer *Server) func(context.Context, string) (net.Conn, error) {
 	listener := bufconn.Listen(1024 * 1024)
 	server := grpc.NewServer()
 	pb.RegisterFrontendNvmeServiceServer(server, opiSpdkServer)
 	pb.RegisterFrontendVirtioBlkServiceServer(server, opiSpdkServer)
 	pb.RegisterFrontendVirtioScsiServiceServer(server, opiSpdkServer)
+	bridgepb.RegisterNvmeSubsystemHostServiceServer(server, opiSpdkServer)
+	bridgepb.RegisterNvmeControllerConnectionServiceServer(server, opiSpdkServer)
+	bridgepb.RegisterNvmeSubsystemSettingsServiceServer(server, opiSpdkServer)
 
 	go func() {
 		if err := server.Serve(listener); err != nil {
@@ -102,33 +145,46 @@ func TestFrontEnd_NewCustomizedServer(t *testing.T) {
 	validJSONRPC := spdk.NewSpdkJSONRPC("/some/path")
 	validSubsyListener := NewTCPSubsystemListener("10.10.10.10:1234")
 	validVirtioBLkTransport := NewVhostUserBlkTransport()
+	validRegistry := volume.NewRegistry()
 
 	tests := map[string]struct {
 		jsonRPC            spdk.JSONRPC
+		registry           *volume.Registry
 		subsysListener     SubsystemListener
 		virtioBlkTransport VirtioBlkTransport
 		wantPanic          bool
 	}{
 		"nil json rpc": {
 			jsonRPC:            nil,
+			registry:           validRegistry,
 			subsysListener:     validSubsyListener,
 			virtioBlkTransport: validVirtioBLkTransport,
 			wantPanic:          true,
 		},
 		"nil subsystem listener": {
 			jsonRPC:            validJSONRPC,
+			registry:           validRegistry,
 			subsysListener:     nil,
 			virtioBlkTransport: validVirtioBLkTransport,
 			wantPanic:          true,
 		},
 		"nil virtio blk transport": {
 			jsonRPC:            validJSONRPC,
+			registry:           validRegistry,
 			subsysListener:     validSubsyListener,
 			virtioBlkTransport: nil,
 			wantPanic:          true,
 		},
+		"nil volume registry": {
+			jsonRPC:            validJSONRPC,
+			registry:           nil,
+			subsysListener:     validSubsyListener,
+			virtioBlkTransport: validVirtioBLkTransport,
+			wantPanic:          true,
+		},
 		"all valid arguments": {
 			jsonRPC:            validJSONRPC,
+			registry:           validRegistry,
 			subsysListener:     validSubsyListener,
 			virtioBlkTransport: validVirtioBLkTransport,
 			wantPanic:          false,
@@ -144,7 +200,7 @@ func TestFrontEnd_NewCustomizedServer(t *testing.T) {
 				}
 			}()
 
-			server := NewCustomizedServer(tt.jsonRPC, tt.subsysListener, tt.virtioBlkTransport)
+			server := NewCustomizedServer(tt.jsonRPC, tt.registry, tt.subsysListener, tt.virtioBlkTransport)
 			if server == nil && !tt.wantPanic {
 				t.Error("expected non nil server or panic")
 			}
@@ -12,9 +12,11 @@ import (
 	"net"
 	"path"
 	"sort"
+	"strings"
 
 	"github.com/opiproject/gospdk/spdk"
 	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
 	"github.com/opiproject/opi-spdk-bridge/pkg/server"
 
 	"github.com/google/uuid"
@@ -33,6 +35,59 @@ const (
 	ipv6NvmeTCPProtocol = "ipv6"
 )
 
+// nvmfSubsystemGetControllersParams holds the parameters required to get
+// controllers or queue pairs of a subsystem
+type nvmfSubsystemGetControllersParams struct {
+	Nqn string `json:"nqn"`
+}
+
+// nvmfSubsystemGetControllersResult is a controller of a host connected to
+// a subsystem
+type nvmfSubsystemGetControllersResult struct {
+	Cntlid      int    `json:"cntlid"`
+	Hostnqn     string `json:"hostnqn"`
+	Hostid      string `json:"hostid"`
+	NumIoQpairs int    `json:"num_io_qpairs"`
+}
+
+// nvmfSubsystemGetQpairsResult is a queue pair of a controller connected to
+// a subsystem
+type nvmfSubsystemGetQpairsResult struct {
+	Cntlid        int    `json:"cntlid"`
+	Qid           int    `json:"qid"`
+	State         string `json:"state"`
+	ListenAddress struct {
+		Trtype  string `json:"trtype"`
+		Traddr  string `json:"traddr"`
+		Trsvcid string `json:"trsvcid"`
+	} `json:"listen_address"`
+}
+
+// nvmfSubsystemListenerSetAnaStateParams holds the parameters required to
+// set the ANA state of a subsystem listener
+type nvmfSubsystemListenerSetAnaStateParams struct {
+	Nqn           string `json:"nqn"`
+	ListenAddress struct {
+		Trtype  string `json:"trtype"`
+		Traddr  string `json:"traddr"`
+		Trsvcid string `json:"trsvcid,omitempty"`
+		Adrfam  string `json:"adrfam,omitempty"`
+	} `json:"listen_address"`
+	AnaState string `json:"ana_state"`
+}
+
+// nvmfSubsystemListenerSetAnaStateResult is the result of setting the ANA
+// state of a subsystem listener
+type nvmfSubsystemListenerSetAnaStateResult bool
+
+// nvmeAnaStates are names of ANA states in SPDK
+var nvmeAnaStates = map[bridgepb.NvmeAnaState]string{
+	bridgepb.NvmeAnaState_NVME_ANA_STATE_UNSPECIFIED:   "optimized",
+	bridgepb.NvmeAnaState_NVME_ANA_STATE_OPTIMIZED:     "optimized",
+	bridgepb.NvmeAnaState_NVME_ANA_STATE_NON_OPTIMIZED: "non_optimized",
+	bridgepb.NvmeAnaState_NVME_ANA_STATE_INACCESSIBLE:  "inaccessible",
+}
+
 // TODO: consider using https://pkg.go.dev/net#TCPAddr
 type tcpSubsystemListener struct {
 	listenAddr net.IP
@@ -40,6 +95,12 @@ type tcpSubsystemListener struct {
 	protocol   string
 }
 
+type rdmaSubsystemListener struct {
+	listenAddr net.IP
+	listenPort string
+	protocol   string
+}
+
 func sortNvmeControllers(controllers []*pb.NvmeController) {
 	sort.Slice(controllers, func(i int, j int) bool {
 		return controllers[i].Name < controllers[j].Name
@@ -48,6 +109,49 @@ func sortNvmeControllers(controllers []*pb.NvmeController) {
 
 // NewTCPSubsystemListener creates a new instance of tcpSubsystemListener
 func NewTCPSubsystemListener(listenAddr string) SubsystemListener {
+	parsedAddr, port, protocol := parseListenAddress(listenAddr)
+	return &tcpSubsystemListener{
+		listenAddr: parsedAddr,
+		listenPort: port,
+		protocol:   protocol,
+	}
+}
+
+func (c *tcpSubsystemListener) Params(_ *pb.NvmeController, nqn string) spdk.NvmfSubsystemAddListenerParams {
+	result := spdk.NvmfSubsystemAddListenerParams{}
+	result.Nqn = nqn
+	result.SecureChannel = false
+	result.ListenAddress.Trtype = "tcp"
+	result.ListenAddress.Traddr = c.listenAddr.String()
+	result.ListenAddress.Trsvcid = c.listenPort
+	result.ListenAddress.Adrfam = c.protocol
+
+	return result
+}
+
+// NewRDMASubsystemListener creates a new instance of rdmaSubsystemListener
+func NewRDMASubsystemListener(listenAddr string) SubsystemListener {
+	parsedAddr, port, protocol := parseListenAddress(listenAddr)
+	return &rdmaSubsystemListener{
+		listenAddr: parsedAddr,
+		listenPort: port,
+		protocol:   protocol,
+	}
+}
+
+func (c *rdmaSubsystemListener) Params(_ *pb.NvmeController, nqn string) spdk.NvmfSubsystemAddListenerParams {
+	result := spdk.NvmfSubsystemAddListenerParams{}
+	result.Nqn = nqn
+	result.ListenAddress.Trtype = "rdma"
+	result.ListenAddress.Traddr = c.listenAddr.String()
+	result.ListenAddress.Trsvcid = c.listenPort
+	result.ListenAddress.Adrfam = c.protocol
+
+	return result
+}
+
+// parseListenAddress splits an ip:port tuple and detects its address family
+func parseListenAddress(listenAddr string) (net.IP, string, string) {
 	host, port, err := net.SplitHostPort(listenAddr)
 	if err != nil {
 		log.Panicf("Invalid ip:port tuple: %v", listenAddr)
@@ -68,28 +172,14 @@ func NewTCPSubsystemListener(listenAddr string) SubsystemListener {
 		log.Panicf("Not supported protocol for: %v", listenAddr)
 	}
 
-	return &tcpSubsystemListener{
-		listenAddr: parsedAddr,
-		listenPort: port,
-		protocol:   protocol,
-	}
-}
-
-func (c *tcpSubsystemListener) Params(_ *pb.NvmeController, nqn string) spdk.NvmfSubsystemAddListenerParams {
-	result := spdk.NvmfSubsystemAddListenerParams{}
-	result.Nqn = nqn
-	result.SecureChannel = false
-	result.ListenAddress.Trtype = "tcp"
-	result.ListenAddress.Traddr = c.listenAddr.String()
-	result.ListenAddress.Trsvcid = c.listenPort
-	result.ListenAddress.Adrfam = c.protocol
-
-	return result
+	return parsedAddr, port, protocol
 }
 
 // CreateNvmeController creates an Nvme controller
 func (s *Server) CreateNvmeController(_ context.Context, in *pb.CreateNvmeControllerRequest) (*pb.NvmeController, error) {
-	log.Printf("Received from client: %v", in.NvmeController)
+	log.Printf("Received from client: %v", server.Redact(in.NvmeController))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -131,22 +221,27 @@ func (s *Server) CreateNvmeController(_ context.Context, in *pb.CreateNvmeContro
 	}
 
 	params := s.Nvme.subsysListener.Params(in.NvmeController, subsys.Spec.Nqn)
-	var result spdk.NvmfSubsystemAddListenerResult
-	err := s.rpc.Call("nvmf_subsystem_add_listener", &params, &result)
-	if err != nil {
-		log.Printf("error: %v", err)
+	if params.ListenAddress.Trtype == "tcp" {
+		// hosts with a pre-shared key connect over TLS only
+		params.SecureChannel = s.hasNvmePskHosts(subsys.Name)
+	}
+	if err := s.addNvmeListener(in.NvmeController.Name, &params); err != nil {
 		return nil, err
 	}
-	log.Printf("Received from SPDK: %v", result)
-	if !result {
-		msg := fmt.Sprintf("Could not create CTRL: %s", in.NvmeController.Name)
-		log.Print(msg)
-		return nil, status.Errorf(codes.InvalidArgument, msg)
+	if state, ok := s.Nvme.anaStates[subsys.Name]; ok {
+		if err := s.setNvmeListenerAnaState(&params, state); err != nil {
+			s.removeNvmeListener(&params)
+			return nil, err
+		}
 	}
 	response := server.ProtoClone(in.NvmeController)
 	response.Spec.NvmeControllerId = proto.Int32(-1)
-	response.Status = &pb.NvmeControllerStatus{Active: true}
+	// hosts cannot be connected through the listener yet
+	response.Status = &pb.NvmeControllerStatus{Active: false}
 	s.Nvme.Controllers[in.NvmeController.Name] = response
+	if params.ListenAddress.Trtype == "tcp" {
+		s.Nvme.secureChannel[in.NvmeController.Name] = params.SecureChannel
+	}
 
 	return response, nil
 }
@@ -154,6 +249,8 @@ func (s *Server) CreateNvmeController(_ context.Context, in *pb.CreateNvmeContro
 // DeleteNvmeController deletes an Nvme controller
 func (s *Server) DeleteNvmeController(_ context.Context, in *pb.DeleteNvmeControllerRequest) (*emptypb.Empty, error) {
 	log.Printf("Received from client: %v", in.Name)
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -182,6 +279,7 @@ func (s *Server) DeleteNvmeController(_ context.Context, in *pb.DeleteNvmeContro
 	}
 
 	params := s.Nvme.subsysListener.Params(controller, subsys.Spec.Nqn)
+	params.SecureChannel = s.Nvme.secureChannel[controller.Name]
 	var result spdk.NvmfSubsystemAddListenerResult
 	err := s.rpc.Call("nvmf_subsystem_remove_listener", &params, &result)
 	if err != nil {
@@ -196,12 +294,15 @@ func (s *Server) DeleteNvmeController(_ context.Context, in *pb.DeleteNvmeContro
 		return nil, status.Errorf(codes.InvalidArgument, msg)
 	}
 	delete(s.Nvme.Controllers, controller.Name)
+	delete(s.Nvme.secureChannel, controller.Name)
 	return &emptypb.Empty{}, nil
 }
 
 // UpdateNvmeController updates an Nvme controller
 func (s *Server) UpdateNvmeController(_ context.Context, in *pb.UpdateNvmeControllerRequest) (*pb.NvmeController, error) {
-	log.Printf("UpdateNvmeController: Received from client: %v", in)
+	log.Printf("UpdateNvmeController: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -229,15 +330,23 @@ func (s *Server) UpdateNvmeController(_ context.Context, in *pb.UpdateNvmeContro
 		return nil, err
 	}
 	log.Printf("TODO: use resourceID=%v", resourceID)
-	response := server.ProtoClone(in.NvmeController)
-	response.Status = &pb.NvmeControllerStatus{Active: true}
-	s.Nvme.Controllers[in.NvmeController.Name] = response
+	response, err := s.connectedNvmeController(in.NvmeController)
+	if err != nil {
+		return nil, err
+	}
+	// ids and queues of connected hosts are not stored, they change with
+	// the connections
+	updated := server.ProtoClone(in.NvmeController)
+	updated.Status = server.ProtoClone(response.Status)
+	s.Nvme.Controllers[in.NvmeController.Name] = updated
 	return response, nil
 }
 
 // ListNvmeControllers lists Nvme controllers
 func (s *Server) ListNvmeControllers(_ context.Context, in *pb.ListNvmeControllersRequest) (*pb.ListNvmeControllersResponse, error) {
 	log.Printf("Received from client: %v", in.Parent)
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -257,6 +366,8 @@ func (s *Server) ListNvmeControllers(_ context.Context, in *pb.ListNvmeControlle
 // GetNvmeController gets an Nvme controller
 func (s *Server) GetNvmeController(_ context.Context, in *pb.GetNvmeControllerRequest) (*pb.NvmeController, error) {
 	log.Printf("Received from client: %v", in.Name)
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -274,12 +385,171 @@ func (s *Server) GetNvmeController(_ context.Context, in *pb.GetNvmeControllerRe
 		log.Printf("error: %v", err)
 		return nil, err
 	}
-	return &pb.NvmeController{Name: in.Name, Spec: &pb.NvmeControllerSpec{NvmeControllerId: controller.Spec.NvmeControllerId}, Status: &pb.NvmeControllerStatus{Active: true}}, nil
+	return s.connectedNvmeController(controller)
+}
+
+// GetNvmeControllerConnections gets hosts connected through an Nvme controller
+func (s *Server) GetNvmeControllerConnections(_ context.Context, in *bridgepb.GetNvmeControllerConnectionsRequest) (*bridgepb.GetNvmeControllerConnectionsResponse, error) {
+	log.Printf("GetNvmeControllerConnections: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	controller, ok := s.Nvme.Controllers[in.Name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	connections, err := s.nvmeControllerConnections(controller)
+	if err != nil {
+		return nil, err
+	}
+	return &bridgepb.GetNvmeControllerConnectionsResponse{Connections: connections}, nil
+}
+
+// connectedNvmeController returns a copy of controller filled with the
+// connections of hosts through it. Hosts connecting over fabrics get
+// a controller id each, the lowest one is reported together with the number
+// of I/O queues its host connected, if the spec leaves them to the default.
+// All connections including NQNs of their hosts are reported by
+// GetNvmeControllerConnections, since the OPI model has no fields for them
+func (s *Server) connectedNvmeController(controller *pb.NvmeController) (*pb.NvmeController, error) {
+	connections, err := s.nvmeControllerConnections(controller)
+	if err != nil {
+		return nil, err
+	}
+	response := server.ProtoClone(controller)
+	response.Status = &pb.NvmeControllerStatus{Active: len(connections) > 0}
+	if len(connections) > 0 {
+		response.Spec.NvmeControllerId = proto.Int32(connections[0].ControllerId)
+		if response.Spec.MaxNsq == 0 {
+			response.Spec.MaxNsq = connections[0].IoQueues
+		}
+		if response.Spec.MaxNcq == 0 {
+			response.Spec.MaxNcq = connections[0].IoQueues
+		}
+	}
+	return response, nil
+}
+
+// nvmeControllerConnections gets SPDK controllers with queue pairs connected
+// through the listener of an Nvme controller
+func (s *Server) nvmeControllerConnections(controller *pb.NvmeController) ([]*bridgepb.NvmeControllerConnection, error) {
+	subsys, ok := s.Nvme.Subsystems[controller.Spec.SubsystemNameRef]
+	if !ok {
+		err := fmt.Errorf("unable to find subsystem %s", controller.Spec.SubsystemNameRef)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	params := nvmfSubsystemGetControllersParams{
+		Nqn: subsys.Spec.Nqn,
+	}
+	var result []nvmfSubsystemGetControllersResult
+	err := s.rpc.Call("nvmf_subsystem_get_controllers", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	var qpairs []nvmfSubsystemGetQpairsResult
+	err = s.rpc.Call("nvmf_subsystem_get_qpairs", &params, &qpairs)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", qpairs)
+
+	listener := s.Nvme.subsysListener.Params(controller, subsys.Spec.Nqn).ListenAddress
+	connected := map[int]bool{}
+	for _, qpair := range qpairs {
+		if strings.EqualFold(qpair.ListenAddress.Trtype, listener.Trtype) &&
+			qpair.ListenAddress.Traddr == listener.Traddr &&
+			qpair.ListenAddress.Trsvcid == listener.Trsvcid {
+			connected[qpair.Cntlid] = true
+		}
+	}
+	connections := []*bridgepb.NvmeControllerConnection{}
+	for _, r := range result {
+		if connected[r.Cntlid] {
+			connections = append(connections, &bridgepb.NvmeControllerConnection{
+				ControllerId: int32(r.Cntlid),
+				HostNqn:      r.Hostnqn,
+				HostId:       r.Hostid,
+				IoQueues:     int32(r.NumIoQpairs),
+			})
+		}
+	}
+	sort.Slice(connections, func(i int, j int) bool {
+		return connections[i].ControllerId < connections[j].ControllerId
+	})
+	return connections, nil
+}
+
+// addNvmeListener adds the listener of controller name
+func (s *Server) addNvmeListener(name string, params *spdk.NvmfSubsystemAddListenerParams) error {
+	var result spdk.NvmfSubsystemAddListenerResult
+	err := s.rpc.Call("nvmf_subsystem_add_listener", params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not create CTRL: %s", name)
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+	return nil
+}
+
+// removeNvmeListener removes a listener added when a later step of creating
+// its controller failed, so errors are logged only
+func (s *Server) removeNvmeListener(params *spdk.NvmfSubsystemAddListenerParams) {
+	var result spdk.NvmfSubsystemAddListenerResult
+	if err := s.rpc.Call("nvmf_subsystem_remove_listener", params, &result); err != nil {
+		log.Printf("error: failed to remove listener of NQN %s: %v", params.Nqn, err)
+		return
+	}
+	log.Printf("Received from SPDK: %v", result)
+}
+
+// setNvmeListenerAnaState sets the ANA state hosts get reported for the path
+// through a listener
+func (s *Server) setNvmeListenerAnaState(listener *spdk.NvmfSubsystemAddListenerParams, state bridgepb.NvmeAnaState) error {
+	params := nvmfSubsystemListenerSetAnaStateParams{
+		Nqn:           listener.Nqn,
+		ListenAddress: listener.ListenAddress,
+		AnaState:      nvmeAnaStates[state],
+	}
+	var result nvmfSubsystemListenerSetAnaStateResult
+	err := s.rpc.Call("nvmf_subsystem_listener_set_ana_state", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not set ANA state %s for NQN: %s", params.AnaState, listener.Nqn)
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+	return nil
 }
 
 // StatsNvmeController gets an Nvme controller stats
 func (s *Server) StatsNvmeController(_ context.Context, in *pb.StatsNvmeControllerRequest) (*pb.StatsNvmeControllerResponse, error) {
-	log.Printf("StatsNvmeController: Received from client: %v", in)
+	log.Printf("StatsNvmeController: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
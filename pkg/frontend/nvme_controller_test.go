@@ -19,7 +19,9 @@ import (
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	"github.com/opiproject/gospdk/spdk"
 	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
 	"github.com/opiproject/opi-spdk-bridge/pkg/server"
 )
 
@@ -144,7 +146,7 @@ func TestFrontEnd_CreateNvmeController(t *testing.T) {
 					NvmeControllerId: proto.Int32(-1),
 				},
 				Status: &pb.NvmeControllerStatus{
-					Active: true,
+					Active: false,
 				},
 			},
 			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
@@ -244,6 +246,106 @@ func TestFrontEnd_CreateNvmeController(t *testing.T) {
 	}
 }
 
+func TestFrontEnd_CreateNvmeControllerSecureChannel(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	tests := map[string]struct {
+		hosts map[string]*nvmeHostKeys
+		out   bool
+	}{
+		"no hosts": {
+			hosts: nil,
+			out:   false,
+		},
+		"host without psk": {
+			hosts: map[string]*nvmeHostKeys{testHostNqn: {}},
+			out:   false,
+		},
+		"host with psk": {
+			hosts: map[string]*nvmeHostKeys{testHostNqn: {psk: "host-psk"}},
+			out:   true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment([]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`})
+			defer testEnv.Close()
+
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName] = server.ProtoClone(&testSubsystem)
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName].Name = testSubsystemName
+			testEnv.opiSpdkServer.Nvme.hostKeys[testSubsystemName] = tt.hosts
+
+			request := &pb.CreateNvmeControllerRequest{NvmeController: server.ProtoClone(&testController), NvmeControllerId: testControllerID}
+			if _, err := testEnv.client.CreateNvmeController(testEnv.ctx, request); err != nil {
+				t.Fatal(err)
+			}
+
+			secure, ok := testEnv.opiSpdkServer.Nvme.secureChannel[testControllerName]
+			if !ok || secure != tt.out {
+				t.Error("secure channel: expected", tt.out, "received", secure, ok)
+			}
+		})
+	}
+}
+
+func TestFrontEnd_CreateNvmeControllerAnaState(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	tests := map[string]struct {
+		state   *bridgepb.NvmeAnaState
+		spdk    []string
+		calls   []string
+		errCode codes.Code
+	}{
+		"optimized listener": {
+			nil,
+			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			[]string{"nvmf_subsystem_add_listener"},
+			codes.OK,
+		},
+		"inaccessible listener": {
+			bridgepb.NvmeAnaState_NVME_ANA_STATE_INACCESSIBLE.Enum(),
+			[]string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			[]string{"nvmf_subsystem_add_listener", "nvmf_subsystem_listener_set_ana_state"},
+			codes.OK,
+		},
+		"listener removed when ana state cannot be set": {
+			bridgepb.NvmeAnaState_NVME_ANA_STATE_INACCESSIBLE.Enum(),
+			[]string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":false}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			[]string{"nvmf_subsystem_add_listener", "nvmf_subsystem_listener_set_ana_state", "nvmf_subsystem_remove_listener"},
+			codes.InvalidArgument,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName] = server.ProtoClone(&testSubsystem)
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName].Name = testSubsystemName
+			if tt.state != nil {
+				testEnv.opiSpdkServer.Nvme.anaStates[testSubsystemName] = *tt.state
+			}
+
+			request := &pb.CreateNvmeControllerRequest{NvmeController: server.ProtoClone(&testController), NvmeControllerId: testControllerID}
+			_, err := testEnv.client.CreateNvmeController(testEnv.ctx, request)
+			if status.Code(err) != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", status.Code(err))
+			}
+			if !reflect.DeepEqual(testEnv.spdkCalls.methods, tt.calls) {
+				t.Error("SPDK calls: expected", tt.calls, "received", testEnv.spdkCalls.methods)
+			}
+		})
+	}
+}
+
 func TestFrontEnd_DeleteNvmeController(t *testing.T) {
 	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
 	tests := map[string]struct {
@@ -387,7 +489,7 @@ func TestFrontEnd_UpdateNvmeController(t *testing.T) {
 			fmt.Sprintf("invalid field path: %s", "'*' must not be used with other paths"),
 			false,
 		},
-		"valid request without SPDK": {
+		"valid request without connected hosts": {
 			nil,
 			&pb.NvmeController{
 				Name: testControllerName,
@@ -396,11 +498,41 @@ func TestFrontEnd_UpdateNvmeController(t *testing.T) {
 			&pb.NvmeController{
 				Name: testControllerName,
 				Spec: spec,
+				Status: &pb.NvmeControllerStatus{
+					Active: false,
+				},
+			},
+			[]string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":[]}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":[]}`,
+			},
+			codes.OK,
+			"",
+			false,
+		},
+		"valid request with connected host": {
+			nil,
+			&pb.NvmeController{
+				Name: testControllerName,
+				Spec: spec,
+			},
+			&pb.NvmeController{
+				Name: testControllerName,
+				Spec: &pb.NvmeControllerSpec{
+					SubsystemNameRef: spec.SubsystemNameRef,
+					PcieId:           spec.PcieId,
+					NvmeControllerId: proto.Int32(1),
+					MaxNsq:           4,
+					MaxNcq:           4,
+				},
 				Status: &pb.NvmeControllerStatus{
 					Active: true,
 				},
 			},
-			[]string{},
+			[]string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":[{"cntlid":1,"hostnqn":"nqn.2014-08.org.nvmexpress:uuid:feb98abe-d51f-40c8-b348-2753f3571d3c","hostid":"feb98abe-d51f-40c8-b348-2753f3571d3c","num_io_qpairs":4}]}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":[{"cntlid":1,"qid":0,"state":"active","listen_address":{"trtype":"TCP","adrfam":"IPv4","traddr":"127.0.0.1","trsvcid":"4420"}}]}`,
+			},
 			codes.OK,
 			"",
 			false,
@@ -446,6 +578,7 @@ func TestFrontEnd_UpdateNvmeController(t *testing.T) {
 			testEnv := createTestEnvironment(tt.spdk)
 			defer testEnv.Close()
 
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName] = server.ProtoClone(&testSubsystem)
 			testEnv.opiSpdkServer.Nvme.Controllers[testControllerName] = server.ProtoClone(&testController)
 
 			request := &pb.UpdateNvmeControllerRequest{NvmeController: tt.in, UpdateMask: tt.mask, AllowMissing: tt.missing}
@@ -588,16 +721,50 @@ func TestFrontEnd_GetNvmeController(t *testing.T) {
 			&pb.NvmeController{
 				Name: testControllerName,
 				Spec: &pb.NvmeControllerSpec{
-					NvmeControllerId: proto.Int32(17),
+					SubsystemNameRef: testSubsystemName,
+					PcieId:           testController.Spec.PcieId,
+					NvmeControllerId: proto.Int32(1),
+					MaxNsq:           4,
+					MaxNcq:           4,
 				},
 				Status: &pb.NvmeControllerStatus{
 					Active: true,
 				},
 			},
-			[]string{},
+			[]string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":[{"cntlid":1,"hostnqn":"nqn.2014-08.org.nvmexpress:uuid:feb98abe-d51f-40c8-b348-2753f3571d3c","hostid":"feb98abe-d51f-40c8-b348-2753f3571d3c","num_io_qpairs":4},{"cntlid":2,"hostnqn":"nqn.2014-08.org.nvmexpress:uuid:other","hostid":"other","num_io_qpairs":1}]}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":[{"cntlid":1,"qid":0,"state":"active","listen_address":{"trtype":"TCP","adrfam":"IPv4","traddr":"127.0.0.1","trsvcid":"4420"}},{"cntlid":2,"qid":0,"state":"active","listen_address":{"trtype":"TCP","adrfam":"IPv4","traddr":"127.0.0.1","trsvcid":"4421"}}]}`,
+			},
+			codes.OK,
+			"",
+		},
+		"valid request without connected hosts": {
+			testControllerName,
+			&pb.NvmeController{
+				Name: testControllerName,
+				Spec: &pb.NvmeControllerSpec{
+					SubsystemNameRef: testSubsystemName,
+					PcieId:           testController.Spec.PcieId,
+					NvmeControllerId: proto.Int32(17),
+				},
+				Status: &pb.NvmeControllerStatus{
+					Active: false,
+				},
+			},
+			[]string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":[]}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":[]}`,
+			},
 			codes.OK,
 			"",
 		},
+		"valid request with error code from SPDK response": {
+			testControllerName,
+			nil,
+			[]string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":[]}`},
+			codes.Unknown,
+			fmt.Sprintf("nvmf_subsystem_get_controllers: %v", "json response error: myopierr"),
+		},
 		"valid request with unknown key": {
 			"unknown-controller-id",
 			nil,
@@ -628,6 +795,7 @@ func TestFrontEnd_GetNvmeController(t *testing.T) {
 			defer testEnv.Close()
 			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName] = server.ProtoClone(&testSubsystem)
 			testEnv.opiSpdkServer.Nvme.Controllers[testControllerName] = server.ProtoClone(&testController)
+			testEnv.opiSpdkServer.Nvme.Controllers[testControllerName].Name = testControllerName
 
 			request := &pb.GetNvmeControllerRequest{Name: tt.in}
 			response, err := testEnv.client.GetNvmeController(testEnv.ctx, request)
@@ -650,6 +818,82 @@ func TestFrontEnd_GetNvmeController(t *testing.T) {
 	}
 }
 
+func TestFrontEnd_GetNvmeControllerConnections(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	tests := map[string]struct {
+		in      string
+		out     []*bridgepb.NvmeControllerConnection
+		spdk    []string
+		errCode codes.Code
+		errMsg  string
+	}{
+		"hosts connected through controller": {
+			testControllerName,
+			[]*bridgepb.NvmeControllerConnection{
+				{ControllerId: 1, HostNqn: "nqn.2014-08.org.nvmexpress:uuid:host1", HostId: "host1", IoQueues: 4},
+				{ControllerId: 3, HostNqn: "nqn.2014-08.org.nvmexpress:uuid:host3", HostId: "host3", IoQueues: 2},
+			},
+			[]string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":[{"cntlid":3,"hostnqn":"nqn.2014-08.org.nvmexpress:uuid:host3","hostid":"host3","num_io_qpairs":2},{"cntlid":1,"hostnqn":"nqn.2014-08.org.nvmexpress:uuid:host1","hostid":"host1","num_io_qpairs":4},{"cntlid":2,"hostnqn":"nqn.2014-08.org.nvmexpress:uuid:host2","hostid":"host2","num_io_qpairs":1}]}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":[{"cntlid":1,"qid":0,"state":"active","listen_address":{"trtype":"TCP","adrfam":"IPv4","traddr":"127.0.0.1","trsvcid":"4420"}},{"cntlid":1,"qid":1,"state":"active","listen_address":{"trtype":"TCP","adrfam":"IPv4","traddr":"127.0.0.1","trsvcid":"4420"}},{"cntlid":2,"qid":0,"state":"active","listen_address":{"trtype":"TCP","adrfam":"IPv4","traddr":"127.0.0.2","trsvcid":"4420"}},{"cntlid":3,"qid":0,"state":"active","listen_address":{"trtype":"TCP","adrfam":"IPv4","traddr":"127.0.0.1","trsvcid":"4420"}}]}`,
+			},
+			codes.OK,
+			"",
+		},
+		"no connected hosts": {
+			testControllerName,
+			[]*bridgepb.NvmeControllerConnection{},
+			[]string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":[]}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":[]}`,
+			},
+			codes.OK,
+			"",
+		},
+		"valid request with error code from SPDK response": {
+			testControllerName,
+			nil,
+			[]string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":[]}`,
+				`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":[]}`,
+			},
+			codes.Unknown,
+			fmt.Sprintf("nvmf_subsystem_get_qpairs: %v", "json response error: myopierr"),
+		},
+		"unknown key": {
+			"unknown-controller-id",
+			nil,
+			[]string{},
+			codes.NotFound,
+			fmt.Sprintf("unable to find key %s", "unknown-controller-id"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName] = server.ProtoClone(&testSubsystem)
+			testEnv.opiSpdkServer.Nvme.Controllers[testControllerName] = server.ProtoClone(&testController)
+
+			request := &bridgepb.GetNvmeControllerConnectionsRequest{Name: tt.in}
+			response, err := testEnv.client.GetNvmeControllerConnections(testEnv.ctx, request)
+
+			if !server.EqualProtoSlices(response.GetConnections(), tt.out) {
+				t.Error("response: expected", tt.out, "received", response.GetConnections())
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
+
 func TestFrontEnd_StatsNvmeController(t *testing.T) {
 	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
 	tests := map[string]struct {
@@ -775,3 +1019,101 @@ func TestFrontEnd_NewTcpSubsystemListener(t *testing.T) {
 		})
 	}
 }
+
+func TestFrontEnd_NewRdmaSubsystemListener(t *testing.T) {
+	tests := map[string]struct {
+		listenAddress string
+		wantPanic     bool
+		protocol      string
+	}{
+		"ipv4 valid address": {
+			listenAddress: "10.10.10.10:4420",
+			wantPanic:     false,
+			protocol:      ipv4NvmeTCPProtocol,
+		},
+		"valid ipv6 addresses": {
+			listenAddress: "[2002:0db0:8833:0000:0000:8a8a:0330:7337]:4420",
+			wantPanic:     false,
+			protocol:      ipv6NvmeTCPProtocol,
+		},
+		"missing port": {
+			listenAddress: "10.10.10.10",
+			wantPanic:     true,
+			protocol:      "",
+		},
+		"valid port invalid ip": {
+			listenAddress: "wrong:4420",
+			wantPanic:     true,
+			protocol:      "",
+		},
+	}
+
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				if (r != nil) != tt.wantPanic {
+					t.Errorf("NewRDMASubsystemListener() recover = %v, wantPanic = %v", r, tt.wantPanic)
+				}
+			}()
+
+			gotSubsysListener := NewRDMASubsystemListener(tt.listenAddress)
+			host, port, _ := net.SplitHostPort(tt.listenAddress)
+			wantSubsysListener := &rdmaSubsystemListener{
+				listenAddr: net.ParseIP(host),
+				listenPort: port,
+				protocol:   tt.protocol,
+			}
+
+			if !reflect.DeepEqual(gotSubsysListener, wantSubsysListener) {
+				t.Errorf("Expect %v subsystem listener, received %v", wantSubsysListener, gotSubsysListener)
+			}
+		})
+	}
+}
+
+func TestFrontEnd_RdmaSubsystemListenerParams(t *testing.T) {
+	wantParams := spdk.NvmfSubsystemAddListenerParams{}
+	wantParams.Nqn = "nqn.2022-09.io.spdk:opi3"
+	wantParams.ListenAddress.Trtype = "rdma"
+	wantParams.ListenAddress.Traddr = "10.10.10.10"
+	wantParams.ListenAddress.Trsvcid = "4420"
+	wantParams.ListenAddress.Adrfam = ipv4NvmeTCPProtocol
+
+	rdmaSubsysListener := NewRDMASubsystemListener("10.10.10.10:4420")
+	gotParams := rdmaSubsysListener.Params(&testController, "nqn.2022-09.io.spdk:opi3")
+
+	if !reflect.DeepEqual(wantParams, gotParams) {
+		t.Errorf("Expect %v, received %v", wantParams, gotParams)
+	}
+}
+
+func TestFrontEnd_RdmaNvmeControllerConnections(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	testEnv := createTestEnvironment([]string{
+		`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+		`{"id":%d,"error":{"code":0,"message":""},"result":[{"cntlid":1,"hostnqn":"nqn.2014-08.org.nvmexpress:uuid:host1","hostid":"host1","num_io_qpairs":8},{"cntlid":2,"hostnqn":"nqn.2014-08.org.nvmexpress:uuid:host2","hostid":"host2","num_io_qpairs":8}]}`,
+		`{"id":%d,"error":{"code":0,"message":""},"result":[{"cntlid":1,"qid":0,"state":"active","listen_address":{"trtype":"RDMA","adrfam":"IPv4","traddr":"10.10.10.10","trsvcid":"4420"}},{"cntlid":2,"qid":0,"state":"active","listen_address":{"trtype":"TCP","adrfam":"IPv4","traddr":"10.10.10.10","trsvcid":"4420"}}]}`,
+	})
+	defer testEnv.Close()
+	testEnv.opiSpdkServer.Nvme.subsysListener = NewRDMASubsystemListener("10.10.10.10:4420")
+	testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName] = server.ProtoClone(&testSubsystem)
+
+	request := &pb.CreateNvmeControllerRequest{NvmeController: server.ProtoClone(&testController), NvmeControllerId: testControllerID}
+	if _, err := testEnv.client.CreateNvmeController(testEnv.ctx, request); err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := testEnv.client.GetNvmeControllerConnections(testEnv.ctx,
+		&bridgepb.GetNvmeControllerConnectionsRequest{Name: testControllerName})
+	if err != nil {
+		t.Fatal(err)
+	}
+	connections := response.GetConnections()
+	want := []*bridgepb.NvmeControllerConnection{
+		{ControllerId: 1, HostNqn: "nqn.2014-08.org.nvmexpress:uuid:host1", HostId: "host1", IoQueues: 8},
+	}
+	if !server.EqualProtoSlices(connections, want) {
+		t.Error("connections: expected", want, "received", connections)
+	}
+}
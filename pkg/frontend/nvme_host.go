@@ -0,0 +1,576 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package frontend implements the FrontEnd APIs (host facing) of the storage Server
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/opiproject/gospdk/spdk"
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/keyring"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+
+	"go.einride.tech/aip/fieldbehavior"
+	"go.einride.tech/aip/resourcename"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// nvmfSubsystemAddHostParams extends spdk.NvmfSubsystemAddHostParams with
+// parameters not yet available there
+type nvmfSubsystemAddHostParams struct {
+	spdk.NvmfSubsystemAddHostParams
+	DhchapKey      string `json:"dhchap_key,omitempty"`
+	DhchapCtrlrKey string `json:"dhchap_ctrlr_key,omitempty"`
+}
+
+// nvmfSubsystemSetKeysParams holds the parameters required to change
+// DH-HMAC-CHAP keys of a host allowed to connect to a subsystem
+type nvmfSubsystemSetKeysParams struct {
+	Nqn            string `json:"nqn"`
+	Host           string `json:"host"`
+	DhchapKey      string `json:"dhchap_key,omitempty"`
+	DhchapCtrlrKey string `json:"dhchap_ctrlr_key,omitempty"`
+}
+
+// nvmfSubsystemSetKeysResult is the result of changing host DH-HMAC-CHAP keys
+type nvmfSubsystemSetKeysResult bool
+
+// nvmfSubsystemRemoveHostParams holds the parameters required to remove
+// a host from a subsystem
+type nvmfSubsystemRemoveHostParams struct {
+	Nqn  string `json:"nqn"`
+	Host string `json:"host"`
+}
+
+// nvmfSubsystemRemoveHostResult is the result of removing a host from a subsystem
+type nvmfSubsystemRemoveHostResult bool
+
+// nvmfSubsystemAllowAnyHostParams holds the parameters required to allow
+// any host or only allowed hosts to connect to a subsystem
+type nvmfSubsystemAllowAnyHostParams struct {
+	Nqn          string `json:"nqn"`
+	AllowAnyHost bool   `json:"allow_any_host"`
+}
+
+// nvmfSubsystemAllowAnyHostResult is the result of changing if any host can
+// connect to a subsystem
+type nvmfSubsystemAllowAnyHostResult bool
+
+// nvmeHostKeys contains names of keyring keys used to authenticate a host.
+// Hosts allowed to connect without keys have empty names
+type nvmeHostKeys struct {
+	hostKey  string
+	ctrlrKey string
+	psk      string
+}
+
+// AddNvmeSubsystemHost adds a host to the allowlist of a subsystem, optionally
+// with a TLS pre-shared key and DH-HMAC-CHAP keys. Keys of an allowed host
+// can be rotated by SetNvmeSubsystemHostDhchap
+func (s *Server) AddNvmeSubsystemHost(_ context.Context, in *bridgepb.AddNvmeSubsystemHostRequest) (*emptypb.Empty, error) {
+	log.Printf("AddNvmeSubsystemHost: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	subsys, ok := s.Nvme.Subsystems[in.Name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	host := in.Host
+	var settings *keyring.Dhchap
+	if host.Dhchap != nil {
+		settings = keyring.NewDhchap(host.Dhchap)
+		if err := verifyNvmeHostDhchap(settings); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := s.Nvme.hostKeys[subsys.Name][host.HostNqn]; ok {
+		err := status.Errorf(codes.AlreadyExists, "host %s is already allowed to connect to NQN: %s", host.HostNqn, subsys.Spec.Nqn)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if err := s.verifyNvmeHostChannel(subsys.Name, host.HostNqn, len(host.Psk) > 0); err != nil {
+		return nil, err
+	}
+
+	keys := &nvmeHostKeys{}
+	if settings != nil {
+		var err error
+		keys, err = s.addNvmeHostKeys(path.Base(subsys.Name), settings)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(host.Psk) > 0 {
+		name := fmt.Sprintf("%s-%s-psk", path.Base(subsys.Name), uuid.New().String())
+		if err := s.keyring.Add(name, host.Psk); err != nil {
+			s.removeNvmeHostKeys(keys)
+			return nil, err
+		}
+		keys.psk = name
+	}
+	if err := s.addNvmeHost(subsys.Spec.Nqn, host.HostNqn, keys); err != nil {
+		s.removeNvmeHostKeys(keys)
+		return nil, err
+	}
+
+	if _, ok := s.Nvme.hostKeys[subsys.Name]; !ok {
+		s.Nvme.hostKeys[subsys.Name] = make(map[string]*nvmeHostKeys)
+	}
+	s.Nvme.hostKeys[subsys.Name][host.HostNqn] = keys
+	return &emptypb.Empty{}, nil
+}
+
+// SetNvmeSubsystemAllowAnyHost changes if any host or only hosts in the
+// allowlist of a subsystem can connect to it
+func (s *Server) SetNvmeSubsystemAllowAnyHost(_ context.Context, in *bridgepb.SetNvmeSubsystemAllowAnyHostRequest) (*emptypb.Empty, error) {
+	log.Printf("SetNvmeSubsystemAllowAnyHost: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	subsys, ok := s.Nvme.Subsystems[in.Name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if err := s.setNvmeAllowAnyHost(subsys, in.AllowAnyHost); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) setNvmeAllowAnyHost(subsys *pb.NvmeSubsystem, allowAnyHost bool) error {
+	params := nvmfSubsystemAllowAnyHostParams{
+		Nqn:          subsys.Spec.Nqn,
+		AllowAnyHost: allowAnyHost,
+	}
+	var result nvmfSubsystemAllowAnyHostResult
+	err := s.rpc.Call("nvmf_subsystem_allow_any_host", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not set allow any host to %v for NQN: %s", allowAnyHost, subsys.Spec.Nqn)
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+	s.Nvme.allowAnyHost[subsys.Name] = allowAnyHost
+	return nil
+}
+
+// GetNvmeSubsystemHosts returns the allowlist of a subsystem together with
+// its drift from the hosts SPDK allows to connect
+func (s *Server) GetNvmeSubsystemHosts(_ context.Context, in *bridgepb.GetNvmeSubsystemHostsRequest) (*bridgepb.NvmeSubsystemHosts, error) {
+	log.Printf("GetNvmeSubsystemHosts: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	subsys, ok := s.Nvme.Subsystems[in.Name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+
+	var result []spdk.NvmfGetSubsystemsResult
+	err := s.rpc.Call("nvmf_get_subsystems", nil, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+
+	for i := range result {
+		r := &result[i]
+		if r.Nqn == subsys.Spec.Nqn {
+			return &bridgepb.NvmeSubsystemHosts{
+				AllowAnyHost: s.allowsAnyHost(subsys.Name),
+				HostNqns:     s.allowedHosts(subsys.Name),
+				Drift:        s.nvmeSubsystemHostsDrift(subsys.Name, r),
+			}, nil
+		}
+	}
+	msg := fmt.Sprintf("Could not find NQN: %s", subsys.Spec.Nqn)
+	log.Print(msg)
+	return nil, status.Errorf(codes.InvalidArgument, msg)
+}
+
+// SetNvmeSubsystemHostDhchap adds a host which has to pass DH-HMAC-CHAP
+// in-band authentication to connect to a subsystem. If the host already has
+// keys, they are rotated. Digests and DH groups of the target are set on SPDK
+// startup, so they cannot be provided here.
+func (s *Server) SetNvmeSubsystemHostDhchap(_ context.Context, in *bridgepb.SetNvmeSubsystemHostDhchapRequest) (*emptypb.Empty, error) {
+	log.Printf("SetNvmeSubsystemHostDhchap: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	subsys, ok := s.Nvme.Subsystems[in.Name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	settings := keyring.NewDhchap(in.Dhchap)
+	if err := verifyNvmeHostDhchap(settings); err != nil {
+		return nil, err
+	}
+	oldKeys, rotate := s.Nvme.hostKeys[subsys.Name][in.HostNqn]
+	if !rotate {
+		if err := s.verifyNvmeHostChannel(subsys.Name, in.HostNqn, false); err != nil {
+			return nil, err
+		}
+	}
+
+	keys, err := s.addNvmeHostKeys(path.Base(subsys.Name), settings)
+	if err != nil {
+		return nil, err
+	}
+	if rotate {
+		err = s.setNvmeHostKeys(subsys.Spec.Nqn, in.HostNqn, keys)
+	} else {
+		err = s.addNvmeHost(subsys.Spec.Nqn, in.HostNqn, keys)
+	}
+	if err != nil {
+		s.removeNvmeHostKeys(keys)
+		return nil, err
+	}
+	if rotate {
+		// the pre-shared key is kept, only DH-HMAC-CHAP keys are rotated
+		keys.psk = oldKeys.psk
+		s.removeNvmeHostKeys(&nvmeHostKeys{hostKey: oldKeys.hostKey, ctrlrKey: oldKeys.ctrlrKey})
+	}
+
+	if _, ok := s.Nvme.hostKeys[subsys.Name]; !ok {
+		s.Nvme.hostKeys[subsys.Name] = make(map[string]*nvmeHostKeys)
+	}
+	s.Nvme.hostKeys[subsys.Name][in.HostNqn] = keys
+	return &emptypb.Empty{}, nil
+}
+
+// RemoveNvmeSubsystemHostDhchap removes a host added by SetNvmeSubsystemHostDhchap
+// from a subsystem
+func (s *Server) RemoveNvmeSubsystemHostDhchap(_ context.Context, in *bridgepb.RemoveNvmeSubsystemHostDhchapRequest) (*emptypb.Empty, error) {
+	log.Printf("RemoveNvmeSubsystemHostDhchap: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if err := s.removeNvmeSubsystemHost(in.Name, in.HostNqn); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RemoveNvmeSubsystemHost removes a host from the allowlist of a subsystem
+// and deletes its keys
+func (s *Server) RemoveNvmeSubsystemHost(_ context.Context, in *bridgepb.RemoveNvmeSubsystemHostRequest) (*emptypb.Empty, error) {
+	log.Printf("RemoveNvmeSubsystemHost: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if err := s.removeNvmeSubsystemHost(in.Name, in.HostNqn); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) removeNvmeSubsystemHost(subsystemName string, hostNqn string) error {
+	subsys, ok := s.Nvme.Subsystems[subsystemName]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", subsystemName)
+		log.Printf("error: %v", err)
+		return err
+	}
+	keys, ok := s.Nvme.hostKeys[subsys.Name][hostNqn]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find host %s", hostNqn)
+		log.Printf("error: %v", err)
+		return err
+	}
+
+	params := nvmfSubsystemRemoveHostParams{
+		Nqn:  subsys.Spec.Nqn,
+		Host: hostNqn,
+	}
+	var result nvmfSubsystemRemoveHostResult
+	err := s.rpc.Call("nvmf_subsystem_remove_host", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not remove host %s from NQN: %s", hostNqn, subsys.Spec.Nqn)
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+
+	s.removeNvmeHostKeys(keys)
+	delete(s.Nvme.hostKeys[subsys.Name], hostNqn)
+	return nil
+}
+
+func (s *Server) addNvmeHost(nqn string, hostNqn string, keys *nvmeHostKeys) error {
+	params := nvmfSubsystemAddHostParams{
+		NvmfSubsystemAddHostParams: spdk.NvmfSubsystemAddHostParams{
+			Nqn:  nqn,
+			Host: hostNqn,
+			Psk:  keys.psk,
+		},
+		DhchapKey:      keys.hostKey,
+		DhchapCtrlrKey: keys.ctrlrKey,
+	}
+	var result spdk.NvmfSubsystemAddHostResult
+	err := s.rpc.Call("nvmf_subsystem_add_host", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not add host %s to NQN: %s", hostNqn, nqn)
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+	return nil
+}
+
+func (s *Server) setNvmeHostKeys(nqn string, hostNqn string, keys *nvmeHostKeys) error {
+	params := nvmfSubsystemSetKeysParams{
+		Nqn:            nqn,
+		Host:           hostNqn,
+		DhchapKey:      keys.hostKey,
+		DhchapCtrlrKey: keys.ctrlrKey,
+	}
+	var result nvmfSubsystemSetKeysResult
+	err := s.rpc.Call("nvmf_subsystem_set_keys", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not set keys for host %s in NQN: %s", hostNqn, nqn)
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+	return nil
+}
+
+func (s *Server) allowsAnyHost(subsystemName string) bool {
+	if allowAnyHost, ok := s.Nvme.allowAnyHost[subsystemName]; ok {
+		return allowAnyHost
+	}
+	return !s.Nvme.hostAllowlist
+}
+
+func (s *Server) allowedHosts(subsystemName string) []string {
+	hosts := make([]string, 0, len(s.Nvme.hostKeys[subsystemName]))
+	for host := range s.Nvme.hostKeys[subsystemName] {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	return hosts
+}
+
+func (s *Server) hasNvmePskHosts(subsystemName string) bool {
+	for _, keys := range s.Nvme.hostKeys[subsystemName] {
+		if keys.psk != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyNvmeHostChannel checks that a host can connect to TCP controllers of
+// a subsystem. A host with a pre-shared key requires a secure channel, while
+// a host without one cannot establish it. Controllers listen over a secure
+// channel if the subsystem had hosts with a pre-shared key when they were
+// created, so such hosts have to be added first
+func (s *Server) verifyNvmeHostChannel(subsystemName string, hostNqn string, psk bool) error {
+	names := make([]string, 0, len(s.Nvme.secureChannel))
+	for name := range s.Nvme.secureChannel {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if s.Nvme.Controllers[name].GetSpec().GetSubsystemNameRef() != subsystemName || s.Nvme.secureChannel[name] == psk {
+			continue
+		}
+		var err error
+		if psk {
+			err = status.Errorf(codes.FailedPrecondition, "host %s has a pre-shared key, but controller %s listens without a secure channel", hostNqn, name)
+		} else {
+			err = status.Errorf(codes.FailedPrecondition, "host %s has no pre-shared key, but controller %s listens over a secure channel", hostNqn, name)
+		}
+		log.Printf("error: %v", err)
+		return err
+	}
+	return nil
+}
+
+// nvmeSubsystemHostsDrift describes how the hosts SPDK allows to connect to
+// a subsystem differ from the hosts the bridge expects, so hosts added or
+// removed directly in SPDK are not overlooked. Empty if they match
+func (s *Server) nvmeSubsystemHostsDrift(subsystemName string, result *spdk.NvmfGetSubsystemsResult) string {
+	hosts := spdkSubsystemHosts(result)
+	allowAnyHost := s.allowsAnyHost(subsystemName)
+	allowedHosts := s.allowedHosts(subsystemName)
+	if result.AllowAnyHost == allowAnyHost && fmt.Sprint(hosts) == fmt.Sprint(allowedHosts) {
+		return ""
+	}
+	return fmt.Sprintf("Hosts of NQN: %s do not match, allow any host %v and hosts %v, expected %v and %v",
+		result.Nqn, result.AllowAnyHost, hosts, allowAnyHost, allowedHosts)
+}
+
+// adoptNvmeSubsystemHosts replaces the allowlist of a subsystem by the hosts
+// SPDK allows to connect, if they differ. Hosts added directly in SPDK are
+// adopted without keys, keys of hosts removed directly in SPDK are deleted
+func (s *Server) adoptNvmeSubsystemHosts(subsystemName string, result *spdk.NvmfGetSubsystemsResult) {
+	drift := s.nvmeSubsystemHostsDrift(subsystemName, result)
+	if drift == "" {
+		return
+	}
+	log.Printf("%s, adopting hosts of SPDK", drift)
+	s.Nvme.allowAnyHost[subsystemName] = result.AllowAnyHost
+	hosts := make(map[string]bool)
+	for _, host := range spdkSubsystemHosts(result) {
+		hosts[host] = true
+		if _, ok := s.Nvme.hostKeys[subsystemName]; !ok {
+			s.Nvme.hostKeys[subsystemName] = make(map[string]*nvmeHostKeys)
+		}
+		if _, ok := s.Nvme.hostKeys[subsystemName][host]; !ok {
+			s.Nvme.hostKeys[subsystemName][host] = &nvmeHostKeys{}
+		}
+	}
+	for host, keys := range s.Nvme.hostKeys[subsystemName] {
+		if !hosts[host] {
+			s.removeNvmeHostKeys(keys)
+			delete(s.Nvme.hostKeys[subsystemName], host)
+		}
+	}
+}
+
+// spdkSubsystemHosts returns sorted NQNs of hosts SPDK allows to connect to
+// a subsystem
+func spdkSubsystemHosts(result *spdk.NvmfGetSubsystemsResult) []string {
+	hosts := make([]string, 0, len(result.Hosts))
+	for _, host := range result.Hosts {
+		if attrs, ok := host.(map[string]interface{}); ok {
+			hosts = append(hosts, fmt.Sprint(attrs["nqn"]))
+		}
+	}
+	sort.Strings(hosts)
+	return hosts
+}
+
+func verifyNvmeHostDhchap(settings *keyring.Dhchap) error {
+	if err := settings.Validate(); err != nil {
+		log.Printf("error: %v", err)
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(settings.Digests) > 0 || len(settings.DhGroups) > 0 {
+		msg := "dhchap digests and dh groups of the target are set on SPDK startup"
+		log.Print(msg)
+		return status.Error(codes.InvalidArgument, msg)
+	}
+	return nil
+}
+
+func (s *Server) addNvmeHostKeys(resourceID string, settings *keyring.Dhchap) (*nvmeHostKeys, error) {
+	keys := &nvmeHostKeys{}
+	prefix := fmt.Sprintf("%s-%s", resourceID, uuid.New().String())
+
+	if err := s.keyring.Add(prefix+"-dhchap-host", settings.HostKey); err != nil {
+		return nil, err
+	}
+	keys.hostKey = prefix + "-dhchap-host"
+
+	if len(settings.CtrlrKey) > 0 {
+		if err := s.keyring.Add(prefix+"-dhchap-ctrlr", settings.CtrlrKey); err != nil {
+			s.removeNvmeHostKeys(keys)
+			return nil, err
+		}
+		keys.ctrlrKey = prefix + "-dhchap-ctrlr"
+	}
+	return keys, nil
+}
+
+func (s *Server) removeNvmeHostKeys(keys *nvmeHostKeys) {
+	for _, name := range []string{keys.hostKey, keys.ctrlrKey, keys.psk} {
+		if name == "" {
+			continue
+		}
+		if err := s.keyring.Remove(name); err != nil {
+			log.Printf("error: failed to remove key %v: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,546 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package frontend implements the FrontEnd APIs (host facing) of the storage Server
+package frontend
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+)
+
+var (
+	testHostNqn        = "nqn.2014-08.org.nvmexpress:uuid:feb98abe-d51f-40c8-b348-2753f3571d3c"
+	testDhchapHostKey  = []byte("DHHC-1:00:AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8gISIj:")
+	testDhchapCtrlrKey = []byte("DHHC-1:01:ZGVmZ2hpamtsbW5vcHFyc3R1dnd4eXp7fH1+f4CBgoOEhYaH:")
+)
+
+func TestFrontEnd_SetNvmeSubsystemHostDhchap(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	tests := map[string]struct {
+		subsystem string
+		host      string
+		in        *bridgepb.Dhchap
+		spdk      []string
+		errCode   codes.Code
+		errMsg    string
+		exist     bool
+		withCtrlr bool
+	}{
+		"unknown subsystem": {
+			subsystem: server.ResourceIDToVolumeName("unknown"),
+			host:      testHostNqn,
+			in:        &bridgepb.Dhchap{HostKey: testDhchapHostKey},
+			spdk:      []string{},
+			errCode:   codes.NotFound,
+			errMsg:    "unable to find key " + server.ResourceIDToVolumeName("unknown"),
+		},
+		"empty host nqn": {
+			subsystem: testSubsystemName,
+			host:      "",
+			in:        &bridgepb.Dhchap{HostKey: testDhchapHostKey},
+			spdk:      []string{},
+			errCode:   codes.Unknown,
+			errMsg:    "missing required field: host_nqn",
+		},
+		"digests are not allowed": {
+			subsystem: testSubsystemName,
+			host:      testHostNqn,
+			in:        &bridgepb.Dhchap{HostKey: testDhchapHostKey, Digests: []string{"sha256"}},
+			spdk:      []string{},
+			errCode:   codes.InvalidArgument,
+			errMsg:    "dhchap digests and dh groups of the target are set on SPDK startup",
+		},
+		"add new host": {
+			subsystem: testSubsystemName,
+			host:      testHostNqn,
+			in:        &bridgepb.Dhchap{HostKey: testDhchapHostKey, CtrlrKey: testDhchapCtrlrKey},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode:   codes.OK,
+			withCtrlr: true,
+		},
+		"add host fails": {
+			subsystem: testSubsystemName,
+			host:      testHostNqn,
+			in:        &bridgepb.Dhchap{HostKey: testDhchapHostKey},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":false}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode: codes.InvalidArgument,
+			errMsg:  "Could not add host " + testHostNqn + " to NQN: " + testSubsystem.Spec.Nqn,
+		},
+		"rotate keys of existing host": {
+			subsystem: testSubsystemName,
+			host:      testHostNqn,
+			in:        &bridgepb.Dhchap{HostKey: testDhchapHostKey},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode: codes.OK,
+			exist:   true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName] = server.ProtoClone(&testSubsystem)
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName].Name = testSubsystemName
+			var oldKeys *nvmeHostKeys
+			if tt.exist {
+				// first SPDK response is consumed by previously configured key
+				oldKeys = &nvmeHostKeys{hostKey: "old-dhchap-host"}
+				if err := testEnv.opiSpdkServer.keyring.Add(oldKeys.hostKey, testDhchapHostKey); err != nil {
+					t.Fatal(err)
+				}
+				testEnv.opiSpdkServer.Nvme.hostKeys[testSubsystemName] = map[string]*nvmeHostKeys{
+					testHostNqn: oldKeys,
+				}
+			}
+
+			request := &bridgepb.SetNvmeSubsystemHostDhchapRequest{Name: tt.subsystem, HostNqn: tt.host, Dhchap: tt.in}
+			_, err := testEnv.client.SetNvmeSubsystemHostDhchap(testEnv.ctx, request)
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+
+			keys, ok := testEnv.opiSpdkServer.Nvme.hostKeys[testSubsystemName][testHostNqn]
+			switch {
+			case tt.errCode == codes.OK && !ok:
+				t.Error("expected host keys to be stored")
+			case tt.errCode == codes.OK && keys == oldKeys:
+				t.Error("expected host keys to be rotated")
+			case tt.errCode == codes.OK && tt.withCtrlr != (keys.ctrlrKey != ""):
+				t.Error("expected controller key to be stored", tt.withCtrlr, "received", keys.ctrlrKey)
+			case tt.errCode != codes.OK && ok:
+				t.Error("expected no host keys stored on error, received", keys)
+			}
+			if tt.exist && tt.errCode == codes.OK && testEnv.opiSpdkServer.keyring.Contains(oldKeys.hostKey) {
+				t.Error("expected old key to be removed")
+			}
+		})
+	}
+}
+
+func TestFrontEnd_RemoveNvmeSubsystemHostDhchap(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	tests := map[string]struct {
+		host    string
+		spdk    []string
+		errCode codes.Code
+		errMsg  string
+	}{
+		"unknown host": {
+			host:    "nqn.2014-08.org.nvmexpress:uuid:unknown",
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode: codes.NotFound,
+			errMsg:  "unable to find host nqn.2014-08.org.nvmexpress:uuid:unknown",
+		},
+		"remove host fails": {
+			host: testHostNqn,
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":false}`,
+			},
+			errCode: codes.InvalidArgument,
+			errMsg:  "Could not remove host " + testHostNqn + " from NQN: " + testSubsystem.Spec.Nqn,
+		},
+		"valid request": {
+			host: testHostNqn,
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode: codes.OK,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName] = server.ProtoClone(&testSubsystem)
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName].Name = testSubsystemName
+			if err := testEnv.opiSpdkServer.keyring.Add("host-key", testDhchapHostKey); err != nil {
+				t.Fatal(err)
+			}
+			testEnv.opiSpdkServer.Nvme.hostKeys[testSubsystemName] = map[string]*nvmeHostKeys{
+				testHostNqn: {hostKey: "host-key"},
+			}
+
+			request := &bridgepb.RemoveNvmeSubsystemHostDhchapRequest{Name: testSubsystemName, HostNqn: tt.host}
+			_, err := testEnv.client.RemoveNvmeSubsystemHostDhchap(testEnv.ctx, request)
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+
+			_, ok := testEnv.opiSpdkServer.Nvme.hostKeys[testSubsystemName][testHostNqn]
+			if ok == (tt.errCode == codes.OK) {
+				t.Error("unexpected host keys presence", ok)
+			}
+		})
+	}
+}
+
+func TestFrontEnd_AddNvmeSubsystemHost(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	tests := map[string]struct {
+		subsystem string
+		in        *bridgepb.NvmeSubsystemHost
+		spdk      []string
+		errCode   codes.Code
+		errMsg    string
+		exist     bool
+		withPsk   bool
+		withKey   bool
+		// secure is the secure channel of an existing TCP controller, nil
+		// without controllers
+		secure *bool
+	}{
+		"unknown subsystem": {
+			subsystem: server.ResourceIDToVolumeName("unknown"),
+			in:        &bridgepb.NvmeSubsystemHost{HostNqn: testHostNqn},
+			spdk:      []string{},
+			errCode:   codes.NotFound,
+			errMsg:    "unable to find key " + server.ResourceIDToVolumeName("unknown"),
+		},
+		"missing host": {
+			subsystem: testSubsystemName,
+			in:        nil,
+			spdk:      []string{},
+			errCode:   codes.Unknown,
+			errMsg:    "missing required field: host",
+		},
+		"empty host nqn": {
+			subsystem: testSubsystemName,
+			in:        &bridgepb.NvmeSubsystemHost{},
+			spdk:      []string{},
+			errCode:   codes.Unknown,
+			errMsg:    "missing required field: host.host_nqn",
+		},
+		"dh groups are not allowed": {
+			subsystem: testSubsystemName,
+			in: &bridgepb.NvmeSubsystemHost{
+				HostNqn: testHostNqn,
+				Dhchap:  &bridgepb.Dhchap{HostKey: testDhchapHostKey, DhGroups: []string{"ffdhe2048"}},
+			},
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  "dhchap digests and dh groups of the target are set on SPDK startup",
+		},
+		"host already allowed": {
+			subsystem: testSubsystemName,
+			in:        &bridgepb.NvmeSubsystemHost{HostNqn: testHostNqn},
+			spdk:      []string{},
+			errCode:   codes.AlreadyExists,
+			errMsg:    "host " + testHostNqn + " is already allowed to connect to NQN: " + testSubsystem.Spec.Nqn,
+			exist:     true,
+		},
+		"host without keys": {
+			subsystem: testSubsystemName,
+			in:        &bridgepb.NvmeSubsystemHost{HostNqn: testHostNqn},
+			spdk:      []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode:   codes.OK,
+		},
+		"host with psk and dhchap keys": {
+			subsystem: testSubsystemName,
+			in: &bridgepb.NvmeSubsystemHost{
+				HostNqn: testHostNqn,
+				Psk:     []byte("NVMeTLSkey-1:01:MDAxMTIyMzM0NDU1NjY3Nzg4OTlhYWJiY2NkZGVlZmZwJEiQ:"),
+				Dhchap:  &bridgepb.Dhchap{HostKey: testDhchapHostKey},
+			},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode: codes.OK,
+			withPsk: true,
+			withKey: true,
+		},
+		"host with psk and controller without secure channel": {
+			subsystem: testSubsystemName,
+			in: &bridgepb.NvmeSubsystemHost{
+				HostNqn: testHostNqn,
+				Psk:     []byte("NVMeTLSkey-1:01:MDAxMTIyMzM0NDU1NjY3Nzg4OTlhYWJiY2NkZGVlZmZwJEiQ:"),
+			},
+			spdk:    []string{},
+			errCode: codes.FailedPrecondition,
+			errMsg:  "host " + testHostNqn + " has a pre-shared key, but controller " + testControllerName + " listens without a secure channel",
+			secure:  proto.Bool(false),
+		},
+		"host with psk and controller with secure channel": {
+			subsystem: testSubsystemName,
+			in: &bridgepb.NvmeSubsystemHost{
+				HostNqn: testHostNqn,
+				Psk:     []byte("NVMeTLSkey-1:01:MDAxMTIyMzM0NDU1NjY3Nzg4OTlhYWJiY2NkZGVlZmZwJEiQ:"),
+			},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode: codes.OK,
+			withPsk: true,
+			secure:  proto.Bool(true),
+		},
+		"host without psk and controller with secure channel": {
+			subsystem: testSubsystemName,
+			in:        &bridgepb.NvmeSubsystemHost{HostNqn: testHostNqn},
+			spdk:      []string{},
+			errCode:   codes.FailedPrecondition,
+			errMsg:    "host " + testHostNqn + " has no pre-shared key, but controller " + testControllerName + " listens over a secure channel",
+			secure:    proto.Bool(true),
+		},
+		"add host fails": {
+			subsystem: testSubsystemName,
+			in: &bridgepb.NvmeSubsystemHost{
+				HostNqn: testHostNqn,
+				Psk:     []byte("NVMeTLSkey-1:01:MDAxMTIyMzM0NDU1NjY3Nzg4OTlhYWJiY2NkZGVlZmZwJEiQ:"),
+			},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":false}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode: codes.InvalidArgument,
+			errMsg:  "Could not add host " + testHostNqn + " to NQN: " + testSubsystem.Spec.Nqn,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName] = server.ProtoClone(&testSubsystem)
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName].Name = testSubsystemName
+			if tt.exist {
+				testEnv.opiSpdkServer.Nvme.hostKeys[testSubsystemName] = map[string]*nvmeHostKeys{
+					testHostNqn: {},
+				}
+			}
+			if tt.secure != nil {
+				testEnv.opiSpdkServer.Nvme.Controllers[testControllerName] = server.ProtoClone(&testController)
+				testEnv.opiSpdkServer.Nvme.secureChannel[testControllerName] = *tt.secure
+			}
+
+			request := &bridgepb.AddNvmeSubsystemHostRequest{Name: tt.subsystem, Host: tt.in}
+			_, err := testEnv.client.AddNvmeSubsystemHost(testEnv.ctx, request)
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+
+			keys, ok := testEnv.opiSpdkServer.Nvme.hostKeys[testSubsystemName][testHostNqn]
+			switch {
+			case tt.errCode == codes.OK && !ok:
+				t.Error("expected host to be allowed")
+			case tt.errCode == codes.OK && tt.withPsk != (keys.psk != ""):
+				t.Error("expected psk to be stored", tt.withPsk, "received", keys.psk)
+			case tt.errCode == codes.OK && tt.withKey != (keys.hostKey != ""):
+				t.Error("expected host key to be stored", tt.withKey, "received", keys.hostKey)
+			case tt.errCode != codes.OK && ok != tt.exist:
+				t.Error("expected no host allowed on error, received", keys)
+			}
+		})
+	}
+}
+
+func TestFrontEnd_SetNvmeSubsystemAllowAnyHost(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	tests := map[string]struct {
+		subsystem string
+		in        bool
+		spdk      []string
+		out       *bridgepb.NvmeSubsystemHosts
+		errCode   codes.Code
+		errMsg    string
+	}{
+		"unknown subsystem": {
+			subsystem: server.ResourceIDToVolumeName("unknown"),
+			in:        false,
+			spdk:      []string{},
+			out:       &bridgepb.NvmeSubsystemHosts{AllowAnyHost: true, HostNqns: []string{testHostNqn}},
+			errCode:   codes.NotFound,
+			errMsg:    "unable to find key " + server.ResourceIDToVolumeName("unknown"),
+		},
+		"allow only hosts in allowlist": {
+			subsystem: testSubsystemName,
+			in:        false,
+			spdk:      []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			out:       &bridgepb.NvmeSubsystemHosts{AllowAnyHost: false, HostNqns: []string{testHostNqn}},
+			errCode:   codes.OK,
+		},
+		"valid request with invalid SPDK response": {
+			subsystem: testSubsystemName,
+			in:        false,
+			spdk:      []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			out:       &bridgepb.NvmeSubsystemHosts{AllowAnyHost: true, HostNqns: []string{testHostNqn}},
+			errCode:   codes.InvalidArgument,
+			errMsg:    "Could not set allow any host to false for NQN: " + testSubsystem.Spec.Nqn,
+		},
+		"valid request with error code from SPDK response": {
+			subsystem: testSubsystemName,
+			in:        false,
+			spdk:      []string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":false}`},
+			out:       &bridgepb.NvmeSubsystemHosts{AllowAnyHost: true, HostNqns: []string{testHostNqn}},
+			errCode:   codes.Unknown,
+			errMsg:    "nvmf_subsystem_allow_any_host: json response error: myopierr",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName] = server.ProtoClone(&testSubsystem)
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName].Name = testSubsystemName
+			testEnv.opiSpdkServer.Nvme.hostKeys[testSubsystemName] = map[string]*nvmeHostKeys{
+				testHostNqn: {},
+			}
+
+			request := &bridgepb.SetNvmeSubsystemAllowAnyHostRequest{Name: tt.subsystem, AllowAnyHost: tt.in}
+			_, err := testEnv.client.SetNvmeSubsystemAllowAnyHost(testEnv.ctx, request)
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+
+			hosts := &bridgepb.NvmeSubsystemHosts{
+				AllowAnyHost: testEnv.opiSpdkServer.allowsAnyHost(testSubsystemName),
+				HostNqns:     testEnv.opiSpdkServer.allowedHosts(testSubsystemName),
+			}
+			if !proto.Equal(hosts, tt.out) {
+				t.Error("hosts: expected", tt.out, "received", hosts)
+			}
+		})
+	}
+}
+
+func TestFrontEnd_GetNvmeSubsystemHosts(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	tests := map[string]struct {
+		subsystem string
+		spdk      []string
+		out       *bridgepb.NvmeSubsystemHosts
+		errCode   codes.Code
+		errMsg    string
+	}{
+		"unknown subsystem": {
+			subsystem: server.ResourceIDToVolumeName("unknown"),
+			spdk:      []string{},
+			out:       nil,
+			errCode:   codes.NotFound,
+			errMsg:    "unable to find key " + server.ResourceIDToVolumeName("unknown"),
+		},
+		"hosts match": {
+			subsystem: testSubsystemName,
+			spdk:      []string{`{"id":%d,"error":{"code":0,"message":""},"result":[{"nqn": "nqn.2022-09.io.spdk:opi3", "allow_any_host": true, "hosts": [{"nqn": "` + testHostNqn + `"}]}]}`},
+			out:       &bridgepb.NvmeSubsystemHosts{AllowAnyHost: true, HostNqns: []string{testHostNqn}},
+			errCode:   codes.OK,
+		},
+		"host not in allowlist": {
+			subsystem: testSubsystemName,
+			spdk:      []string{`{"id":%d,"error":{"code":0,"message":""},"result":[{"nqn": "nqn.2022-09.io.spdk:opi3", "allow_any_host": true, "hosts": [{"nqn": "` + testHostNqn + `"}, {"nqn": "nqn.2014-08.org.nvmexpress:uuid:unknown"}]}]}`},
+			out: &bridgepb.NvmeSubsystemHosts{
+				AllowAnyHost: true,
+				HostNqns:     []string{testHostNqn},
+				Drift: "Hosts of NQN: nqn.2022-09.io.spdk:opi3 do not match, allow any host true and hosts [" +
+					testHostNqn + " nqn.2014-08.org.nvmexpress:uuid:unknown], expected true and [" + testHostNqn + "]",
+			},
+			errCode: codes.OK,
+		},
+		"allow any host mismatch": {
+			subsystem: testSubsystemName,
+			spdk:      []string{`{"id":%d,"error":{"code":0,"message":""},"result":[{"nqn": "nqn.2022-09.io.spdk:opi3", "allow_any_host": false, "hosts": [{"nqn": "` + testHostNqn + `"}]}]}`},
+			out: &bridgepb.NvmeSubsystemHosts{
+				AllowAnyHost: true,
+				HostNqns:     []string{testHostNqn},
+				Drift: "Hosts of NQN: nqn.2022-09.io.spdk:opi3 do not match, allow any host false and hosts [" +
+					testHostNqn + "], expected true and [" + testHostNqn + "]",
+			},
+			errCode: codes.OK,
+		},
+		"subsystem not in SPDK": {
+			subsystem: testSubsystemName,
+			spdk:      []string{`{"id":%d,"error":{"code":0,"message":""},"result":[]}`},
+			out:       nil,
+			errCode:   codes.InvalidArgument,
+			errMsg:    "Could not find NQN: " + testSubsystem.Spec.Nqn,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName] = server.ProtoClone(&testSubsystem)
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName].Name = testSubsystemName
+			testEnv.opiSpdkServer.Nvme.hostKeys[testSubsystemName] = map[string]*nvmeHostKeys{
+				testHostNqn: {},
+			}
+
+			request := &bridgepb.GetNvmeSubsystemHostsRequest{Name: tt.subsystem}
+			hosts, err := testEnv.client.GetNvmeSubsystemHosts(testEnv.ctx, request)
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+			if !proto.Equal(hosts, tt.out) {
+				t.Error("hosts: expected", tt.out, "received", hosts)
+			}
+		})
+	}
+}
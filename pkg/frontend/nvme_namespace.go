@@ -34,7 +34,9 @@ func sortNvmeNamespaces(namespaces []*pb.NvmeNamespace) {
 
 // CreateNvmeNamespace creates an Nvme namespace
 func (s *Server) CreateNvmeNamespace(_ context.Context, in *pb.CreateNvmeNamespaceRequest) (*pb.NvmeNamespace, error) {
-	log.Printf("CreateNvmeNamespace: Received from client: %v", in)
+	log.Printf("CreateNvmeNamespace: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -80,17 +82,21 @@ func (s *Server) CreateNvmeNamespace(_ context.Context, in *pb.CreateNvmeNamespa
 		log.Printf("error: %v", err)
 		return nil, err
 	}
+	volume, err := s.registry.Get(in.NvmeNamespace.Spec.VolumeNameRef)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
 
 	params := spdk.NvmfSubsystemAddNsParams{
 		Nqn: subsys.Spec.Nqn,
 	}
 
-	// TODO: using bdev for volume id as a middle end handle for now
 	params.Namespace.Nsid = int(in.NvmeNamespace.Spec.HostNsid)
-	params.Namespace.BdevName = in.NvmeNamespace.Spec.VolumeNameRef
+	params.Namespace.BdevName = volume.BdevName
 
 	var result spdk.NvmfSubsystemAddNsResult
-	err := s.rpc.Call("nvmf_subsystem_add_ns", &params, &result)
+	err = s.rpc.Call("nvmf_subsystem_add_ns", &params, &result)
 	if err != nil {
 		log.Printf("error: %v", err)
 		return nil, err
@@ -106,12 +112,15 @@ func (s *Server) CreateNvmeNamespace(_ context.Context, in *pb.CreateNvmeNamespa
 	response.Status = &pb.NvmeNamespaceStatus{PciState: 2, PciOperState: 1}
 	response.Spec.HostNsid = int32(result)
 	s.Nvme.Namespaces[in.NvmeNamespace.Name] = response
+	s.acquireVolume(volume.Name, response.Name)
 	return response, nil
 }
 
 // DeleteNvmeNamespace deletes an Nvme namespace
 func (s *Server) DeleteNvmeNamespace(_ context.Context, in *pb.DeleteNvmeNamespaceRequest) (*emptypb.Empty, error) {
-	log.Printf("DeleteNvmeNamespace: Received from client: %v", in)
+	log.Printf("DeleteNvmeNamespace: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -156,12 +165,15 @@ func (s *Server) DeleteNvmeNamespace(_ context.Context, in *pb.DeleteNvmeNamespa
 		return nil, status.Errorf(codes.InvalidArgument, msg)
 	}
 	delete(s.Nvme.Namespaces, namespace.Name)
+	s.registry.Release(namespace.Spec.VolumeNameRef, namespace.Name)
 	return &emptypb.Empty{}, nil
 }
 
 // UpdateNvmeNamespace updates an Nvme namespace
 func (s *Server) UpdateNvmeNamespace(_ context.Context, in *pb.UpdateNvmeNamespaceRequest) (*pb.NvmeNamespace, error) {
-	log.Printf("UpdateNvmeNamespace: Received from client: %v", in)
+	log.Printf("UpdateNvmeNamespace: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -203,7 +215,9 @@ func (s *Server) UpdateNvmeNamespace(_ context.Context, in *pb.UpdateNvmeNamespa
 
 // ListNvmeNamespaces lists Nvme namespaces
 func (s *Server) ListNvmeNamespaces(_ context.Context, in *pb.ListNvmeNamespacesRequest) (*pb.ListNvmeNamespacesResponse, error) {
-	log.Printf("ListNvmeNamespaces: Received from client: %v", in)
+	log.Printf("ListNvmeNamespaces: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -262,7 +276,9 @@ func (s *Server) ListNvmeNamespaces(_ context.Context, in *pb.ListNvmeNamespaces
 
 // GetNvmeNamespace gets an Nvme namespace
 func (s *Server) GetNvmeNamespace(_ context.Context, in *pb.GetNvmeNamespaceRequest) (*pb.NvmeNamespace, error) {
-	log.Printf("GetNvmeNamespace: Received from client: %v", in)
+	log.Printf("GetNvmeNamespace: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -321,9 +337,12 @@ func (s *Server) GetNvmeNamespace(_ context.Context, in *pb.GetNvmeNamespaceRequ
 	return nil, status.Errorf(codes.InvalidArgument, msg)
 }
 
-// StatsNvmeNamespace gets an Nvme namespace stats
+// StatsNvmeNamespace gets an Nvme namespace stats. Stats are not collected
+// yet, StatsVolume reports them for the volume of the namespace
 func (s *Server) StatsNvmeNamespace(_ context.Context, in *pb.StatsNvmeNamespaceRequest) (*pb.StatsNvmeNamespaceResponse, error) {
-	log.Printf("StatsNvmeNamespace: Received from client: %v", in)
+	log.Printf("StatsNvmeNamespace: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -177,6 +177,20 @@ func TestFrontEnd_CreateNvmeNamespace(t *testing.T) {
 			fmt.Sprintf("segment '%s': not a valid DNS name", "-ABC-DEF"),
 			false,
 		},
+		"unknown volume": {
+			testNamespaceID,
+			&pb.NvmeNamespace{
+				Spec: &pb.NvmeNamespaceSpec{
+					SubsystemNameRef: testSubsystemName,
+					VolumeNameRef:    "unknown-volume",
+				},
+			},
+			nil,
+			[]string{},
+			codes.NotFound,
+			"unable to find volume unknown-volume",
+			false,
+		},
 		"no required ns field": {
 			testNamespaceID,
 			nil,
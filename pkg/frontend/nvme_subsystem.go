@@ -14,6 +14,7 @@ import (
 
 	"github.com/opiproject/gospdk/spdk"
 	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
 	"github.com/opiproject/opi-spdk-bridge/pkg/server"
 
 	"github.com/google/uuid"
@@ -23,9 +24,24 @@ import (
 	"go.einride.tech/aip/resourcename"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+// Limits of identifiers reported by controllers of a subsystem, see
+// Identify Controller data structure of the NVMe specification
+const (
+	maxSerialNumberLength = 20
+	maxModelNumberLength  = 40
+)
+
+// nvmfCreateSubsystemParams extends spdk.NvmfCreateSubsystemParams with
+// parameters not yet available there
+type nvmfCreateSubsystemParams struct {
+	spdk.NvmfCreateSubsystemParams
+	AnaReporting bool `json:"ana_reporting,omitempty"`
+}
+
 func sortNvmeSubsystems(subsystems []*pb.NvmeSubsystem) {
 	sort.Slice(subsystems, func(i int, j int) bool {
 		return subsystems[i].Spec.Nqn < subsystems[j].Spec.Nqn
@@ -34,7 +50,9 @@ func sortNvmeSubsystems(subsystems []*pb.NvmeSubsystem) {
 
 // CreateNvmeSubsystem creates an Nvme Subsystem
 func (s *Server) CreateNvmeSubsystem(_ context.Context, in *pb.CreateNvmeSubsystemRequest) (*pb.NvmeSubsystem, error) {
-	log.Printf("CreateNvmeSubsystem: Received from client: %v", in)
+	log.Printf("CreateNvmeSubsystem: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -67,27 +85,12 @@ func (s *Server) CreateNvmeSubsystem(_ context.Context, in *pb.CreateNvmeSubsyst
 		}
 	}
 	// not found, so create a new one
-	params := spdk.NvmfCreateSubsystemParams{
-		Nqn:           in.NvmeSubsystem.Spec.Nqn,
-		SerialNumber:  in.NvmeSubsystem.Spec.SerialNumber,
-		ModelNumber:   in.NvmeSubsystem.Spec.ModelNumber,
-		AllowAnyHost:  true,
-		MaxNamespaces: int(in.NvmeSubsystem.Spec.MaxNamespaces),
-	}
-	var result spdk.NvmfCreateSubsystemResult
-	err := s.rpc.Call("nvmf_create_subsystem", &params, &result)
-	if err != nil {
-		log.Printf("error: %v", err)
+	allowAnyHost := !s.Nvme.hostAllowlist
+	if err := s.createNvmeSubsystem(in.NvmeSubsystem.Spec, allowAnyHost); err != nil {
 		return nil, err
 	}
-	log.Printf("Received from SPDK: %v", result)
-	if !result {
-		msg := fmt.Sprintf("Could not create NQN: %s", in.NvmeSubsystem.Spec.Nqn)
-		log.Print(msg)
-		return nil, status.Errorf(codes.InvalidArgument, msg)
-	}
 	var ver spdk.GetVersionResult
-	err = s.rpc.Call("spdk_get_version", nil, &ver)
+	err := s.rpc.Call("spdk_get_version", nil, &ver)
 	if err != nil {
 		log.Printf("error: %v", err)
 		return nil, err
@@ -96,12 +99,15 @@ func (s *Server) CreateNvmeSubsystem(_ context.Context, in *pb.CreateNvmeSubsyst
 	response := server.ProtoClone(in.NvmeSubsystem)
 	response.Status = &pb.NvmeSubsystemStatus{FirmwareRevision: ver.Version}
 	s.Nvme.Subsystems[in.NvmeSubsystem.Name] = response
+	s.Nvme.allowAnyHost[in.NvmeSubsystem.Name] = allowAnyHost
 	return response, nil
 }
 
 // DeleteNvmeSubsystem deletes an Nvme Subsystem
 func (s *Server) DeleteNvmeSubsystem(_ context.Context, in *pb.DeleteNvmeSubsystemRequest) (*emptypb.Empty, error) {
-	log.Printf("DeleteNvmeSubsystem: Received from client: %v", in)
+	log.Printf("DeleteNvmeSubsystem: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -122,28 +128,26 @@ func (s *Server) DeleteNvmeSubsystem(_ context.Context, in *pb.DeleteNvmeSubsyst
 		log.Printf("error: %v", err)
 		return nil, err
 	}
-	params := spdk.NvmfDeleteSubsystemParams{
-		Nqn: subsys.Spec.Nqn,
-	}
-	var result spdk.NvmfDeleteSubsystemResult
-	err := s.rpc.Call("nvmf_delete_subsystem", &params, &result)
-	if err != nil {
-		log.Printf("error: %v", err)
+	if err := s.deleteNvmeSubsystem(subsys.Spec.Nqn); err != nil {
 		return nil, err
 	}
-	log.Printf("Received from SPDK: %v", result)
-	if !result {
-		msg := fmt.Sprintf("Could not delete NQN: %s", subsys.Spec.Nqn)
-		log.Print(msg)
-		return nil, status.Errorf(codes.InvalidArgument, msg)
+	for _, keys := range s.Nvme.hostKeys[subsys.Name] {
+		s.removeNvmeHostKeys(keys)
 	}
+	delete(s.Nvme.hostKeys, subsys.Name)
+	delete(s.Nvme.allowAnyHost, subsys.Name)
+	delete(s.Nvme.anaStates, subsys.Name)
 	delete(s.Nvme.Subsystems, subsys.Name)
 	return &emptypb.Empty{}, nil
 }
 
-// UpdateNvmeSubsystem updates an Nvme Subsystem
+// UpdateNvmeSubsystem updates an Nvme Subsystem. The NQN cannot be changed.
+// SPDK cannot change other fields of the spec of a live subsystem, so they
+// are applied by recreating it, which is rejected while hosts are connected
 func (s *Server) UpdateNvmeSubsystem(_ context.Context, in *pb.UpdateNvmeSubsystemRequest) (*pb.NvmeSubsystem, error) {
-	log.Printf("UpdateNvmeSubsystem: Received from client: %v", in)
+	log.Printf("UpdateNvmeSubsystem: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -155,7 +159,7 @@ func (s *Server) UpdateNvmeSubsystem(_ context.Context, in *pb.UpdateNvmeSubsyst
 		return nil, err
 	}
 	// fetch object from the database
-	volume, ok := s.Nvme.Subsystems[in.NvmeSubsystem.Name]
+	subsys, ok := s.Nvme.Subsystems[in.NvmeSubsystem.Name]
 	if !ok {
 		if in.AllowMissing {
 			log.Printf("TODO: in case of AllowMissing, create a new resource, don;t return error")
@@ -164,19 +168,67 @@ func (s *Server) UpdateNvmeSubsystem(_ context.Context, in *pb.UpdateNvmeSubsyst
 		log.Printf("error: %v", err)
 		return nil, err
 	}
-	resourceID := path.Base(volume.Name)
 	// update_mask = 2
 	if err := fieldmask.Validate(in.UpdateMask, in.NvmeSubsystem); err != nil {
 		log.Printf("error: %v", err)
 		return nil, err
 	}
-	log.Printf("TODO: use resourceID=%v", resourceID)
-	return nil, status.Errorf(codes.Unimplemented, "UpdateNvmeSubsystem method is not implemented")
+	updated := server.ProtoClone(subsys)
+	fieldmask.Update(in.UpdateMask, updated, in.NvmeSubsystem)
+	settings := s.nvmeSubsystemSettings(subsys)
+	settings.Spec = updated.Spec
+	if err := s.updateNvmeSubsystem(subsys, settings); err != nil {
+		return nil, err
+	}
+	response := server.ProtoClone(s.Nvme.Subsystems[subsys.Name])
+	log.Printf("UpdateNvmeSubsystem: Sending to client: %v", server.Redact(response))
+	return response, nil
+}
+
+// UpdateNvmeSubsystemSettings updates an Nvme Subsystem together with its
+// host access and the ANA state of its listeners, which the OPI model has
+// no fields for. Spec fields are applied as by UpdateNvmeSubsystem
+func (s *Server) UpdateNvmeSubsystemSettings(_ context.Context, in *bridgepb.UpdateNvmeSubsystemSettingsRequest) (*bridgepb.NvmeSubsystemSettings, error) {
+	log.Printf("UpdateNvmeSubsystemSettings: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Settings.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// fetch object from the database
+	subsys, ok := s.Nvme.Subsystems[in.Settings.Name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Settings.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if err := fieldmask.Validate(in.UpdateMask, in.Settings); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	settings := s.nvmeSubsystemSettings(subsys)
+	fieldmask.Update(in.UpdateMask, settings, in.Settings)
+	settings.Name = subsys.Name
+	if err := s.updateNvmeSubsystem(subsys, settings); err != nil {
+		return nil, err
+	}
+	response := s.nvmeSubsystemSettings(s.Nvme.Subsystems[subsys.Name])
+	log.Printf("UpdateNvmeSubsystemSettings: Sending to client: %v", server.Redact(response))
+	return response, nil
 }
 
 // ListNvmeSubsystems lists Nvme Subsystems
 func (s *Server) ListNvmeSubsystems(_ context.Context, in *pb.ListNvmeSubsystemsRequest) (*pb.ListNvmeSubsystemsResponse, error) {
-	log.Printf("ListNvmeSubsystems: Received from client: %v", in)
+	log.Printf("ListNvmeSubsystems: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -211,9 +263,13 @@ func (s *Server) ListNvmeSubsystems(_ context.Context, in *pb.ListNvmeSubsystems
 	return &pb.ListNvmeSubsystemsResponse{NvmeSubsystems: Blobarray, NextPageToken: token}, nil
 }
 
-// GetNvmeSubsystem gets Nvme Subsystems
+// GetNvmeSubsystem gets an Nvme Subsystem with its spec and firmware
+// revision as reported by SPDK. Hosts SPDK allows to connect replace the
+// allowlist of the subsystem, if they differ
 func (s *Server) GetNvmeSubsystem(_ context.Context, in *pb.GetNvmeSubsystemRequest) (*pb.NvmeSubsystem, error) {
-	log.Printf("GetNvmeSubsystem: Received from client: %v", in)
+	log.Printf("GetNvmeSubsystem: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -243,7 +299,25 @@ func (s *Server) GetNvmeSubsystem(_ context.Context, in *pb.GetNvmeSubsystemRequ
 	for i := range result {
 		r := &result[i]
 		if r.Nqn == subsys.Spec.Nqn {
-			return &pb.NvmeSubsystem{Spec: &pb.NvmeSubsystemSpec{Nqn: r.Nqn, SerialNumber: r.SerialNumber, ModelNumber: r.ModelNumber}, Status: &pb.NvmeSubsystemStatus{FirmwareRevision: "TBD"}}, nil
+			var ver spdk.GetVersionResult
+			err := s.rpc.Call("spdk_get_version", nil, &ver)
+			if err != nil {
+				log.Printf("error: %v", err)
+				return nil, err
+			}
+			log.Printf("Received from SPDK: %v", ver)
+			s.adoptNvmeSubsystemHosts(subsys.Name, r)
+			response := server.ProtoClone(subsys)
+			response.Spec.SerialNumber = r.SerialNumber
+			response.Spec.ModelNumber = r.ModelNumber
+			response.Spec.MaxNamespaces = int64(r.MaxNamespaces)
+			if response.Status == nil {
+				response.Status = &pb.NvmeSubsystemStatus{}
+			}
+			response.Status.FirmwareRevision = ver.Version
+			// updates are applied to the live spec
+			s.Nvme.Subsystems[subsys.Name] = server.ProtoClone(response)
+			return response, nil
 		}
 	}
 	msg := fmt.Sprintf("Could not find NQN: %s", subsys.Spec.Nqn)
@@ -253,7 +327,9 @@ func (s *Server) GetNvmeSubsystem(_ context.Context, in *pb.GetNvmeSubsystemRequ
 
 // StatsNvmeSubsystem gets Nvme Subsystem stats
 func (s *Server) StatsNvmeSubsystem(_ context.Context, in *pb.StatsNvmeSubsystemRequest) (*pb.StatsNvmeSubsystemResponse, error) {
-	log.Printf("StatsNvmeSubsystem: Received from client: %v", in)
+	log.Printf("StatsNvmeSubsystem: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -282,3 +358,272 @@ func (s *Server) StatsNvmeSubsystem(_ context.Context, in *pb.StatsNvmeSubsystem
 	log.Printf("Received from SPDK: %v", result)
 	return &pb.StatsNvmeSubsystemResponse{Stats: &pb.VolumeStats{ReadOpsCount: -1, WriteOpsCount: -1}}, nil
 }
+
+// nvmeSubsystemSettings returns the spec, host access and ANA state of
+// a subsystem
+func (s *Server) nvmeSubsystemSettings(subsys *pb.NvmeSubsystem) *bridgepb.NvmeSubsystemSettings {
+	return &bridgepb.NvmeSubsystemSettings{
+		Name:         subsys.Name,
+		Spec:         server.ProtoClone(subsys.Spec),
+		AllowAnyHost: s.allowsAnyHost(subsys.Name),
+		HostNqns:     s.allowedHosts(subsys.Name),
+		AnaState:     s.Nvme.anaStates[subsys.Name],
+	}
+}
+
+// updateNvmeSubsystem applies settings to a subsystem. A changed spec is
+// applied by recreating the subsystem, host access through the allow any
+// host flag and the allowlist of the subsystem, and the ANA state through
+// listeners of its controllers
+func (s *Server) updateNvmeSubsystem(subsys *pb.NvmeSubsystem, settings *bridgepb.NvmeSubsystemSettings) error {
+	if err := s.verifyNvmeSubsystemUpdate(subsys, settings); err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	if !proto.Equal(subsys.Spec, settings.Spec) {
+		if err := s.recreateNvmeSubsystem(subsys, settings.Spec); err != nil {
+			return err
+		}
+		updated := server.ProtoClone(subsys)
+		updated.Spec = server.ProtoClone(settings.Spec)
+		s.Nvme.Subsystems[subsys.Name] = updated
+		subsys = updated
+	}
+	if settings.AllowAnyHost != s.allowsAnyHost(subsys.Name) {
+		if err := s.setNvmeAllowAnyHost(subsys, settings.AllowAnyHost); err != nil {
+			return err
+		}
+	}
+	if err := s.updateNvmeSubsystemHosts(subsys, settings.HostNqns); err != nil {
+		return err
+	}
+	if nvmeAnaStates[settings.AnaState] != nvmeAnaStates[s.Nvme.anaStates[subsys.Name]] {
+		for _, controller := range s.subsystemControllers(subsys.Name) {
+			params := s.Nvme.subsysListener.Params(controller, subsys.Spec.Nqn)
+			if err := s.setNvmeListenerAnaState(&params, settings.AnaState); err != nil {
+				return err
+			}
+		}
+		if nvmeAnaStates[settings.AnaState] == nvmeAnaStates[bridgepb.NvmeAnaState_NVME_ANA_STATE_OPTIMIZED] {
+			delete(s.Nvme.anaStates, subsys.Name)
+		} else {
+			s.Nvme.anaStates[subsys.Name] = settings.AnaState
+		}
+	}
+	return nil
+}
+
+// updateNvmeSubsystemHosts makes hostNqns the allowlist of a subsystem.
+// Hosts are added without keys, hosts which are kept keep their keys
+func (s *Server) updateNvmeSubsystemHosts(subsys *pb.NvmeSubsystem, hostNqns []string) error {
+	allowed := make(map[string]bool, len(hostNqns))
+	for _, host := range hostNqns {
+		allowed[host] = true
+	}
+	for _, host := range s.allowedHosts(subsys.Name) {
+		if !allowed[host] {
+			if err := s.removeNvmeSubsystemHost(subsys.Name, host); err != nil {
+				return err
+			}
+		}
+		delete(allowed, host)
+	}
+	added := make([]string, 0, len(allowed))
+	for host := range allowed {
+		added = append(added, host)
+	}
+	sort.Strings(added)
+	for _, host := range added {
+		if err := s.verifyNvmeHostChannel(subsys.Name, host, false); err != nil {
+			return err
+		}
+		keys := &nvmeHostKeys{}
+		if err := s.addNvmeHost(subsys.Spec.Nqn, host, keys); err != nil {
+			return err
+		}
+		if _, ok := s.Nvme.hostKeys[subsys.Name]; !ok {
+			s.Nvme.hostKeys[subsys.Name] = make(map[string]*nvmeHostKeys)
+		}
+		s.Nvme.hostKeys[subsys.Name][host] = keys
+	}
+	return nil
+}
+
+// verifyNvmeSubsystemUpdate checks that settings can be applied to
+// a subsystem. SPDK has no RPC changing the NQN of a subsystem, which
+// identifies it for hosts, so it cannot be changed
+func (s *Server) verifyNvmeSubsystemUpdate(subsys *pb.NvmeSubsystem, settings *bridgepb.NvmeSubsystemSettings) error {
+	spec := settings.Spec
+	if spec == nil {
+		return status.Error(codes.InvalidArgument, "missing required field: spec")
+	}
+	if spec.Nqn != subsys.Spec.Nqn {
+		return status.Error(codes.InvalidArgument, "nqn of NvmeSubsystem cannot be changed")
+	}
+	if len(spec.SerialNumber) > maxSerialNumberLength {
+		msg := fmt.Sprintf("serial_number of NvmeSubsystem cannot be longer than %d characters", maxSerialNumberLength)
+		return status.Error(codes.InvalidArgument, msg)
+	}
+	if len(spec.ModelNumber) > maxModelNumberLength {
+		msg := fmt.Sprintf("model_number of NvmeSubsystem cannot be longer than %d characters", maxModelNumberLength)
+		return status.Error(codes.InvalidArgument, msg)
+	}
+	if spec.MaxNamespaces < 0 {
+		return status.Error(codes.InvalidArgument, "max_namespaces of NvmeSubsystem cannot be negative")
+	}
+	if spec.MaxNamespaces > 0 {
+		for _, namespace := range s.subsystemNamespaces(subsys.Name) {
+			if namespace.Spec.HostNsid > int32(spec.MaxNamespaces) {
+				msg := fmt.Sprintf("max_namespaces %d of NvmeSubsystem is less than host_nsid %d of %s",
+					spec.MaxNamespaces, namespace.Spec.HostNsid, namespace.Name)
+				return status.Error(codes.InvalidArgument, msg)
+			}
+		}
+	}
+	for _, host := range settings.HostNqns {
+		if host == "" {
+			return status.Error(codes.InvalidArgument, "host_nqns of NvmeSubsystem cannot contain an empty NQN")
+		}
+	}
+	if _, ok := nvmeAnaStates[settings.AnaState]; !ok {
+		msg := fmt.Sprintf("ana_state %v of NvmeSubsystem is unknown", settings.AnaState)
+		return status.Error(codes.InvalidArgument, msg)
+	}
+	return nil
+}
+
+// recreateNvmeSubsystem applies spec to a subsystem by deleting it in SPDK
+// and creating it again with its namespaces, allowed hosts and the listeners
+// of its controllers. Hosts would lose their connections, so the subsystem
+// cannot be recreated while they are connected
+func (s *Server) recreateNvmeSubsystem(subsys *pb.NvmeSubsystem, spec *pb.NvmeSubsystemSpec) error {
+	params := nvmfSubsystemGetControllersParams{
+		Nqn: subsys.Spec.Nqn,
+	}
+	var result []nvmfSubsystemGetControllersResult
+	err := s.rpc.Call("nvmf_subsystem_get_controllers", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if len(result) > 0 {
+		msg := fmt.Sprintf("NvmeSubsystem %s cannot be changed while host %s is connected to it",
+			subsys.Name, result[0].Hostnqn)
+		log.Print(msg)
+		return status.Error(codes.FailedPrecondition, msg)
+	}
+	namespaces := s.subsystemNamespaces(subsys.Name)
+	bdevNames := make([]string, len(namespaces))
+	for i, namespace := range namespaces {
+		volume, err := s.registry.Get(namespace.Spec.VolumeNameRef)
+		if err != nil {
+			log.Printf("error: %v", err)
+			return err
+		}
+		bdevNames[i] = volume.BdevName
+	}
+
+	if err := s.deleteNvmeSubsystem(subsys.Spec.Nqn); err != nil {
+		return err
+	}
+	if err := s.createNvmeSubsystem(spec, s.allowsAnyHost(subsys.Name)); err != nil {
+		return err
+	}
+	for i, namespace := range namespaces {
+		if err := s.attachNvmeNamespace(namespace.Name, namespace, spec.Nqn, bdevNames[i]); err != nil {
+			return err
+		}
+	}
+	for _, host := range s.allowedHosts(subsys.Name) {
+		if err := s.addNvmeHost(spec.Nqn, host, s.Nvme.hostKeys[subsys.Name][host]); err != nil {
+			return err
+		}
+	}
+	for _, controller := range s.subsystemControllers(subsys.Name) {
+		params := s.Nvme.subsysListener.Params(controller, spec.Nqn)
+		params.SecureChannel = s.Nvme.secureChannel[controller.Name]
+		if err := s.addNvmeListener(controller.Name, &params); err != nil {
+			return err
+		}
+		if state, ok := s.Nvme.anaStates[subsys.Name]; ok {
+			if err := s.setNvmeListenerAnaState(&params, state); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// createNvmeSubsystem creates a subsystem in SPDK. ANA reporting is enabled,
+// so that the ANA state of its listeners can be changed later
+func (s *Server) createNvmeSubsystem(spec *pb.NvmeSubsystemSpec, allowAnyHost bool) error {
+	params := nvmfCreateSubsystemParams{
+		NvmfCreateSubsystemParams: spdk.NvmfCreateSubsystemParams{
+			Nqn:           spec.Nqn,
+			SerialNumber:  spec.SerialNumber,
+			ModelNumber:   spec.ModelNumber,
+			AllowAnyHost:  allowAnyHost,
+			MaxNamespaces: int(spec.MaxNamespaces),
+		},
+		AnaReporting: true,
+	}
+	var result spdk.NvmfCreateSubsystemResult
+	err := s.rpc.Call("nvmf_create_subsystem", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not create NQN: %s", spec.Nqn)
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+	return nil
+}
+
+func (s *Server) deleteNvmeSubsystem(nqn string) error {
+	params := spdk.NvmfDeleteSubsystemParams{
+		Nqn: nqn,
+	}
+	var result spdk.NvmfDeleteSubsystemResult
+	err := s.rpc.Call("nvmf_delete_subsystem", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not delete NQN: %s", nqn)
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+	return nil
+}
+
+// subsystemNamespaces returns namespaces of a subsystem sorted by Nsid
+func (s *Server) subsystemNamespaces(subsystemName string) []*pb.NvmeNamespace {
+	namespaces := []*pb.NvmeNamespace{}
+	for _, namespace := range s.Nvme.Namespaces {
+		if namespace.Spec.SubsystemNameRef == subsystemName {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	sort.Slice(namespaces, func(i int, j int) bool {
+		return namespaces[i].Spec.HostNsid < namespaces[j].Spec.HostNsid
+	})
+	return namespaces
+}
+
+// subsystemControllers returns controllers of a subsystem sorted by name
+func (s *Server) subsystemControllers(subsystemName string) []*pb.NvmeController {
+	controllers := []*pb.NvmeController{}
+	for _, controller := range s.Nvme.Controllers {
+		if controller.Spec.SubsystemNameRef == subsystemName {
+			controllers = append(controllers, controller)
+		}
+	}
+	sortNvmeControllers(controllers)
+	return controllers
+}
@@ -18,6 +18,7 @@ import (
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
 	"github.com/opiproject/opi-spdk-bridge/pkg/server"
 )
 
@@ -305,11 +306,26 @@ func TestFrontEnd_DeleteNvmeSubsystem(t *testing.T) {
 
 func TestFrontEnd_UpdateNvmeSubsystem(t *testing.T) {
 	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	recreated := []string{
+		"nvmf_subsystem_get_controllers",
+		"nvmf_delete_subsystem",
+		"nvmf_create_subsystem",
+		"nvmf_subsystem_add_ns",
+		"nvmf_subsystem_add_listener",
+	}
+	recreate := []string{
+		`{"id":%d,"error":{"code":0,"message":""},"result":[]}`,
+		`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+		`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+		`{"id":%d,"error":{"code":0,"message":""},"result":22}`,
+		`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+	}
 	tests := map[string]struct {
 		mask    *fieldmaskpb.FieldMask
 		in      *pb.NvmeSubsystem
 		out     *pb.NvmeSubsystem
 		spdk    []string
+		calls   []string
 		errCode codes.Code
 		errMsg  string
 		missing bool
@@ -322,20 +338,170 @@ func TestFrontEnd_UpdateNvmeSubsystem(t *testing.T) {
 			},
 			nil,
 			[]string{},
+			nil,
 			codes.Unknown,
 			fmt.Sprintf("invalid field path: %s", "'*' must not be used with other paths"),
 			false,
 		},
-		"unimplemented method": {
+		"valid request without changes": {
 			nil,
 			&pb.NvmeSubsystem{
 				Name: testSubsystemName,
 				Spec: testSubsystem.Spec,
 			},
+			&pb.NvmeSubsystem{
+				Name: testSubsystemName,
+				Spec: testSubsystem.Spec,
+			},
+			[]string{},
+			nil,
+			codes.OK,
+			"",
+			false,
+		},
+		"new serial number recreates subsystem": {
+			&fieldmaskpb.FieldMask{Paths: []string{"spec.serial_number"}},
+			&pb.NvmeSubsystem{
+				Name: testSubsystemName,
+				Spec: &pb.NvmeSubsystemSpec{
+					Nqn:          testSubsystem.Spec.Nqn,
+					SerialNumber: "OpiSerialNumber",
+				},
+			},
+			&pb.NvmeSubsystem{
+				Name: testSubsystemName,
+				Spec: &pb.NvmeSubsystemSpec{
+					Nqn:          testSubsystem.Spec.Nqn,
+					SerialNumber: "OpiSerialNumber",
+				},
+			},
+			recreate,
+			recreated,
+			codes.OK,
+			"",
+			false,
+		},
+		"new model number recreates subsystem": {
+			&fieldmaskpb.FieldMask{Paths: []string{"spec.model_number"}},
+			&pb.NvmeSubsystem{
+				Name: testSubsystemName,
+				Spec: &pb.NvmeSubsystemSpec{
+					Nqn:         testSubsystem.Spec.Nqn,
+					ModelNumber: "OpiModelNumber",
+				},
+			},
+			&pb.NvmeSubsystem{
+				Name: testSubsystemName,
+				Spec: &pb.NvmeSubsystemSpec{
+					Nqn:         testSubsystem.Spec.Nqn,
+					ModelNumber: "OpiModelNumber",
+				},
+			},
+			recreate,
+			recreated,
+			codes.OK,
+			"",
+			false,
+		},
+		"new max namespaces recreates subsystem": {
+			&fieldmaskpb.FieldMask{Paths: []string{"spec.max_namespaces"}},
+			&pb.NvmeSubsystem{
+				Name: testSubsystemName,
+				Spec: &pb.NvmeSubsystemSpec{
+					Nqn:           testSubsystem.Spec.Nqn,
+					MaxNamespaces: 32,
+				},
+			},
+			&pb.NvmeSubsystem{
+				Name: testSubsystemName,
+				Spec: &pb.NvmeSubsystemSpec{
+					Nqn:           testSubsystem.Spec.Nqn,
+					MaxNamespaces: 32,
+				},
+			},
+			recreate,
+			recreated,
+			codes.OK,
+			"",
+			false,
+		},
+		"connected host prevents recreating subsystem": {
+			&fieldmaskpb.FieldMask{Paths: []string{"spec.max_namespaces"}},
+			&pb.NvmeSubsystem{
+				Name: testSubsystemName,
+				Spec: &pb.NvmeSubsystemSpec{
+					Nqn:           testSubsystem.Spec.Nqn,
+					MaxNamespaces: 32,
+				},
+			},
+			nil,
+			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":[{"cntlid":1,"hostnqn":"nqn.2014-08.org.nvmexpress:uuid:1630a3a6-5bac-4563-a1a6-d2b0257c282a","hostid":"1630a3a6-5bac-4563-a1a6-d2b0257c282a","num_io_qpairs":4}]}`},
+			[]string{"nvmf_subsystem_get_controllers"},
+			codes.FailedPrecondition,
+			fmt.Sprintf("NvmeSubsystem %s cannot be changed while host %s is connected to it",
+				testSubsystemName, "nqn.2014-08.org.nvmexpress:uuid:1630a3a6-5bac-4563-a1a6-d2b0257c282a"),
+			false,
+		},
+		"max namespaces below namespace id": {
+			&fieldmaskpb.FieldMask{Paths: []string{"spec.max_namespaces"}},
+			&pb.NvmeSubsystem{
+				Name: testSubsystemName,
+				Spec: &pb.NvmeSubsystemSpec{
+					Nqn:           testSubsystem.Spec.Nqn,
+					MaxNamespaces: 16,
+				},
+			},
+			nil,
+			[]string{},
+			nil,
+			codes.InvalidArgument,
+			fmt.Sprintf("max_namespaces %d of NvmeSubsystem is less than host_nsid %d of %s",
+				16, testNamespace.Spec.HostNsid, testNamespaceName),
+			false,
+		},
+		"too long serial number": {
+			&fieldmaskpb.FieldMask{Paths: []string{"spec.serial_number"}},
+			&pb.NvmeSubsystem{
+				Name: testSubsystemName,
+				Spec: &pb.NvmeSubsystemSpec{
+					Nqn:          testSubsystem.Spec.Nqn,
+					SerialNumber: "OpiVeryLongSerialNumber",
+				},
+			},
+			nil,
+			[]string{},
+			nil,
+			codes.InvalidArgument,
+			fmt.Sprintf("serial_number of NvmeSubsystem cannot be longer than %d characters", 20),
+			false,
+		},
+		"nqn cannot be changed": {
+			nil,
+			&pb.NvmeSubsystem{
+				Name: testSubsystemName,
+				Spec: &pb.NvmeSubsystemSpec{Nqn: "nqn.2022-09.io.spdk:opi4"},
+			},
 			nil,
 			[]string{},
-			codes.Unimplemented,
-			fmt.Sprintf("%v method is not implemented", "UpdateNvmeSubsystem"),
+			nil,
+			codes.InvalidArgument,
+			"nqn of NvmeSubsystem cannot be changed",
+			false,
+		},
+		"valid request with error code from SPDK response": {
+			&fieldmaskpb.FieldMask{Paths: []string{"spec.serial_number"}},
+			&pb.NvmeSubsystem{
+				Name: testSubsystemName,
+				Spec: &pb.NvmeSubsystemSpec{
+					Nqn:          testSubsystem.Spec.Nqn,
+					SerialNumber: "OpiSerialNumber",
+				},
+			},
+			nil,
+			[]string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":false}`},
+			[]string{"nvmf_subsystem_get_controllers"},
+			codes.Unknown,
+			fmt.Sprintf("nvmf_subsystem_get_controllers: %v", "json response error: myopierr"),
 			false,
 		},
 		"valid request with unknown key": {
@@ -348,6 +514,7 @@ func TestFrontEnd_UpdateNvmeSubsystem(t *testing.T) {
 			},
 			nil,
 			[]string{},
+			nil,
 			codes.NotFound,
 			fmt.Sprintf("unable to find key %v", server.ResourceIDToVolumeName("unknown-id")),
 			false,
@@ -362,6 +529,7 @@ func TestFrontEnd_UpdateNvmeSubsystem(t *testing.T) {
 			},
 			nil,
 			[]string{},
+			nil,
 			codes.NotFound,
 			fmt.Sprintf("unable to find key %v", server.ResourceIDToVolumeName("unknown-id")),
 			true,
@@ -371,6 +539,7 @@ func TestFrontEnd_UpdateNvmeSubsystem(t *testing.T) {
 			&pb.NvmeSubsystem{Name: "-ABC-DEF", Spec: testSubsystem.Spec},
 			nil,
 			[]string{},
+			nil,
 			codes.Unknown,
 			fmt.Sprintf("segment '%s': not a valid DNS name", "-ABC-DEF"),
 			false,
@@ -384,6 +553,12 @@ func TestFrontEnd_UpdateNvmeSubsystem(t *testing.T) {
 			defer testEnv.Close()
 
 			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName] = server.ProtoClone(&testSubsystem)
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName].Name = testSubsystemName
+			testEnv.opiSpdkServer.Nvme.Namespaces[testNamespaceName] = server.ProtoClone(&testNamespace)
+			testEnv.opiSpdkServer.Nvme.Namespaces[testNamespaceName].Name = testNamespaceName
+			testEnv.opiSpdkServer.Nvme.Namespaces[testNamespaceName].Spec.VolumeNameRef = server.ResourceIDToVolumeName("Malloc1")
+			testEnv.opiSpdkServer.Nvme.Controllers[testControllerName] = server.ProtoClone(&testController)
+			testEnv.opiSpdkServer.Nvme.Controllers[testControllerName].Name = testControllerName
 
 			request := &pb.UpdateNvmeSubsystemRequest{NvmeSubsystem: tt.in, UpdateMask: tt.mask, AllowMissing: tt.missing}
 			response, err := testEnv.client.UpdateNvmeSubsystem(testEnv.ctx, request)
@@ -391,6 +566,199 @@ func TestFrontEnd_UpdateNvmeSubsystem(t *testing.T) {
 			if !proto.Equal(response, tt.out) {
 				t.Error("response: expected", tt.out, "received", response)
 			}
+			if !reflect.DeepEqual(testEnv.spdkCalls.methods, tt.calls) {
+				t.Error("SPDK calls: expected", tt.calls, "received", testEnv.spdkCalls.methods)
+			}
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+		})
+	}
+}
+
+func TestFrontEnd_UpdateNvmeSubsystemSettings(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	hostNqn := "nqn.2014-08.org.nvmexpress:uuid:1630a3a6-5bac-4563-a1a6-d2b0257c282a"
+	otherHostNqn := "nqn.2014-08.org.nvmexpress:uuid:feb98abe-d51f-40c8-b348-2753f3571d3c"
+	tests := map[string]struct {
+		mask    *fieldmaskpb.FieldMask
+		in      *bridgepb.NvmeSubsystemSettings
+		out     *bridgepb.NvmeSubsystemSettings
+		spdk    []string
+		calls   []string
+		errCode codes.Code
+		errMsg  string
+	}{
+		"allow any host": {
+			&fieldmaskpb.FieldMask{Paths: []string{"allow_any_host"}},
+			&bridgepb.NvmeSubsystemSettings{Name: testSubsystemName, AllowAnyHost: false},
+			&bridgepb.NvmeSubsystemSettings{
+				Name:     testSubsystemName,
+				Spec:     testSubsystem.Spec,
+				HostNqns: []string{hostNqn},
+			},
+			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			[]string{"nvmf_subsystem_allow_any_host"},
+			codes.OK,
+			"",
+		},
+		"host nqns": {
+			&fieldmaskpb.FieldMask{Paths: []string{"host_nqns"}},
+			&bridgepb.NvmeSubsystemSettings{Name: testSubsystemName, HostNqns: []string{otherHostNqn}},
+			&bridgepb.NvmeSubsystemSettings{
+				Name:         testSubsystemName,
+				Spec:         testSubsystem.Spec,
+				AllowAnyHost: true,
+				HostNqns:     []string{otherHostNqn},
+			},
+			[]string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			[]string{"nvmf_subsystem_remove_host", "nvmf_subsystem_add_host"},
+			codes.OK,
+			"",
+		},
+		"ana state": {
+			&fieldmaskpb.FieldMask{Paths: []string{"ana_state"}},
+			&bridgepb.NvmeSubsystemSettings{Name: testSubsystemName, AnaState: bridgepb.NvmeAnaState_NVME_ANA_STATE_NON_OPTIMIZED},
+			&bridgepb.NvmeSubsystemSettings{
+				Name:         testSubsystemName,
+				Spec:         testSubsystem.Spec,
+				AllowAnyHost: true,
+				HostNqns:     []string{hostNqn},
+				AnaState:     bridgepb.NvmeAnaState_NVME_ANA_STATE_NON_OPTIMIZED,
+			},
+			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			[]string{"nvmf_subsystem_listener_set_ana_state"},
+			codes.OK,
+			"",
+		},
+		"optimized ana state is the default": {
+			&fieldmaskpb.FieldMask{Paths: []string{"ana_state"}},
+			&bridgepb.NvmeSubsystemSettings{Name: testSubsystemName, AnaState: bridgepb.NvmeAnaState_NVME_ANA_STATE_OPTIMIZED},
+			&bridgepb.NvmeSubsystemSettings{
+				Name:         testSubsystemName,
+				Spec:         testSubsystem.Spec,
+				AllowAnyHost: true,
+				HostNqns:     []string{hostNqn},
+			},
+			[]string{},
+			nil,
+			codes.OK,
+			"",
+		},
+		"serial number": {
+			&fieldmaskpb.FieldMask{Paths: []string{"spec.serial_number"}},
+			&bridgepb.NvmeSubsystemSettings{
+				Name: testSubsystemName,
+				Spec: &pb.NvmeSubsystemSpec{
+					Nqn:          testSubsystem.Spec.Nqn,
+					SerialNumber: "OpiSerialNumber",
+				},
+			},
+			&bridgepb.NvmeSubsystemSettings{
+				Name: testSubsystemName,
+				Spec: &pb.NvmeSubsystemSpec{
+					Nqn:          testSubsystem.Spec.Nqn,
+					SerialNumber: "OpiSerialNumber",
+				},
+				AllowAnyHost: true,
+				HostNqns:     []string{hostNqn},
+			},
+			[]string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":[]}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			[]string{
+				"nvmf_subsystem_get_controllers",
+				"nvmf_delete_subsystem",
+				"nvmf_create_subsystem",
+				"nvmf_subsystem_add_host",
+				"nvmf_subsystem_add_listener",
+			},
+			codes.OK,
+			"",
+		},
+		"unknown ana state": {
+			&fieldmaskpb.FieldMask{Paths: []string{"ana_state"}},
+			&bridgepb.NvmeSubsystemSettings{Name: testSubsystemName, AnaState: 7},
+			nil,
+			[]string{},
+			nil,
+			codes.InvalidArgument,
+			"ana_state 7 of NvmeSubsystem is unknown",
+		},
+		"empty host nqn": {
+			&fieldmaskpb.FieldMask{Paths: []string{"host_nqns"}},
+			&bridgepb.NvmeSubsystemSettings{Name: testSubsystemName, HostNqns: []string{""}},
+			nil,
+			[]string{},
+			nil,
+			codes.InvalidArgument,
+			"host_nqns of NvmeSubsystem cannot contain an empty NQN",
+		},
+		"valid request with invalid SPDK response": {
+			&fieldmaskpb.FieldMask{Paths: []string{"allow_any_host"}},
+			&bridgepb.NvmeSubsystemSettings{Name: testSubsystemName, AllowAnyHost: false},
+			nil,
+			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			[]string{"nvmf_subsystem_allow_any_host"},
+			codes.InvalidArgument,
+			fmt.Sprintf("Could not set allow any host to %v for NQN: %s", false, testSubsystem.Spec.Nqn),
+		},
+		"valid request with unknown key": {
+			nil,
+			&bridgepb.NvmeSubsystemSettings{Name: server.ResourceIDToVolumeName("unknown-id")},
+			nil,
+			[]string{},
+			nil,
+			codes.NotFound,
+			fmt.Sprintf("unable to find key %v", server.ResourceIDToVolumeName("unknown-id")),
+		},
+		"malformed name": {
+			nil,
+			&bridgepb.NvmeSubsystemSettings{Name: "-ABC-DEF"},
+			nil,
+			[]string{},
+			nil,
+			codes.Unknown,
+			fmt.Sprintf("segment '%s': not a valid DNS name", "-ABC-DEF"),
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName] = server.ProtoClone(&testSubsystem)
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName].Name = testSubsystemName
+			testEnv.opiSpdkServer.Nvme.Controllers[testControllerName] = server.ProtoClone(&testController)
+			testEnv.opiSpdkServer.Nvme.Controllers[testControllerName].Name = testControllerName
+			testEnv.opiSpdkServer.Nvme.hostKeys[testSubsystemName] = map[string]*nvmeHostKeys{hostNqn: {}}
+
+			request := &bridgepb.UpdateNvmeSubsystemSettingsRequest{Settings: tt.in, UpdateMask: tt.mask}
+			response, err := testEnv.client.UpdateNvmeSubsystemSettings(testEnv.ctx, request)
+
+			if !proto.Equal(response, tt.out) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+			if !reflect.DeepEqual(testEnv.spdkCalls.methods, tt.calls) {
+				t.Error("SPDK calls: expected", tt.calls, "received", testEnv.spdkCalls.methods)
+			}
 
 			if er, ok := status.FromError(err); ok {
 				if er.Code() != tt.errCode {
@@ -593,6 +961,7 @@ func TestFrontEnd_GetNvmeSubsystem(t *testing.T) {
 		in      string
 		out     *pb.NvmeSubsystem
 		spdk    []string
+		hosts   []string
 		errCode codes.Code
 		errMsg  string
 	}{
@@ -600,6 +969,7 @@ func TestFrontEnd_GetNvmeSubsystem(t *testing.T) {
 			testSubsystemName,
 			nil,
 			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":[]}`},
+			nil,
 			codes.InvalidArgument,
 			fmt.Sprintf("Could not find NQN: %v", "nqn.2022-09.io.spdk:opi3"),
 		},
@@ -607,6 +977,7 @@ func TestFrontEnd_GetNvmeSubsystem(t *testing.T) {
 			testSubsystemName,
 			nil,
 			[]string{""},
+			nil,
 			codes.Unknown,
 			fmt.Sprintf("nvmf_get_subsystems: %v", "EOF"),
 		},
@@ -614,6 +985,7 @@ func TestFrontEnd_GetNvmeSubsystem(t *testing.T) {
 			testSubsystemName,
 			nil,
 			[]string{`{"id":0,"error":{"code":0,"message":""},"result":[]}`},
+			nil,
 			codes.Unknown,
 			fmt.Sprintf("nvmf_get_subsystems: %v", "json response ID mismatch"),
 		},
@@ -621,23 +993,45 @@ func TestFrontEnd_GetNvmeSubsystem(t *testing.T) {
 			testSubsystemName,
 			nil,
 			[]string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":[]}`},
+			nil,
 			codes.Unknown,
 			fmt.Sprintf("nvmf_get_subsystems: %v", "json response error: myopierr"),
 		},
 		"valid request with valid SPDK response": {
 			testSubsystemName,
 			&pb.NvmeSubsystem{
+				Name: testSubsystemName,
+				Spec: &pb.NvmeSubsystemSpec{
+					Nqn:           "nqn.2022-09.io.spdk:opi3",
+					SerialNumber:  "OpiSerialNumber3",
+					ModelNumber:   "OpiModelNumber3",
+					MaxNamespaces: 32,
+				},
+				Status: &pb.NvmeSubsystemStatus{
+					FirmwareRevision: "SPDK v23.05",
+				},
+			},
+			// {'jsonrpc': '2.0', 'id': 1, 'result': [{'nqn': 'nqn.2020-12.mlnx.snap', 'serial_number': 'Mellanox_Nvme_SNAP', 'model_number': 'Mellanox Nvme SNAP Controller', 'controllers': [{'name': 'NvmeEmu0pf1', 'cntlid': 0, 'pci_bdf': 'ca:00.3', 'pci_index': 1}]}]}
+			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":[{"nqn": "nqn.2022-09.io.spdk:opi1", "serial_number": "OpiSerialNumber1", "model_number": "OpiModelNumber1"},{"nqn": "nqn.2022-09.io.spdk:opi2", "serial_number": "OpiSerialNumber2", "model_number": "OpiModelNumber2"},{"nqn": "nqn.2022-09.io.spdk:opi3", "serial_number": "OpiSerialNumber3", "model_number": "OpiModelNumber3", "max_namespaces": 32, "allow_any_host": true, "hosts": []}]}`, `{"jsonrpc":"2.0","id":%d,"result":{"version":"SPDK v23.05","fields":{"major":23,"minor":5,"patch":0,"suffix":""}}}`},
+			[]string{},
+			codes.OK,
+			"",
+		},
+		"valid request adopting host not in allowlist": {
+			testSubsystemName,
+			&pb.NvmeSubsystem{
+				Name: testSubsystemName,
 				Spec: &pb.NvmeSubsystemSpec{
 					Nqn:          "nqn.2022-09.io.spdk:opi3",
 					SerialNumber: "OpiSerialNumber3",
 					ModelNumber:  "OpiModelNumber3",
 				},
 				Status: &pb.NvmeSubsystemStatus{
-					FirmwareRevision: "TBD",
+					FirmwareRevision: "SPDK v23.05",
 				},
 			},
-			// {'jsonrpc': '2.0', 'id': 1, 'result': [{'nqn': 'nqn.2020-12.mlnx.snap', 'serial_number': 'Mellanox_Nvme_SNAP', 'model_number': 'Mellanox Nvme SNAP Controller', 'controllers': [{'name': 'NvmeEmu0pf1', 'cntlid': 0, 'pci_bdf': 'ca:00.3', 'pci_index': 1}]}]}
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":[{"nqn": "nqn.2022-09.io.spdk:opi1", "serial_number": "OpiSerialNumber1", "model_number": "OpiModelNumber1"},{"nqn": "nqn.2022-09.io.spdk:opi2", "serial_number": "OpiSerialNumber2", "model_number": "OpiModelNumber2"},{"nqn": "nqn.2022-09.io.spdk:opi3", "serial_number": "OpiSerialNumber3", "model_number": "OpiModelNumber3"}]}`},
+			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":[{"nqn": "nqn.2022-09.io.spdk:opi3", "serial_number": "OpiSerialNumber3", "model_number": "OpiModelNumber3", "allow_any_host": true, "hosts": [{"nqn": "nqn.2014-08.org.nvmexpress:uuid:unknown"}]}]}`, `{"jsonrpc":"2.0","id":%d,"result":{"version":"SPDK v23.05","fields":{"major":23,"minor":5,"patch":0,"suffix":""}}}`},
+			[]string{"nqn.2014-08.org.nvmexpress:uuid:unknown"},
 			codes.OK,
 			"",
 		},
@@ -645,6 +1039,7 @@ func TestFrontEnd_GetNvmeSubsystem(t *testing.T) {
 			"unknown-subsystem-id",
 			nil,
 			[]string{},
+			nil,
 			codes.NotFound,
 			fmt.Sprintf("unable to find key %v", "unknown-subsystem-id"),
 		},
@@ -652,6 +1047,7 @@ func TestFrontEnd_GetNvmeSubsystem(t *testing.T) {
 			"-ABC-DEF",
 			nil,
 			[]string{},
+			nil,
 			codes.Unknown,
 			fmt.Sprintf("segment '%s': not a valid DNS name", "-ABC-DEF"),
 		},
@@ -659,6 +1055,7 @@ func TestFrontEnd_GetNvmeSubsystem(t *testing.T) {
 			"",
 			nil,
 			[]string{},
+			nil,
 			codes.Unknown,
 			"missing required field: name",
 		},
@@ -670,6 +1067,8 @@ func TestFrontEnd_GetNvmeSubsystem(t *testing.T) {
 			testEnv := createTestEnvironment(tt.spdk)
 			defer testEnv.Close()
 			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName] = server.ProtoClone(&testSubsystem)
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName].Name = testSubsystemName
+			testEnv.opiSpdkServer.Nvme.Subsystems[testSubsystemName].Status = &pb.NvmeSubsystemStatus{FirmwareRevision: "SPDK v20.10"}
 
 			request := &pb.GetNvmeSubsystemRequest{Name: tt.in}
 			response, err := testEnv.client.GetNvmeSubsystem(testEnv.ctx, request)
@@ -677,6 +1076,12 @@ func TestFrontEnd_GetNvmeSubsystem(t *testing.T) {
 			if !proto.Equal(response, tt.out) {
 				t.Error("response: expected", tt.out, "received", response)
 			}
+			if tt.errCode == codes.OK {
+				hosts := testEnv.opiSpdkServer.allowedHosts(testSubsystemName)
+				if !reflect.DeepEqual(hosts, tt.hosts) {
+					t.Error("hosts: expected", tt.hosts, "received", hosts)
+				}
+			}
 
 			if er, ok := status.FromError(err); ok {
 				if er.Code() != tt.errCode {
@@ -34,7 +34,9 @@ func sortScsiControllers(controllers []*pb.VirtioScsiController) {
 
 // CreateVirtioScsiController creates a Virtio SCSI controller
 func (s *Server) CreateVirtioScsiController(_ context.Context, in *pb.CreateVirtioScsiControllerRequest) (*pb.VirtioScsiController, error) {
-	log.Printf("CreateVirtioScsiController: Received from client: %v", in)
+	log.Printf("CreateVirtioScsiController: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -71,7 +73,7 @@ func (s *Server) CreateVirtioScsiController(_ context.Context, in *pb.CreateVirt
 	}
 	log.Printf("Received from SPDK: %v", result)
 	if !result {
-		log.Printf("Could not create: %v", in)
+		log.Printf("Could not create: %v", server.Redact(in))
 	}
 	response := server.ProtoClone(in.VirtioScsiController)
 	// response.Status = &pb.VirtioScsiControllerStatus{Active: true}
@@ -81,7 +83,9 @@ func (s *Server) CreateVirtioScsiController(_ context.Context, in *pb.CreateVirt
 
 // DeleteVirtioScsiController deletes a Virtio SCSI controller
 func (s *Server) DeleteVirtioScsiController(_ context.Context, in *pb.DeleteVirtioScsiControllerRequest) (*emptypb.Empty, error) {
-	log.Printf("DeleteVirtioScsiController: Received from client: %v", in)
+	log.Printf("DeleteVirtioScsiController: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -114,7 +118,7 @@ func (s *Server) DeleteVirtioScsiController(_ context.Context, in *pb.DeleteVirt
 	}
 	log.Printf("Received from SPDK: %v", result)
 	if !result {
-		log.Printf("Could not delete: %v", in)
+		log.Printf("Could not delete: %v", server.Redact(in))
 	}
 	delete(s.Virt.ScsiCtrls, controller.Name)
 	return &emptypb.Empty{}, nil
@@ -122,7 +126,9 @@ func (s *Server) DeleteVirtioScsiController(_ context.Context, in *pb.DeleteVirt
 
 // UpdateVirtioScsiController updates a Virtio SCSI controller
 func (s *Server) UpdateVirtioScsiController(_ context.Context, in *pb.UpdateVirtioScsiControllerRequest) (*pb.VirtioScsiController, error) {
-	log.Printf("Received from client: %v", in)
+	log.Printf("Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -155,7 +161,9 @@ func (s *Server) UpdateVirtioScsiController(_ context.Context, in *pb.UpdateVirt
 
 // ListVirtioScsiControllers lists Virtio SCSI controllers
 func (s *Server) ListVirtioScsiControllers(_ context.Context, in *pb.ListVirtioScsiControllersRequest) (*pb.ListVirtioScsiControllersResponse, error) {
-	log.Printf("ListVirtioScsiControllers: Received from client: %v", in)
+	log.Printf("ListVirtioScsiControllers: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -192,7 +200,9 @@ func (s *Server) ListVirtioScsiControllers(_ context.Context, in *pb.ListVirtioS
 
 // GetVirtioScsiController gets a Virtio SCSI controller
 func (s *Server) GetVirtioScsiController(_ context.Context, in *pb.GetVirtioScsiControllerRequest) (*pb.VirtioScsiController, error) {
-	log.Printf("GetVirtioScsiController: Received from client: %v", in)
+	log.Printf("GetVirtioScsiController: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -231,7 +241,9 @@ func (s *Server) GetVirtioScsiController(_ context.Context, in *pb.GetVirtioScsi
 
 // StatsVirtioScsiController gets a Virtio SCSI controller stats
 func (s *Server) StatsVirtioScsiController(_ context.Context, in *pb.StatsVirtioScsiControllerRequest) (*pb.StatsVirtioScsiControllerResponse, error) {
-	log.Printf("Received from client: %v", in)
+	log.Printf("Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -256,7 +268,9 @@ func (s *Server) StatsVirtioScsiController(_ context.Context, in *pb.StatsVirtio
 
 // CreateVirtioScsiLun creates a Virtio SCSI LUN
 func (s *Server) CreateVirtioScsiLun(_ context.Context, in *pb.CreateVirtioScsiLunRequest) (*pb.VirtioScsiLun, error) {
-	log.Printf("CreateVirtioScsiLun: Received from client: %v", in)
+	log.Printf("CreateVirtioScsiLun: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -287,6 +301,11 @@ func (s *Server) CreateVirtioScsiLun(_ context.Context, in *pb.CreateVirtioScsiL
 		return lun, nil
 	}
 	// not found, so create a new one
+	volume, err := s.registry.Get(in.VirtioScsiLun.VolumeNameRef)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
 	params := struct {
 		Name string `json:"ctrlr"`
 		Num  int    `json:"scsi_target_num"`
@@ -294,10 +313,10 @@ func (s *Server) CreateVirtioScsiLun(_ context.Context, in *pb.CreateVirtioScsiL
 	}{
 		Name: resourceID,
 		Num:  5,
-		Bdev: in.VirtioScsiLun.VolumeNameRef,
+		Bdev: volume.BdevName,
 	}
 	var result int
-	err := s.rpc.Call("vhost_scsi_controller_add_target", &params, &result)
+	err = s.rpc.Call("vhost_scsi_controller_add_target", &params, &result)
 	if err != nil {
 		log.Printf("error: %v", err)
 		return nil, err
@@ -306,12 +325,15 @@ func (s *Server) CreateVirtioScsiLun(_ context.Context, in *pb.CreateVirtioScsiL
 	response := server.ProtoClone(in.VirtioScsiLun)
 	// response.Status = &pb.VirtioScsiLunStatus{Active: true}
 	s.Virt.ScsiLuns[in.VirtioScsiLun.Name] = response
+	s.acquireVolume(volume.Name, response.Name)
 	return response, nil
 }
 
 // DeleteVirtioScsiLun deletes a Virtio SCSI LUN
 func (s *Server) DeleteVirtioScsiLun(_ context.Context, in *pb.DeleteVirtioScsiLunRequest) (*emptypb.Empty, error) {
-	log.Printf("DeleteVirtioScsiLun: Received from client: %v", in)
+	log.Printf("DeleteVirtioScsiLun: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -348,15 +370,18 @@ func (s *Server) DeleteVirtioScsiLun(_ context.Context, in *pb.DeleteVirtioScsiL
 	}
 	log.Printf("Received from SPDK: %v", result)
 	if !result {
-		log.Printf("Could not delete: %v", in)
+		log.Printf("Could not delete: %v", server.Redact(in))
 	}
 	delete(s.Virt.ScsiLuns, lun.Name)
+	s.registry.Release(lun.VolumeNameRef, lun.Name)
 	return &emptypb.Empty{}, nil
 }
 
 // UpdateVirtioScsiLun updates a Virtio SCSI LUN
 func (s *Server) UpdateVirtioScsiLun(_ context.Context, in *pb.UpdateVirtioScsiLunRequest) (*pb.VirtioScsiLun, error) {
-	log.Printf("Received from client: %v", in)
+	log.Printf("Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -389,7 +414,9 @@ func (s *Server) UpdateVirtioScsiLun(_ context.Context, in *pb.UpdateVirtioScsiL
 
 // ListVirtioScsiLuns lists Virtio SCSI LUNs
 func (s *Server) ListVirtioScsiLuns(_ context.Context, in *pb.ListVirtioScsiLunsRequest) (*pb.ListVirtioScsiLunsResponse, error) {
-	log.Printf("ListVirtioScsiLuns: Received from client: %v", in)
+	log.Printf("ListVirtioScsiLuns: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -427,7 +454,9 @@ func (s *Server) ListVirtioScsiLuns(_ context.Context, in *pb.ListVirtioScsiLuns
 
 // GetVirtioScsiLun gets a Virtio SCSI LUN
 func (s *Server) GetVirtioScsiLun(_ context.Context, in *pb.GetVirtioScsiLunRequest) (*pb.VirtioScsiLun, error) {
-	log.Printf("GetVirtioScsiLun: Received from client: %v", in)
+	log.Printf("GetVirtioScsiLun: Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -464,9 +493,12 @@ func (s *Server) GetVirtioScsiLun(_ context.Context, in *pb.GetVirtioScsiLunRequ
 	return &pb.VirtioScsiLun{VolumeNameRef: server.ResourceIDToVolumeName(result[0].Ctrlr)}, nil
 }
 
-// StatsVirtioScsiLun gets a Virtio SCSI LUN stats
+// StatsVirtioScsiLun gets a Virtio SCSI LUN stats. Stats are not collected
+// yet, StatsVolume reports them for the volume of the LUN
 func (s *Server) StatsVirtioScsiLun(_ context.Context, in *pb.StatsVirtioScsiLunRequest) (*pb.StatsVirtioScsiLunResponse, error) {
-	log.Printf("Received from client: %v", in)
+	log.Printf("Received from client: %v", server.Redact(in))
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
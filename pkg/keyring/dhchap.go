@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package keyring manages secrets registered in SPDK keyring
+package keyring
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+)
+
+const dhchapSecretPrefix = "DHHC-1"
+
+// Dhchap contains NVMe DH-HMAC-CHAP in-band authentication settings
+type Dhchap struct {
+	// HostKey is a host secret in the "DHHC-1:xx:<base64>:" representation
+	HostKey []byte
+	// CtrlrKey is an optional controller secret used for bidirectional
+	// authentication in the same representation as HostKey
+	CtrlrKey []byte
+	// Digests is a list of allowed hash functions, e.g. "sha256"
+	Digests []string
+	// DhGroups is a list of allowed Diffie-Hellman groups, e.g. "ffdhe2048"
+	DhGroups []string
+}
+
+// NewDhchap converts DH-HMAC-CHAP settings received over the bridge API
+func NewDhchap(in *bridgepb.Dhchap) *Dhchap {
+	return &Dhchap{
+		HostKey:  in.GetHostKey(),
+		CtrlrKey: in.GetCtrlrKey(),
+		Digests:  in.GetDigests(),
+		DhGroups: in.GetDhGroups(),
+	}
+}
+
+// Validate checks that Dhchap settings can be passed to SPDK
+func (d *Dhchap) Validate() error {
+	if len(d.HostKey) == 0 {
+		return fmt.Errorf("dhchap host key is required")
+	}
+	if err := validateDhchapSecret(d.HostKey); err != nil {
+		return fmt.Errorf("invalid dhchap host key: %v", err)
+	}
+	if len(d.CtrlrKey) != 0 {
+		if err := validateDhchapSecret(d.CtrlrKey); err != nil {
+			return fmt.Errorf("invalid dhchap controller key: %v", err)
+		}
+	}
+	for _, digest := range d.Digests {
+		switch digest {
+		case "sha256", "sha384", "sha512":
+		default:
+			return fmt.Errorf("not supported dhchap digest: %v", digest)
+		}
+	}
+	for _, group := range d.DhGroups {
+		switch group {
+		case "null", "ffdhe2048", "ffdhe3072", "ffdhe4096", "ffdhe6144", "ffdhe8192":
+		default:
+			return fmt.Errorf("not supported dhchap dh group: %v", group)
+		}
+	}
+	return nil
+}
+
+func validateDhchapSecret(secret []byte) error {
+	parts := strings.Split(string(secret), ":")
+	if len(parts) != 4 || parts[0] != dhchapSecretPrefix || parts[3] != "" {
+		return fmt.Errorf("expected %v:xx:<base64>: format", dhchapSecretPrefix)
+	}
+	switch parts[1] {
+	case "00", "01", "02", "03":
+	default:
+		return fmt.Errorf("unknown secret transformation %v", parts[1])
+	}
+	decoded, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return fmt.Errorf("secret is not base64 encoded")
+	}
+	// 32, 48 or 64 bytes of secret followed by crc32
+	switch len(decoded) {
+	case 36, 52, 68:
+	default:
+		return fmt.Errorf("unexpected secret length %v", len(decoded))
+	}
+	return nil
+}
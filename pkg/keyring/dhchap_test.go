@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package keyring manages secrets registered in SPDK keyring
+package keyring
+
+import (
+	"testing"
+)
+
+var (
+	testDhchapHostKey  = []byte("DHHC-1:00:AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8gISIj:")
+	testDhchapCtrlrKey = []byte("DHHC-1:01:ZGVmZ2hpamtsbW5vcHFyc3R1dnd4eXp7fH1+f4CBgoOEhYaH:")
+)
+
+func TestDhchap_Validate(t *testing.T) {
+	tests := map[string]struct {
+		in     *Dhchap
+		errMsg string
+	}{
+		"host key only": {
+			in:     &Dhchap{HostKey: testDhchapHostKey},
+			errMsg: "",
+		},
+		"bidirectional with digests and groups": {
+			in: &Dhchap{
+				HostKey:  testDhchapHostKey,
+				CtrlrKey: testDhchapCtrlrKey,
+				Digests:  []string{"sha256", "sha512"},
+				DhGroups: []string{"null", "ffdhe2048"},
+			},
+			errMsg: "",
+		},
+		"missing host key": {
+			in:     &Dhchap{CtrlrKey: testDhchapCtrlrKey},
+			errMsg: "dhchap host key is required",
+		},
+		"host key without prefix": {
+			in:     &Dhchap{HostKey: []byte("AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8gISIj")},
+			errMsg: "invalid dhchap host key: expected DHHC-1:xx:<base64>: format",
+		},
+		"host key with unknown transformation": {
+			in:     &Dhchap{HostKey: []byte("DHHC-1:04:AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8gISIj:")},
+			errMsg: "invalid dhchap host key: unknown secret transformation 04",
+		},
+		"host key not base64": {
+			in:     &Dhchap{HostKey: []byte("DHHC-1:00:%%%:")},
+			errMsg: "invalid dhchap host key: secret is not base64 encoded",
+		},
+		"host key wrong length": {
+			in:     &Dhchap{HostKey: []byte("DHHC-1:00:AAECAwQF:")},
+			errMsg: "invalid dhchap host key: unexpected secret length 6",
+		},
+		"invalid controller key": {
+			in:     &Dhchap{HostKey: testDhchapHostKey, CtrlrKey: []byte("key")},
+			errMsg: "invalid dhchap controller key: expected DHHC-1:xx:<base64>: format",
+		},
+		"unsupported digest": {
+			in:     &Dhchap{HostKey: testDhchapHostKey, Digests: []string{"md5"}},
+			errMsg: "not supported dhchap digest: md5",
+		},
+		"unsupported dh group": {
+			in:     &Dhchap{HostKey: testDhchapHostKey, DhGroups: []string{"ffdhe1024"}},
+			errMsg: "not supported dhchap dh group: ffdhe1024",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tt.in.Validate()
+			errMsg := ""
+			if err != nil {
+				errMsg = err.Error()
+			}
+			if errMsg != tt.errMsg {
+				t.Error("error: expected", tt.errMsg, "received", errMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package keyring manages secrets registered in SPDK keyring
+package keyring
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sync"
+
+	"github.com/opiproject/gospdk/spdk"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// keyringFileAddKeyParams holds the parameters required to add a file based key
+type keyringFileAddKeyParams struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+// keyringFileAddKeyResult is the result of adding a file based key
+type keyringFileAddKeyResult bool
+
+// keyringFileRemoveKeyParams holds the parameters required to remove a file based key
+type keyringFileRemoveKeyParams struct {
+	Name string `json:"name"`
+}
+
+// keyringFileRemoveKeyResult is the result of removing a file based key
+type keyringFileRemoveKeyResult bool
+
+// Keyring stores secrets in files only readable by the owner and registers
+// them in SPDK keyring, so that the secrets themselves are never passed
+// over SPDK JSON RPC
+type Keyring struct {
+	rpc   spdk.JSONRPC
+	dir   string
+	mu    sync.Mutex
+	files map[string]string
+
+	createTempFile func(dir, pattern string) (*os.File, error)
+	writeKey       func(keyFile string, key []byte, perm os.FileMode) error
+	removeFile     func(name string) error
+}
+
+// NewKeyring creates a keyring which keeps key files in dir. Empty dir
+// stands for the default directory for temporary files
+func NewKeyring(jsonRPC spdk.JSONRPC, dir string) *Keyring {
+	return &Keyring{
+		rpc:            jsonRPC,
+		dir:            dir,
+		files:          make(map[string]string),
+		createTempFile: os.CreateTemp,
+		writeKey:       os.WriteFile,
+		removeFile:     os.Remove,
+	}
+}
+
+// Add registers a key with the given name in SPDK keyring
+func (k *Keyring) Add(name string, key []byte) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if _, ok := k.files[name]; ok {
+		return status.Errorf(codes.AlreadyExists, "key %v already exists", name)
+	}
+
+	keyFile, err := k.createTempFile(k.dir, "opikey")
+	if err != nil {
+		log.Printf("error: failed to create file for key: %v", err)
+		return status.Error(codes.Internal, "failed to handle key")
+	}
+	// file is only needed to get a unique name, the key is written below
+	_ = keyFile.Close()
+
+	const keyPermissions = 0600
+	if err := k.writeKey(keyFile.Name(), key, keyPermissions); err != nil {
+		log.Printf("error: failed to write to key file: %v", err)
+		removeErr := k.removeFile(keyFile.Name())
+		log.Printf("Delete key file after key write: %v", removeErr)
+		return status.Error(codes.Internal, "failed to handle key")
+	}
+
+	params := keyringFileAddKeyParams{
+		Name: name,
+		Path: keyFile.Name(),
+	}
+	var result keyringFileAddKeyResult
+	err = k.rpc.Call("keyring_file_add_key", &params, &result)
+	if err == nil && !result {
+		err = fmt.Errorf("could not add key %v", name)
+	}
+	if err != nil {
+		log.Printf("error: %v", err)
+		removeErr := k.removeFile(keyFile.Name())
+		log.Printf("Delete key file after failed key add: %v", removeErr)
+		return status.Errorf(codes.FailedPrecondition, "could not add key %v to keyring", name)
+	}
+	log.Printf("Received from SPDK: %v", result)
+
+	k.files[name] = keyFile.Name()
+	return nil
+}
+
+// Remove removes a key from SPDK keyring and deletes its file
+func (k *Keyring) Remove(name string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	keyFile, ok := k.files[name]
+	if !ok {
+		return status.Errorf(codes.NotFound, "unable to find key %v", name)
+	}
+
+	params := keyringFileRemoveKeyParams{
+		Name: name,
+	}
+	var result keyringFileRemoveKeyResult
+	err := k.rpc.Call("keyring_file_remove_key", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not remove key: %v", name)
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+
+	err = k.removeFile(keyFile)
+	log.Printf("Cleanup key file %v: %v", keyFile, err)
+	delete(k.files, name)
+	return nil
+}
+
+// Contains reports if a key with the given name is registered
+func (k *Keyring) Contains(name string) bool {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	_, ok := k.files[name]
+	return ok
+}
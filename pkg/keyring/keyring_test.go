@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package keyring manages secrets registered in SPDK keyring
+package keyring
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+)
+
+func TestKeyring_Add(t *testing.T) {
+	testKey := testDhchapHostKey
+	tests := map[string]struct {
+		spdk          []string
+		exist         bool
+		createFileErr error
+		writeKeyErr   error
+		errCode       codes.Code
+		errMsg        string
+	}{
+		"valid request": {
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"already exists": {
+			spdk:    []string{},
+			exist:   true,
+			errCode: codes.AlreadyExists,
+			errMsg:  "key mykey already exists",
+		},
+		"failed to create file": {
+			spdk:          []string{},
+			createFileErr: errors.New("stub error"),
+			errCode:       codes.Internal,
+			errMsg:        "failed to handle key",
+		},
+		"failed to write key": {
+			spdk:        []string{},
+			writeKeyErr: errors.New("stub error"),
+			errCode:     codes.Internal,
+			errMsg:      "failed to handle key",
+		},
+		"SPDK error": {
+			spdk:    []string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":false}`},
+			errCode: codes.FailedPrecondition,
+			errMsg:  "could not add key mykey to keyring",
+		},
+		"SPDK false result": {
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			errCode: codes.FailedPrecondition,
+			errMsg:  "could not add key mykey to keyring",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testSocket := server.GenerateSocketName("keyring")
+			ln, jsonRPC := server.CreateTestSpdkServer(testSocket, tt.spdk)
+			defer func() { _ = os.RemoveAll(testSocket) }()
+			defer server.CloseListener(ln)
+
+			k := NewKeyring(jsonRPC, t.TempDir())
+			if tt.exist {
+				k.files["mykey"] = "/some/file"
+			}
+			if tt.createFileErr != nil {
+				k.createTempFile = func(dir, pattern string) (*os.File, error) {
+					return nil, tt.createFileErr
+				}
+			}
+			writtenKey := []byte{}
+			writtenFile := ""
+			k.writeKey = func(keyFile string, key []byte, perm os.FileMode) error {
+				writtenKey = key
+				writtenFile = keyFile
+				if perm != 0600 {
+					t.Errorf("Expected key file permissions 0600, received: %o", perm)
+				}
+				if tt.writeKeyErr != nil {
+					return tt.writeKeyErr
+				}
+				return os.WriteFile(keyFile, key, perm)
+			}
+
+			err := k.Add("mykey", testKey)
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+
+			if tt.errCode == codes.OK {
+				if !bytes.Equal(writtenKey, testKey) {
+					t.Errorf("Expected key %v is written, received: %v", testKey, writtenKey)
+				}
+				if k.files["mykey"] != writtenFile {
+					t.Errorf("Expected key file %v is recorded, received: %v", writtenFile, k.files["mykey"])
+				}
+			} else if writtenFile != "" {
+				if _, err := os.Stat(writtenFile); !os.IsNotExist(err) {
+					t.Errorf("Expected key file %v is removed", writtenFile)
+				}
+			}
+		})
+	}
+}
+
+func TestKeyring_Remove(t *testing.T) {
+	tests := map[string]struct {
+		spdk    []string
+		exist   bool
+		errCode codes.Code
+		errMsg  string
+	}{
+		"valid request": {
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			exist:   true,
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"not found": {
+			spdk:    []string{},
+			exist:   false,
+			errCode: codes.NotFound,
+			errMsg:  "unable to find key mykey",
+		},
+		"SPDK false result": {
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			exist:   true,
+			errCode: codes.InvalidArgument,
+			errMsg:  "Could not remove key: mykey",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testSocket := server.GenerateSocketName("keyring")
+			ln, jsonRPC := server.CreateTestSpdkServer(testSocket, tt.spdk)
+			defer func() { _ = os.RemoveAll(testSocket) }()
+			defer server.CloseListener(ln)
+
+			k := NewKeyring(jsonRPC, t.TempDir())
+			removedFile := ""
+			k.removeFile = func(name string) error {
+				removedFile = name
+				return nil
+			}
+			if tt.exist {
+				k.files["mykey"] = "/some/file"
+			}
+
+			err := k.Remove("mykey")
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+
+			if tt.errCode == codes.OK {
+				if removedFile != "/some/file" {
+					t.Errorf("Expected key file is removed, received: %v", removedFile)
+				}
+				if k.Contains("mykey") {
+					t.Error("Expected key is not tracked after removal")
+				}
+			}
+		})
+	}
+}
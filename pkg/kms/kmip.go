@@ -0,0 +1,273 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package kms resolves references to data encryption keys held by key managers
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+)
+
+// KMIP 1.4 tags, item types and enumerations used to fetch symmetric keys
+const (
+	kmipTagBatchCount       = 0x42000D
+	kmipTagBatchItem        = 0x42000F
+	kmipTagKey              = 0x42003F
+	kmipTagKeyBlock         = 0x420040
+	kmipTagKeyFormatType    = 0x420042
+	kmipTagKeyMaterial      = 0x420043
+	kmipTagKeyValue         = 0x420045
+	kmipTagOperation        = 0x42005C
+	kmipTagProtocolVersion  = 0x420069
+	kmipTagProtocolMajor    = 0x42006A
+	kmipTagProtocolMinor    = 0x42006B
+	kmipTagRequestHeader    = 0x420077
+	kmipTagRequestMessage   = 0x420078
+	kmipTagRequestPayload   = 0x420079
+	kmipTagResponseHeader   = 0x42007A
+	kmipTagResponseMessage  = 0x42007B
+	kmipTagResponsePayload  = 0x42007C
+	kmipTagResultMessage    = 0x42007D
+	kmipTagResultReason     = 0x42007E
+	kmipTagResultStatus     = 0x42007F
+	kmipTagSymmetricKey     = 0x42008F
+	kmipTagUniqueIdentifier = 0x420094
+
+	kmipTypeStructure   = 0x01
+	kmipTypeInteger     = 0x02
+	kmipTypeEnumeration = 0x05
+	kmipTypeTextString  = 0x07
+	kmipTypeByteString  = 0x08
+
+	kmipOperationGet                  = 0x0A
+	kmipResultStatusSuccess           = 0x00
+	kmipResultReasonItemNotFound      = 0x01
+	kmipKeyFormatRaw                  = 0x01
+	kmipKeyFormatTransparentSymmetric = 0x07
+
+	kmipProtocolMajor  = 1
+	kmipProtocolMinor  = 4
+	kmipMaxMessageSize = 64 * 1024
+)
+
+// KmipKeyManager resolves key references to symmetric keys of a KMIP server,
+// using the reference as the unique identifier of the key
+type KmipKeyManager struct {
+	dial func(ctx context.Context) (net.Conn, error)
+}
+
+// NewKmipKeyManager creates a key manager connecting to a KMIP server at
+// endpoint over TLS with config, which should carry the client certificate
+func NewKmipKeyManager(endpoint string, config *tls.Config) *KmipKeyManager {
+	dialer := &tls.Dialer{Config: config}
+	return &KmipKeyManager{
+		dial: func(ctx context.Context) (net.Conn, error) {
+			return dialer.DialContext(ctx, "tcp", endpoint)
+		},
+	}
+}
+
+// Key fetches the symmetric key identified by ref with a KMIP Get operation
+func (m *KmipKeyManager) Key(ctx context.Context, ref string) ([]byte, error) {
+	if err := validateKeyRef(ref); err != nil {
+		return nil, err
+	}
+	conn, err := m.dial(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to KMIP server: %w: %v", ErrUnavailable, err)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrUnavailable, err)
+		}
+	}
+
+	if _, err := conn.Write(kmipGetRequest(ref)); err != nil {
+		return nil, fmt.Errorf("could not send KMIP request: %w: %v", ErrUnavailable, err)
+	}
+	response, err := readKmipItem(conn)
+	if err != nil {
+		return nil, fmt.Errorf("could not read KMIP response: %w", err)
+	}
+	defer Zero(response.value)
+	return parseKmipGetResponse(response, ref)
+}
+
+// kmipItem is a decoded KMIP TTLV (tag, type, length, value) item
+type kmipItem struct {
+	tag   uint32
+	typ   byte
+	value []byte
+}
+
+func kmipGetRequest(ref string) []byte {
+	return encodeKmipStructure(kmipTagRequestMessage,
+		encodeKmipStructure(kmipTagRequestHeader,
+			encodeKmipStructure(kmipTagProtocolVersion,
+				encodeKmipInteger(kmipTagProtocolMajor, kmipProtocolMajor),
+				encodeKmipInteger(kmipTagProtocolMinor, kmipProtocolMinor),
+			),
+			encodeKmipInteger(kmipTagBatchCount, 1),
+		),
+		encodeKmipStructure(kmipTagBatchItem,
+			encodeKmipEnumeration(kmipTagOperation, kmipOperationGet),
+			encodeKmipStructure(kmipTagRequestPayload,
+				encodeKmipText(kmipTagUniqueIdentifier, ref),
+				encodeKmipEnumeration(kmipTagKeyFormatType, kmipKeyFormatRaw),
+			),
+		),
+	)
+}
+
+func parseKmipGetResponse(response kmipItem, ref string) ([]byte, error) {
+	if response.tag != kmipTagResponseMessage {
+		return nil, fmt.Errorf("unexpected KMIP response tag %06X", response.tag)
+	}
+	batch, err := response.find(kmipTagBatchItem)
+	if err != nil {
+		return nil, err
+	}
+	status, err := batch.find(kmipTagResultStatus)
+	if err != nil {
+		return nil, err
+	}
+	if status.uint32() != kmipResultStatusSuccess {
+		if reason, err := batch.find(kmipTagResultReason); err == nil && reason.uint32() == kmipResultReasonItemNotFound {
+			return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, ref)
+		}
+		msg := ""
+		if m, err := batch.find(kmipTagResultMessage); err == nil {
+			msg = string(m.value)
+		}
+		return nil, fmt.Errorf("KMIP Get of %s failed with status %d: %s", ref, status.uint32(), msg)
+	}
+	block, err := batch.find(kmipTagResponsePayload, kmipTagSymmetricKey, kmipTagKeyBlock)
+	if err != nil {
+		return nil, err
+	}
+	format, err := block.find(kmipTagKeyFormatType)
+	if err != nil {
+		return nil, err
+	}
+	material, err := block.find(kmipTagKeyValue, kmipTagKeyMaterial)
+	if err != nil {
+		return nil, err
+	}
+	switch format.uint32() {
+	case kmipKeyFormatRaw:
+	case kmipKeyFormatTransparentSymmetric:
+		if material, err = material.find(kmipTagKey); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("not supported KMIP key format type %d", format.uint32())
+	}
+	if material.typ != kmipTypeByteString {
+		return nil, fmt.Errorf("unexpected KMIP key material type %d", material.typ)
+	}
+	return append([]byte(nil), material.value...), nil
+}
+
+// find returns the item reached by following tags through nested structures
+func (i kmipItem) find(tags ...uint32) (kmipItem, error) {
+	item := i
+	for _, tag := range tags {
+		if item.typ != kmipTypeStructure {
+			return kmipItem{}, fmt.Errorf("KMIP item %06X is not a structure", item.tag)
+		}
+		children, err := decodeKmipItems(item.value)
+		if err != nil {
+			return kmipItem{}, err
+		}
+		found := false
+		for _, child := range children {
+			if child.tag == tag {
+				item, found = child, true
+				break
+			}
+		}
+		if !found {
+			return kmipItem{}, fmt.Errorf("missing KMIP item %06X in %06X", tag, item.tag)
+		}
+	}
+	return item, nil
+}
+
+func (i kmipItem) uint32() uint32 {
+	if len(i.value) < 4 {
+		return 0
+	}
+	return binary.BigEndian.Uint32(i.value)
+}
+
+func readKmipItem(r io.Reader) (kmipItem, error) {
+	header := make([]byte, 8)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return kmipItem{}, fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+	length := binary.BigEndian.Uint32(header[4:])
+	if length > kmipMaxMessageSize {
+		return kmipItem{}, fmt.Errorf("KMIP message of %d bytes is too large", length)
+	}
+	value := make([]byte, kmipPadded(uint64(length)))
+	if _, err := io.ReadFull(r, value); err != nil {
+		return kmipItem{}, fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+	return kmipItem{tag: kmipTag(header), typ: header[3], value: value[:length]}, nil
+}
+
+func decodeKmipItems(b []byte) ([]kmipItem, error) {
+	var items []kmipItem
+	for len(b) > 0 {
+		if len(b) < 8 {
+			return nil, fmt.Errorf("truncated KMIP item header")
+		}
+		length := binary.BigEndian.Uint32(b[4:])
+		end := 8 + kmipPadded(uint64(length))
+		if uint64(len(b)) < end {
+			return nil, fmt.Errorf("truncated KMIP item %06X", kmipTag(b))
+		}
+		items = append(items, kmipItem{tag: kmipTag(b), typ: b[3], value: b[8 : 8+length]})
+		b = b[end:]
+	}
+	return items, nil
+}
+
+func kmipTag(header []byte) uint32 {
+	return uint32(header[0])<<16 | uint32(header[1])<<8 | uint32(header[2])
+}
+
+func kmipPadded(length uint64) uint64 {
+	return (length + 7) &^ 7
+}
+
+func encodeKmipItem(tag uint32, typ byte, value []byte) []byte {
+	buf := make([]byte, 8, 8+kmipPadded(uint64(len(value))))
+	buf[0], buf[1], buf[2], buf[3] = byte(tag>>16), byte(tag>>8), byte(tag), typ
+	binary.BigEndian.PutUint32(buf[4:], uint32(len(value)))
+	buf = append(buf, value...)
+	return append(buf, make([]byte, cap(buf)-len(buf))...)
+}
+
+func encodeKmipStructure(tag uint32, items ...[]byte) []byte {
+	return encodeKmipItem(tag, kmipTypeStructure, bytes.Join(items, nil))
+}
+
+func encodeKmipInteger(tag uint32, v uint32) []byte {
+	return encodeKmipItem(tag, kmipTypeInteger, binary.BigEndian.AppendUint32(nil, v))
+}
+
+func encodeKmipEnumeration(tag uint32, v uint32) []byte {
+	return encodeKmipItem(tag, kmipTypeEnumeration, binary.BigEndian.AppendUint32(nil, v))
+}
+
+func encodeKmipText(tag uint32, v string) []byte {
+	return encodeKmipItem(tag, kmipTypeTextString, []byte(v))
+}
@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package kms resolves references to data encryption keys held by key managers
+package kms
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"testing"
+)
+
+func encodeKmipBytes(tag uint32, v []byte) []byte {
+	return encodeKmipItem(tag, kmipTypeByteString, v)
+}
+
+func kmipResponse(items ...[]byte) []byte {
+	return encodeKmipStructure(kmipTagResponseMessage,
+		encodeKmipStructure(kmipTagResponseHeader,
+			encodeKmipStructure(kmipTagProtocolVersion,
+				encodeKmipInteger(kmipTagProtocolMajor, kmipProtocolMajor),
+				encodeKmipInteger(kmipTagProtocolMinor, kmipProtocolMinor),
+			),
+			encodeKmipInteger(kmipTagBatchCount, 1),
+		),
+		encodeKmipStructure(kmipTagBatchItem,
+			append([][]byte{encodeKmipEnumeration(kmipTagOperation, kmipOperationGet)}, items...)...,
+		),
+	)
+}
+
+func kmipKeyResponse(format uint32, material []byte) []byte {
+	return kmipResponse(
+		encodeKmipEnumeration(kmipTagResultStatus, kmipResultStatusSuccess),
+		encodeKmipStructure(kmipTagResponsePayload,
+			encodeKmipStructure(kmipTagSymmetricKey,
+				encodeKmipStructure(kmipTagKeyBlock,
+					encodeKmipEnumeration(kmipTagKeyFormatType, format),
+					encodeKmipStructure(kmipTagKeyValue, material),
+				),
+			),
+		),
+	)
+}
+
+func TestKmipKeyManager_Key(t *testing.T) {
+	tests := map[string]struct {
+		ref      string
+		response []byte
+		out      []byte
+		kind     error
+		errMsg   string
+	}{
+		"raw key": {
+			ref:      "1234",
+			response: kmipKeyResponse(kmipKeyFormatRaw, encodeKmipBytes(kmipTagKeyMaterial, testDek)),
+			out:      testDek,
+		},
+		"transparent symmetric key": {
+			ref: "1234",
+			response: kmipKeyResponse(kmipKeyFormatTransparentSymmetric,
+				encodeKmipStructure(kmipTagKeyMaterial, encodeKmipBytes(kmipTagKey, testDek))),
+			out: testDek,
+		},
+		"item not found": {
+			ref: "1234",
+			response: kmipResponse(
+				encodeKmipEnumeration(kmipTagResultStatus, 1),
+				encodeKmipEnumeration(kmipTagResultReason, kmipResultReasonItemNotFound),
+				encodeKmipText(kmipTagResultMessage, "no such object"),
+			),
+			kind:   ErrKeyNotFound,
+			errMsg: "key not found: 1234",
+		},
+		"operation failed": {
+			ref: "1234",
+			response: kmipResponse(
+				encodeKmipEnumeration(kmipTagResultStatus, 1),
+				encodeKmipEnumeration(kmipTagResultReason, 0x0C),
+				encodeKmipText(kmipTagResultMessage, "permission denied"),
+			),
+			errMsg: "KMIP Get of 1234 failed with status 1: permission denied",
+		},
+		"not supported key format": {
+			ref:      "1234",
+			response: kmipKeyResponse(0x03, encodeKmipBytes(kmipTagKeyMaterial, testDek)),
+			errMsg:   "not supported KMIP key format type 3",
+		},
+		"missing payload": {
+			ref:      "1234",
+			response: kmipResponse(encodeKmipEnumeration(kmipTagResultStatus, kmipResultStatusSuccess)),
+			errMsg:   "missing KMIP item 42007C in 42000F",
+		},
+		"unexpected message": {
+			ref:      "1234",
+			response: encodeKmipStructure(kmipTagRequestMessage),
+			errMsg:   "unexpected KMIP response tag 420078",
+		},
+		"truncated message": {
+			ref:      "1234",
+			response: encodeKmipStructure(kmipTagResponseMessage)[:4],
+			kind:     ErrUnavailable,
+			errMsg:   "could not read KMIP response: key manager is unavailable: unexpected EOF",
+		},
+		"invalid reference": {
+			ref:    "a/b",
+			kind:   ErrInvalidKeyRef,
+			errMsg: `invalid key reference "a/b"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, kmipServer := net.Pipe()
+			defer kmipServer.Close()
+			go func() {
+				request, err := readKmipItem(kmipServer)
+				if err != nil {
+					return
+				}
+				id, err := request.find(kmipTagBatchItem, kmipTagRequestPayload, kmipTagUniqueIdentifier)
+				if err != nil || string(id.value) != tt.ref {
+					t.Error("unexpected request", request, err)
+				}
+				_, _ = kmipServer.Write(tt.response)
+				_ = kmipServer.Close()
+			}()
+			km := &KmipKeyManager{
+				dial: func(ctx context.Context) (net.Conn, error) { return client, nil },
+			}
+
+			key, err := km.Key(context.Background(), tt.ref)
+
+			if !bytes.Equal(key, tt.out) {
+				t.Error("key: expected", tt.out, "received", key)
+			}
+			for _, kind := range []error{ErrKeyNotFound, ErrInvalidKeyRef, ErrKeyUnwrap, ErrUnavailable} {
+				if errors.Is(err, kind) != (kind == tt.kind) {
+					t.Error("error kind: expected", tt.kind, "received", err)
+				}
+			}
+			if tt.errMsg == "" && err != nil || tt.errMsg != "" && (err == nil || err.Error() != tt.errMsg) {
+				t.Error("error: expected", tt.errMsg, "received", err)
+			}
+		})
+	}
+}
+
+func TestKmipGetRequest(t *testing.T) {
+	request, err := decodeKmipItems(kmipGetRequest("1234"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(request) != 1 || request[0].tag != kmipTagRequestMessage {
+		t.Fatal("unexpected request", request)
+	}
+
+	operation, err := request[0].find(kmipTagBatchItem, kmipTagOperation)
+	if err != nil || operation.uint32() != kmipOperationGet {
+		t.Error("operation: expected", kmipOperationGet, "received", operation, err)
+	}
+	format, err := request[0].find(kmipTagBatchItem, kmipTagRequestPayload, kmipTagKeyFormatType)
+	if err != nil || format.uint32() != kmipKeyFormatRaw {
+		t.Error("key format: expected", kmipKeyFormatRaw, "received", format, err)
+	}
+	if len(kmipGetRequest("1234"))%8 != 0 {
+		t.Error("request is not aligned to 8 bytes")
+	}
+}
+
+func TestDecodeKmipItems(t *testing.T) {
+	tests := map[string]struct {
+		in     []byte
+		errMsg string
+	}{
+		"truncated header": {
+			in:     []byte{0x42, 0x00, 0x78},
+			errMsg: "truncated KMIP item header",
+		},
+		"length beyond buffer": {
+			in:     []byte{0x42, 0x00, 0x78, kmipTypeStructure, 0xFF, 0xFF, 0xFF, 0xFF},
+			errMsg: "truncated KMIP item 420078",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := decodeKmipItems(tt.in)
+
+			if err == nil || err.Error() != tt.errMsg {
+				t.Error("error: expected", tt.errMsg, "received", err)
+			}
+		})
+	}
+}
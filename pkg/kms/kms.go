@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package kms resolves references to data encryption keys held by key managers
+package kms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// ErrKeyNotFound is returned when a key manager does not know a key reference
+var ErrKeyNotFound = errors.New("key not found")
+
+// ErrInvalidKeyRef is returned for key references which are not valid
+var ErrInvalidKeyRef = errors.New("invalid key reference")
+
+// ErrKeyUnwrap is returned when a wrapped key cannot be unwrapped, e.g. as it
+// was wrapped with another key encryption key or for another reference
+var ErrKeyUnwrap = errors.New("could not unwrap key")
+
+// ErrUnavailable is returned when a key manager cannot be reached
+var ErrUnavailable = errors.New("key manager is unavailable")
+
+// KeyManager resolves key references to data encryption keys
+type KeyManager interface {
+	// Key returns the data encryption key referenced by ref. The key is
+	// only kept in memory and the caller is expected to Zero it once used
+	Key(ctx context.Context, ref string) ([]byte, error)
+}
+
+// validKeyRef limits key references to characters which are safe to use as
+// file names and KMIP unique identifiers
+var validKeyRef = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+func validateKeyRef(ref string) error {
+	if !validKeyRef.MatchString(ref) {
+		return fmt.Errorf("%w %q", ErrInvalidKeyRef, ref)
+	}
+	return nil
+}
+
+// Zero overwrites key material
+func Zero(key []byte) {
+	for i := range key {
+		key[i] = 0
+	}
+}
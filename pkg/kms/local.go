@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package kms resolves references to data encryption keys held by key managers
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// kekSize is the size of AES-256 key encryption keys
+const kekSize = 32
+
+// LocalKeyManager resolves key references to data encryption keys wrapped
+// with a key encryption key (KEK) read from a local file. Each wrapped key is
+// stored in a file named after its reference, as created by WrapKey. It is
+// meant for tests and deployments without a key management server
+type LocalKeyManager struct {
+	kek  cipher.AEAD
+	keys string
+}
+
+// NewLocalKeyManager creates a key manager using the raw AES-256 KEK stored
+// in kekPath to unwrap keys stored in the keys directory
+func NewLocalKeyManager(kekPath string, keys string) (*LocalKeyManager, error) {
+	kek, err := os.ReadFile(filepath.Clean(kekPath))
+	if err != nil {
+		return nil, fmt.Errorf("could not read key encryption key: %v", err)
+	}
+	defer Zero(kek)
+	aead, err := newKeyWrapper(kek)
+	if err != nil {
+		return nil, err
+	}
+	return &LocalKeyManager{kek: aead, keys: keys}, nil
+}
+
+// Key unwraps the data encryption key stored under ref
+func (m *LocalKeyManager) Key(_ context.Context, ref string) ([]byte, error) {
+	if err := validateKeyRef(ref); err != nil {
+		return nil, err
+	}
+	wrapped, err := os.ReadFile(filepath.Join(m.keys, ref))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, ref)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read wrapped key %s: %v", ref, err)
+	}
+	return unwrapKey(m.kek, ref, wrapped)
+}
+
+// WrapKey wraps a data encryption key with kek, so that LocalKeyManager can
+// resolve it once stored in a file named ref
+func WrapKey(kek []byte, ref string, dek []byte) ([]byte, error) {
+	if err := validateKeyRef(ref); err != nil {
+		return nil, err
+	}
+	aead, err := newKeyWrapper(kek)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	// the reference is authenticated, so wrapped keys cannot be swapped
+	return aead.Seal(nonce, nonce, dek, []byte(ref)), nil
+}
+
+func unwrapKey(kek cipher.AEAD, ref string, wrapped []byte) ([]byte, error) {
+	if len(wrapped) < kek.NonceSize() {
+		return nil, fmt.Errorf("%w %s: wrapped key is truncated", ErrKeyUnwrap, ref)
+	}
+	nonce, sealed := wrapped[:kek.NonceSize()], wrapped[kek.NonceSize():]
+	dek, err := kek.Open(nil, nonce, sealed, []byte(ref))
+	if err != nil {
+		return nil, fmt.Errorf("%w %s: %v", ErrKeyUnwrap, ref, err)
+	}
+	return dek, nil
+}
+
+func newKeyWrapper(kek []byte) (cipher.AEAD, error) {
+	if len(kek) != kekSize {
+		return nil, fmt.Errorf("expected key encryption key size %db, provided size %db", kekSize*8, len(kek)*8)
+	}
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
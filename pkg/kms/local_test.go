@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package kms resolves references to data encryption keys held by key managers
+package kms
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var (
+	testKek = []byte("0123456789abcdef0123456789abcdef")
+	testDek = []byte("fedcba9876543210fedcba9876543210")
+)
+
+func createLocalKeys(t *testing.T) (string, string) {
+	dir := t.TempDir()
+	kekPath := filepath.Join(dir, "kek")
+	if err := os.WriteFile(kekPath, testKek, 0600); err != nil {
+		t.Fatal(err)
+	}
+	keys := filepath.Join(dir, "keys")
+	if err := os.Mkdir(keys, 0700); err != nil {
+		t.Fatal(err)
+	}
+	for _, ref := range []string{"volume-key", "other-key"} {
+		wrapped, err := WrapKey(testKek, ref, testDek)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Contains(wrapped, testDek) {
+			t.Fatal("wrapped key contains key material")
+		}
+		if err := os.WriteFile(filepath.Join(keys, ref), wrapped, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return kekPath, keys
+}
+
+func TestLocalKeyManager_Key(t *testing.T) {
+	tests := map[string]struct {
+		ref     string
+		prepare func(t *testing.T, keys string)
+		out     []byte
+		kind    error
+		errMsg  string
+	}{
+		"valid reference": {
+			ref: "volume-key",
+			out: testDek,
+		},
+		"unknown reference": {
+			ref:    "unknown-key",
+			kind:   ErrKeyNotFound,
+			errMsg: "key not found: unknown-key",
+		},
+		"reference escaping keys directory": {
+			ref:    "../kek",
+			kind:   ErrInvalidKeyRef,
+			errMsg: `invalid key reference "../kek"`,
+		},
+		"empty reference": {
+			ref:    "",
+			kind:   ErrInvalidKeyRef,
+			errMsg: `invalid key reference ""`,
+		},
+		"wrapped key of another reference": {
+			ref: "volume-key",
+			prepare: func(t *testing.T, keys string) {
+				wrapped, err := os.ReadFile(filepath.Join(keys, "other-key"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(filepath.Join(keys, "volume-key"), wrapped, 0600); err != nil {
+					t.Fatal(err)
+				}
+			},
+			kind:   ErrKeyUnwrap,
+			errMsg: "could not unwrap key volume-key: cipher: message authentication failed",
+		},
+		"truncated wrapped key": {
+			ref: "volume-key",
+			prepare: func(t *testing.T, keys string) {
+				if err := os.WriteFile(filepath.Join(keys, "volume-key"), []byte("short"), 0600); err != nil {
+					t.Fatal(err)
+				}
+			},
+			kind:   ErrKeyUnwrap,
+			errMsg: "could not unwrap key volume-key: wrapped key is truncated",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			kekPath, keys := createLocalKeys(t)
+			if tt.prepare != nil {
+				tt.prepare(t, keys)
+			}
+			km, err := NewLocalKeyManager(kekPath, keys)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			key, err := km.Key(context.Background(), tt.ref)
+
+			if !bytes.Equal(key, tt.out) {
+				t.Error("key: expected", tt.out, "received", key)
+			}
+			for _, kind := range []error{ErrKeyNotFound, ErrInvalidKeyRef, ErrKeyUnwrap, ErrUnavailable} {
+				if errors.Is(err, kind) != (kind == tt.kind) {
+					t.Error("error kind: expected", tt.kind, "received", err)
+				}
+			}
+			if tt.errMsg == "" && err != nil || tt.errMsg != "" && (err == nil || err.Error() != tt.errMsg) {
+				t.Error("error: expected", tt.errMsg, "received", err)
+			}
+		})
+	}
+}
+
+func TestNewLocalKeyManager(t *testing.T) {
+	dir := t.TempDir()
+	shortKek := filepath.Join(dir, "short")
+	if err := os.WriteFile(shortKek, testKek[:16], 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		kekPath string
+		errMsg  string
+	}{
+		"missing kek": {
+			kekPath: filepath.Join(dir, "missing"),
+			errMsg:  "could not read key encryption key: open " + filepath.Join(dir, "missing") + ": no such file or directory",
+		},
+		"kek of wrong size": {
+			kekPath: shortKek,
+			errMsg:  "expected key encryption key size 256b, provided size 128b",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			km, err := NewLocalKeyManager(tt.kekPath, dir)
+
+			if km != nil {
+				t.Error("expected no key manager, received", km)
+			}
+			if err == nil || err.Error() != tt.errMsg {
+				t.Error("error: expected", tt.errMsg, "received", err)
+			}
+		})
+	}
+}
+
+func TestZero(t *testing.T) {
+	key := []byte("0123456789abcdef")
+
+	Zero(key)
+
+	if !bytes.Equal(key, make([]byte, 16)) {
+		t.Error("key not zeroed:", key)
+	}
+}
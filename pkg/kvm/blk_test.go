@@ -164,7 +164,7 @@ func TestCreateVirtioBlk(t *testing.T) {
 
 	for testName, tt := range tests {
 		t.Run(testName, func(t *testing.T) {
-			opiSpdkServer := frontend.NewServer(tt.jsonRPC)
+			opiSpdkServer := frontend.NewServer(tt.jsonRPC, createTestVolumeRegistry())
 			qmpServer := startMockQmpServer(t, tt.mockQmpCalls)
 			defer qmpServer.Stop()
 			qmpAddress := qmpServer.socketPath
@@ -265,7 +265,7 @@ func TestDeleteVirtioBlk(t *testing.T) {
 
 	for testName, tt := range tests {
 		t.Run(testName, func(t *testing.T) {
-			opiSpdkServer := frontend.NewServer(tt.jsonRPC)
+			opiSpdkServer := frontend.NewServer(tt.jsonRPC, createTestVolumeRegistry())
 			opiSpdkServer.Virt.BlkCtrls[testVirtioBlkName] =
 				server.ProtoClone(testCreateVirtioBlkRequest.VirtioBlk)
 			opiSpdkServer.Virt.BlkCtrls[testVirtioBlkName].Name = testVirtioBlkName
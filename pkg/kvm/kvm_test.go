@@ -18,6 +18,7 @@ import (
 
 	"github.com/opiproject/gospdk/spdk"
 	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -44,6 +45,19 @@ var (
 	)
 )
 
+func createTestVolumeRegistry() *volume.Registry {
+	registry := volume.NewRegistry()
+	err := registry.Register(volume.Volume{
+		Name:     server.ResourceIDToVolumeName("Malloc42"),
+		BdevName: "Malloc42",
+		Type:     volume.TypeNull,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	return registry
+}
+
 type stubJSONRRPC struct {
 	err error
 }
@@ -121,6 +121,7 @@ func dirExists(dirname string) bool {
 func TestCreateNvmeController(t *testing.T) {
 	expectNotNilOut := server.ProtoClone(testCreateNvmeControllerRequest.NvmeController)
 	expectNotNilOut.Spec.NvmeControllerId = proto.Int32(-1)
+	expectNotNilOut.Status = &pb.NvmeControllerStatus{Active: false}
 	expectNotNilOut.Name = testNvmeControllerName
 	t.Cleanup(server.CheckTestProtoObjectsNotChanged(expectNotNilOut)(t, t.Name()))
 	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
@@ -231,7 +232,7 @@ func TestCreateNvmeController(t *testing.T) {
 					NvmeControllerId: proto.Int32(-1),
 				},
 				Status: &pb.NvmeControllerStatus{
-					Active: true,
+					Active: false,
 				},
 			},
 			ctrlrDirExistsBeforeOperation: false,
@@ -303,7 +304,7 @@ func TestCreateNvmeController(t *testing.T) {
 
 	for testName, tt := range tests {
 		t.Run(testName, func(t *testing.T) {
-			opiSpdkServer := frontend.NewServer(tt.jsonRPC)
+			opiSpdkServer := frontend.NewServer(tt.jsonRPC, createTestVolumeRegistry())
 			opiSpdkServer.Nvme.Subsystems[testSubsystemName] = &testSubsystem
 			qmpServer := startMockQmpServer(t, tt.mockQmpCalls)
 			defer qmpServer.Stop()
@@ -449,7 +450,7 @@ func TestDeleteNvmeController(t *testing.T) {
 
 	for testName, tt := range tests {
 		t.Run(testName, func(t *testing.T) {
-			opiSpdkServer := frontend.NewServer(tt.jsonRPC)
+			opiSpdkServer := frontend.NewServer(tt.jsonRPC, createTestVolumeRegistry())
 			opiSpdkServer.Nvme.Subsystems[testSubsystemName] = &testSubsystem
 			if !tt.noController {
 				opiSpdkServer.Nvme.Controllers[testNvmeControllerName] =
@@ -0,0 +1,389 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package middleend implements the MiddleEnd APIs (service) of the storage Server
+package middleend
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
+
+	"go.einride.tech/aip/fieldbehavior"
+	"go.einride.tech/aip/resourceid"
+	"go.einride.tech/aip/resourcename"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// bdevOcfCreateParams holds the parameters required to create an OCF Block Device
+type bdevOcfCreateParams struct {
+	Name          string `json:"name"`
+	Mode          string `json:"mode"`
+	CacheLineSize uint32 `json:"cache_line_size,omitempty"`
+	CacheBdevName string `json:"cache_bdev_name"`
+	CoreBdevName  string `json:"core_bdev_name"`
+}
+
+// bdevOcfCreateResult is the result of creating an OCF Block Device
+type bdevOcfCreateResult string
+
+// bdevOcfDeleteParams holds the parameters required to delete an OCF Block Device
+type bdevOcfDeleteParams struct {
+	Name string `json:"name"`
+}
+
+// bdevOcfDeleteResult is the result of deleting an OCF Block Device
+type bdevOcfDeleteResult bool
+
+// bdevOcfSetCacheModeParams holds the parameters required to change cache
+// mode of an OCF Block Device
+type bdevOcfSetCacheModeParams struct {
+	Name string `json:"name"`
+	Mode string `json:"mode"`
+}
+
+// bdevOcfSetCacheModeResult is the cache mode applied to an OCF Block Device
+type bdevOcfSetCacheModeResult string
+
+// bdevOcfGetStatsParams holds the parameters required to get statistics of
+// an OCF Block Device
+type bdevOcfGetStatsParams struct {
+	Name string `json:"name"`
+}
+
+// bdevOcfStat is a single OCF statistic. SPDK formats the percentage as a
+// quoted string
+type bdevOcfStat struct {
+	Count      uint64 `json:"count"`
+	Percentage string `json:"percentage"`
+	Units      string `json:"units"`
+}
+
+// bdevOcfGetStatsResult is the result of getting statistics of an OCF
+// Block Device. Only request statistics are decoded
+type bdevOcfGetStatsResult struct {
+	Requests struct {
+		RdHits          bdevOcfStat `json:"rd_hits"`
+		RdPartialMisses bdevOcfStat `json:"rd_partial_misses"`
+		RdFullMisses    bdevOcfStat `json:"rd_full_misses"`
+		RdTotal         bdevOcfStat `json:"rd_total"`
+		WrHits          bdevOcfStat `json:"wr_hits"`
+		WrPartialMisses bdevOcfStat `json:"wr_partial_misses"`
+		WrFullMisses    bdevOcfStat `json:"wr_full_misses"`
+		WrTotal         bdevOcfStat `json:"wr_total"`
+		RdPt            bdevOcfStat `json:"rd_pt"`
+		WrPt            bdevOcfStat `json:"wr_pt"`
+	} `json:"requests"`
+}
+
+// cacheModes maps cache modes to cache modes supported by OCF
+var cacheModes = map[bridgepb.CacheMode]string{
+	bridgepb.CacheMode_CACHE_MODE_WRITE_THROUGH:    "wt",
+	bridgepb.CacheMode_CACHE_MODE_WRITE_BACK:       "wb",
+	bridgepb.CacheMode_CACHE_MODE_WRITE_AROUND:     "wa",
+	bridgepb.CacheMode_CACHE_MODE_WRITE_INVALIDATE: "wi",
+	bridgepb.CacheMode_CACHE_MODE_WRITE_ONLY:       "wo",
+	bridgepb.CacheMode_CACHE_MODE_PASS_THROUGH:     "pt",
+}
+
+func sortCachedVolumes(volumes []*bridgepb.CachedVolume) {
+	sort.Slice(volumes, func(i int, j int) bool {
+		return volumes[i].Name < volumes[j].Name
+	})
+}
+
+func (s *Server) getCachedVolume(name string) (*bridgepb.CachedVolume, error) {
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// fetch object from the database
+	cachedVolume, ok := s.volumes.cachedVolumes[name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	return cachedVolume, nil
+}
+
+// CreateCachedVolume creates a volume caching I/O to a registered volume on
+// another registered volume. Created volume can be referenced as any other
+// volume
+func (s *Server) CreateCachedVolume(_ context.Context, in *bridgepb.CreateCachedVolumeRequest) (*bridgepb.CachedVolume, error) {
+	log.Printf("CreateCachedVolume: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// see https://google.aip.dev/133#user-specified-ids
+	resourceID := resourceid.NewSystemGenerated()
+	if in.CachedVolumeId != "" {
+		err := resourceid.ValidateUserSettable(in.CachedVolumeId)
+		if err != nil {
+			log.Printf("error: %v", err)
+			return nil, err
+		}
+		log.Printf("client provided the ID of a resource %v, ignoring the name field %v", in.CachedVolumeId, in.CachedVolume.Name)
+		resourceID = in.CachedVolumeId
+	}
+	name := server.ResourceIDToVolumeName(resourceID)
+	if err := verifyCachedVolume(in.CachedVolume); err != nil {
+		log.Printf("error: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// idempotent API when called with same key, should return same object
+	if cachedVolume, ok := s.volumes.cachedVolumes[name]; ok {
+		log.Printf("Already existing CachedVolume with id %v", name)
+		return server.ProtoClone(cachedVolume), nil
+	}
+	core, err := s.registry.Get(in.CachedVolume.VolumeNameRef)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	cache, err := s.registry.Get(in.CachedVolume.CacheVolumeNameRef)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if core.Name == cache.Name {
+		err := status.Errorf(codes.InvalidArgument, "volume %v cannot cache itself", core.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	params := bdevOcfCreateParams{
+		Name:          resourceID,
+		Mode:          cacheModes[in.CachedVolume.Mode],
+		CacheLineSize: in.CachedVolume.CacheLineSizeKib,
+		CacheBdevName: cache.BdevName,
+		CoreBdevName:  core.BdevName,
+	}
+	var result bdevOcfCreateResult
+	err = s.rpc.Call("bdev_ocf_create", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if result == "" {
+		msg := fmt.Sprintf("Could not create OCF Dev: %s", params.Name)
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	response := server.ProtoClone(in.CachedVolume)
+	response.Name = name
+	s.volumes.cachedVolumes[name] = response
+	s.acquireVolume(core.Name, name)
+	s.acquireVolume(cache.Name, name)
+	err = s.registry.Register(volume.Volume{
+		Name:        name,
+		BdevName:    resourceID,
+		Type:        volume.TypeCached,
+		Owner:       bridgepb.CachedVolumeService_ServiceDesc.ServiceName,
+		BlockSize:   core.BlockSize,
+		BlocksCount: core.BlocksCount,
+	})
+	if err != nil {
+		log.Printf("error: %v", err)
+	}
+	log.Printf("CreateCachedVolume: Sending to client: %v", server.Redact(response))
+	return server.ProtoClone(response), nil
+}
+
+// DeleteCachedVolume deletes a cached volume. Dirty data is flushed to the
+// cached volume by OCF before the cache is stopped
+func (s *Server) DeleteCachedVolume(_ context.Context, in *bridgepb.DeleteCachedVolumeRequest) (*emptypb.Empty, error) {
+	log.Printf("DeleteCachedVolume: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if _, ok := s.volumes.cachedVolumes[in.Name]; !ok && in.AllowMissing {
+		return &emptypb.Empty{}, nil
+	}
+	cachedVolume, err := s.getCachedVolume(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.registry.CheckUnused(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	params := bdevOcfDeleteParams{
+		Name: path.Base(in.Name),
+	}
+	var result bdevOcfDeleteResult
+	err = s.rpc.Call("bdev_ocf_delete", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not delete OCF Dev: %s", params.Name)
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	delete(s.volumes.cachedVolumes, in.Name)
+	s.registry.Release(cachedVolume.VolumeNameRef, in.Name)
+	s.registry.Release(cachedVolume.CacheVolumeNameRef, in.Name)
+	if err := s.registry.Unregister(in.Name); err != nil {
+		log.Printf("error: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// UpdateCachedVolume updates a cached volume. Only cache mode can be
+// changed, which is applied without stopping the cache
+func (s *Server) UpdateCachedVolume(_ context.Context, in *bridgepb.UpdateCachedVolumeRequest) (*bridgepb.CachedVolume, error) {
+	log.Printf("UpdateCachedVolume: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	cachedVolume, err := s.getCachedVolume(in.CachedVolume.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyCachedVolume(in.CachedVolume); err != nil {
+		log.Printf("error: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if cachedVolume.VolumeNameRef != in.CachedVolume.VolumeNameRef ||
+		cachedVolume.CacheVolumeNameRef != in.CachedVolume.CacheVolumeNameRef {
+		msg := fmt.Sprintf("Change of underlying volumes %v, %v to new ones %v, %v is forbidden",
+			cachedVolume.VolumeNameRef, cachedVolume.CacheVolumeNameRef, in.CachedVolume.VolumeNameRef, in.CachedVolume.CacheVolumeNameRef)
+		log.Println("error:", msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	if cachedVolume.CacheLineSizeKib != in.CachedVolume.CacheLineSizeKib {
+		msg := fmt.Sprintf("Change of cache line size %v to %v is forbidden",
+			cachedVolume.CacheLineSizeKib, in.CachedVolume.CacheLineSizeKib)
+		log.Println("error:", msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	if cachedVolume.Mode != in.CachedVolume.Mode {
+		params := bdevOcfSetCacheModeParams{
+			Name: path.Base(cachedVolume.Name),
+			Mode: cacheModes[in.CachedVolume.Mode],
+		}
+		var result bdevOcfSetCacheModeResult
+		err := s.rpc.Call("bdev_ocf_set_cache_mode", &params, &result)
+		if err != nil {
+			log.Printf("error: %v", err)
+			return nil, err
+		}
+		log.Printf("Received from SPDK: %v", result)
+		if string(result) != params.Mode {
+			msg := fmt.Sprintf("Could not set cache mode %s of OCF Dev: %s", params.Mode, params.Name)
+			log.Print(msg)
+			return nil, status.Errorf(codes.InvalidArgument, msg)
+		}
+		cachedVolume.Mode = in.CachedVolume.Mode
+	}
+	return server.ProtoClone(cachedVolume), nil
+}
+
+// ListCachedVolumes lists cached volumes
+func (s *Server) ListCachedVolumes(_ context.Context, in *bridgepb.ListCachedVolumesRequest) (*bridgepb.ListCachedVolumesResponse, error) {
+	log.Printf("ListCachedVolumes: Received from client: %v", server.Redact(in))
+	size, offset, err := server.ExtractPagination(in.PageSize, in.PageToken, s.Pagination)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+
+	volumes := []*bridgepb.CachedVolume{}
+	for _, cachedVolume := range s.volumes.cachedVolumes {
+		volumes = append(volumes, server.ProtoClone(cachedVolume))
+	}
+	sortCachedVolumes(volumes)
+
+	token := ""
+	log.Printf("Limiting result len(%d) to [%d:%d]", len(volumes), offset, size)
+	volumes, hasMoreElements := server.LimitPagination(volumes, offset, size)
+	if hasMoreElements {
+		token = uuid.New().String()
+		s.Pagination[token] = offset + size
+	}
+	return &bridgepb.ListCachedVolumesResponse{CachedVolumes: volumes, NextPageToken: token}, nil
+}
+
+// GetCachedVolume gets a cached volume
+func (s *Server) GetCachedVolume(_ context.Context, in *bridgepb.GetCachedVolumeRequest) (*bridgepb.CachedVolume, error) {
+	log.Printf("GetCachedVolume: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	cachedVolume, err := s.getCachedVolume(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	return server.ProtoClone(cachedVolume), nil
+}
+
+// StatsCachedVolume gets I/O stats of a cached volume together with cache
+// hits and misses reported by OCF. I/O counters saturate at math.MaxInt32,
+// see server.VolumeStats.ToProto, while StatsVolume reports them in 64 bits
+func (s *Server) StatsCachedVolume(_ context.Context, in *bridgepb.StatsCachedVolumeRequest) (*bridgepb.StatsCachedVolumeResponse, error) {
+	log.Printf("StatsCachedVolume: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if _, err := s.getCachedVolume(in.Name); err != nil {
+		return nil, err
+	}
+	stats, err := s.volumeStats(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	params := bdevOcfGetStatsParams{
+		Name: path.Base(in.Name),
+	}
+	var result bdevOcfGetStatsResult
+	err = s.rpc.Call("bdev_ocf_get_stats", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	requests := result.Requests
+	return &bridgepb.StatsCachedVolumeResponse{
+		Stats:       stats.ToProto(),
+		ReadHits:    requests.RdHits.Count,
+		ReadMisses:  requests.RdPartialMisses.Count + requests.RdFullMisses.Count,
+		WriteHits:   requests.WrHits.Count,
+		WriteMisses: requests.WrPartialMisses.Count + requests.WrFullMisses.Count,
+		PassThrough: requests.RdPt.Count + requests.WrPt.Count,
+	}, nil
+}
+
+func verifyCachedVolume(cachedVolume *bridgepb.CachedVolume) error {
+	if _, ok := cacheModes[cachedVolume.Mode]; !ok {
+		return fmt.Errorf("cache mode %v is not supported", cachedVolume.Mode)
+	}
+	switch cachedVolume.CacheLineSizeKib {
+	case 0, 4, 8, 16, 32, 64:
+	default:
+		return fmt.Errorf("cache line size %d KiB is not supported, must be one of 4, 8, 16, 32, 64", cachedVolume.CacheLineSizeKib)
+	}
+	return nil
+}
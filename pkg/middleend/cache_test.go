@@ -0,0 +1,462 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package middleend implements the MiddleEnd APIs (service) of the storage Server
+package middleend
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
+)
+
+var (
+	cachedVolumeID   = "cache-test"
+	cachedVolumeName = server.ResourceIDToVolumeName(cachedVolumeID)
+	cachedVolume     = bridgepb.CachedVolume{
+		Name:               cachedVolumeName,
+		VolumeNameRef:      "volume-test",
+		CacheVolumeNameRef: "volume-42",
+		Mode:               bridgepb.CacheMode_CACHE_MODE_WRITE_BACK,
+		CacheLineSizeKib:   64,
+	}
+)
+
+func addTestCachedVolume(env *testEnv) {
+	v := server.ProtoClone(&cachedVolume)
+	env.opiSpdkServer.volumes.cachedVolumes[cachedVolumeName] = v
+	_ = env.opiSpdkServer.registry.Register(volume.Volume{
+		Name:     cachedVolumeName,
+		BdevName: cachedVolumeID,
+		Type:     volume.TypeCached,
+		Owner:    bridgepb.CachedVolumeService_ServiceDesc.ServiceName,
+	})
+	env.opiSpdkServer.acquireVolume(v.VolumeNameRef, cachedVolumeName)
+	env.opiSpdkServer.acquireVolume(v.CacheVolumeNameRef, cachedVolumeName)
+}
+
+func TestMiddleEnd_CreateCachedVolume(t *testing.T) {
+	tests := map[string]struct {
+		id      string
+		in      *bridgepb.CachedVolume
+		out     *bridgepb.CachedVolume
+		spdk    []string
+		errCode codes.Code
+		errMsg  string
+		exist   bool
+	}{
+		"illegal resource_id": {
+			id:      "CapitalLettersNotAllowed",
+			in:      &cachedVolume,
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.Unknown,
+			errMsg:  fmt.Sprintf("user-settable ID must only contain lowercase, numbers and hyphens (%v)", "got: 'C' in position 0"),
+		},
+		"missing cache volume": {
+			id:      cachedVolumeID,
+			in:      &bridgepb.CachedVolume{VolumeNameRef: "volume-test", Mode: bridgepb.CacheMode_CACHE_MODE_WRITE_BACK},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.Unknown,
+			errMsg:  "missing required field: cached_volume.cache_volume_name_ref",
+		},
+		"unsupported cache mode": {
+			id:      cachedVolumeID,
+			in:      &bridgepb.CachedVolume{VolumeNameRef: "volume-test", CacheVolumeNameRef: "volume-42", Mode: 9},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("cache mode %v is not supported", 9),
+		},
+		"unsupported cache line size": {
+			id:      cachedVolumeID,
+			in:      &bridgepb.CachedVolume{VolumeNameRef: "volume-test", CacheVolumeNameRef: "volume-42", Mode: bridgepb.CacheMode_CACHE_MODE_WRITE_THROUGH, CacheLineSizeKib: 128},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  "cache line size 128 KiB is not supported, must be one of 4, 8, 16, 32, 64",
+		},
+		"unknown cache volume": {
+			id:      cachedVolumeID,
+			in:      &bridgepb.CachedVolume{VolumeNameRef: "volume-test", CacheVolumeNameRef: "unknown", Mode: bridgepb.CacheMode_CACHE_MODE_WRITE_THROUGH},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  "unable to find volume unknown",
+		},
+		"volume caching itself": {
+			id:      cachedVolumeID,
+			in:      &bridgepb.CachedVolume{VolumeNameRef: "volume-test", CacheVolumeNameRef: "volume-test", Mode: bridgepb.CacheMode_CACHE_MODE_WRITE_THROUGH},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("volume %v cannot cache itself", server.ResourceIDToVolumeName("volume-test")),
+		},
+		"valid request with invalid SPDK response": {
+			id:      cachedVolumeID,
+			in:      &cachedVolume,
+			out:     nil,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":""}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("Could not create OCF Dev: %v", cachedVolumeID),
+		},
+		"valid request with error code from SPDK response": {
+			id:      cachedVolumeID,
+			in:      &cachedVolume,
+			out:     nil,
+			spdk:    []string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":""}`},
+			errCode: codes.Unknown,
+			errMsg:  fmt.Sprintf("bdev_ocf_create: %v", "json response error: myopierr"),
+		},
+		"valid request with valid SPDK response": {
+			id:      cachedVolumeID,
+			in:      &cachedVolume,
+			out:     &cachedVolume,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":"cache-test"}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"already exists": {
+			id:      cachedVolumeID,
+			in:      &cachedVolume,
+			out:     &cachedVolume,
+			spdk:    []string{},
+			errCode: codes.OK,
+			errMsg:  "",
+			exist:   true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+			if tt.exist {
+				addTestCachedVolume(testEnv)
+			}
+
+			request := &bridgepb.CreateCachedVolumeRequest{CachedVolume: tt.in, CachedVolumeId: tt.id}
+			response, err := testEnv.client.CreateCachedVolume(testEnv.ctx, request)
+
+			if !proto.Equal(response, tt.out) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+
+			if tt.out != nil {
+				for _, ref := range []string{"volume-test", "volume-42"} {
+					vol, _ := testEnv.opiSpdkServer.registry.Get(ref)
+					if !reflect.DeepEqual(vol.Users, []string{cachedVolumeName}) {
+						t.Error("expected", ref, "used by", cachedVolumeName, "received", vol.Users)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_DeleteCachedVolume(t *testing.T) {
+	tests := map[string]struct {
+		in      string
+		spdk    []string
+		errCode codes.Code
+		errMsg  string
+		missing bool
+	}{
+		"unknown key": {
+			in:      server.ResourceIDToVolumeName("unknown-id"),
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %v", server.ResourceIDToVolumeName("unknown-id")),
+		},
+		"unknown key with missing allowed": {
+			in:      server.ResourceIDToVolumeName("unknown-id"),
+			spdk:    []string{},
+			errCode: codes.OK,
+			errMsg:  "",
+			missing: true,
+		},
+		"valid request with invalid SPDK response": {
+			in:      cachedVolumeName,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("Could not delete OCF Dev: %v", cachedVolumeID),
+		},
+		"valid request with error code from SPDK response": {
+			in:      cachedVolumeName,
+			spdk:    []string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":false}`},
+			errCode: codes.Unknown,
+			errMsg:  fmt.Sprintf("bdev_ocf_delete: %v", "json response error: myopierr"),
+		},
+		"valid request with valid SPDK response": {
+			in:      cachedVolumeName,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+			addTestCachedVolume(testEnv)
+
+			request := &bridgepb.DeleteCachedVolumeRequest{Name: tt.in, AllowMissing: tt.missing}
+			_, err := testEnv.client.DeleteCachedVolume(testEnv.ctx, request)
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+
+			deleted := tt.errCode == codes.OK && tt.in == cachedVolumeName
+			for _, ref := range []string{"volume-test", "volume-42"} {
+				vol, _ := testEnv.opiSpdkServer.registry.Get(ref)
+				if released := len(vol.Users) == 0; released != deleted {
+					t.Error("unexpected users of", ref, vol.Users)
+				}
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_UpdateCachedVolume(t *testing.T) {
+	writeThrough := server.ProtoClone(&cachedVolume)
+	writeThrough.Mode = bridgepb.CacheMode_CACHE_MODE_WRITE_THROUGH
+	tests := map[string]struct {
+		in      *bridgepb.CachedVolume
+		out     *bridgepb.CachedVolume
+		spdk    []string
+		errCode codes.Code
+		errMsg  string
+	}{
+		"unknown key": {
+			in:      &bridgepb.CachedVolume{Name: server.ResourceIDToVolumeName("unknown-id"), VolumeNameRef: "volume-test", CacheVolumeNameRef: "volume-42", Mode: bridgepb.CacheMode_CACHE_MODE_WRITE_BACK},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %v", server.ResourceIDToVolumeName("unknown-id")),
+		},
+		"change cache volume": {
+			in:      &bridgepb.CachedVolume{Name: cachedVolumeName, VolumeNameRef: "volume-test", CacheVolumeNameRef: "volume-test", Mode: bridgepb.CacheMode_CACHE_MODE_WRITE_BACK, CacheLineSizeKib: 64},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("Change of underlying volumes %v, %v to new ones %v, %v is forbidden", "volume-test", "volume-42", "volume-test", "volume-test"),
+		},
+		"change cache line size": {
+			in:      &bridgepb.CachedVolume{Name: cachedVolumeName, VolumeNameRef: "volume-test", CacheVolumeNameRef: "volume-42", Mode: bridgepb.CacheMode_CACHE_MODE_WRITE_BACK, CacheLineSizeKib: 4},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  "Change of cache line size 64 to 4 is forbidden",
+		},
+		"unsupported cache mode": {
+			in:      &bridgepb.CachedVolume{Name: cachedVolumeName, VolumeNameRef: "volume-test", CacheVolumeNameRef: "volume-42", Mode: 9, CacheLineSizeKib: 64},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("cache mode %v is not supported", 9),
+		},
+		"mode not changed": {
+			in:      &cachedVolume,
+			out:     &cachedVolume,
+			spdk:    []string{},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"mode change with invalid SPDK response": {
+			in:      writeThrough,
+			out:     nil,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":"wb"}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("Could not set cache mode %v of OCF Dev: %v", "wt", cachedVolumeID),
+		},
+		"mode change with error code from SPDK response": {
+			in:      writeThrough,
+			out:     nil,
+			spdk:    []string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":""}`},
+			errCode: codes.Unknown,
+			errMsg:  fmt.Sprintf("bdev_ocf_set_cache_mode: %v", "json response error: myopierr"),
+		},
+		"mode change": {
+			in:      writeThrough,
+			out:     writeThrough,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":"wt"}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+			addTestCachedVolume(testEnv)
+
+			request := &bridgepb.UpdateCachedVolumeRequest{CachedVolume: tt.in}
+			response, err := testEnv.client.UpdateCachedVolume(testEnv.ctx, request)
+
+			if !proto.Equal(response, tt.out) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_ListCachedVolumes(t *testing.T) {
+	tests := map[string]struct {
+		size    int32
+		token   string
+		out     []*bridgepb.CachedVolume
+		errCode codes.Code
+		errMsg  string
+	}{
+		"valid request": {
+			out:     []*bridgepb.CachedVolume{&cachedVolume},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"negative page size": {
+			size:    -10,
+			out:     nil,
+			errCode: codes.InvalidArgument,
+			errMsg:  "negative PageSize is not allowed",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment([]string{})
+			defer testEnv.Close()
+			addTestCachedVolume(testEnv)
+
+			request := &bridgepb.ListCachedVolumesRequest{PageSize: tt.size, PageToken: tt.token}
+			response, err := testEnv.client.ListCachedVolumes(testEnv.ctx, request)
+
+			if !server.EqualProtoSlices(response.GetCachedVolumes(), tt.out) {
+				t.Error("response: expected", tt.out, "received", response.GetCachedVolumes())
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_StatsCachedVolume(t *testing.T) {
+	tests := map[string]struct {
+		in      string
+		out     *bridgepb.StatsCachedVolumeResponse
+		spdk    []string
+		errCode codes.Code
+		errMsg  string
+	}{
+		"unknown key": {
+			in:      server.ResourceIDToVolumeName("unknown-id"),
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %v", server.ResourceIDToVolumeName("unknown-id")),
+		},
+		"iostat with invalid SPDK response": {
+			in:      cachedVolumeName,
+			out:     nil,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":{"tick_rate":0,"ticks":0,"bdevs":[]}}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("expecting exactly 1 result, got %v", 0),
+		},
+		"ocf stats with error code from SPDK response": {
+			in:  cachedVolumeName,
+			out: nil,
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":{"tick_rate":0,"ticks":0,"bdevs":[{"name":"cache-test"}]}}`,
+				`{"id":%d,"error":{"code":1,"message":"myopierr"}}`,
+			},
+			errCode: codes.Unknown,
+			errMsg:  fmt.Sprintf("bdev_ocf_get_stats: %v", "json response error: myopierr"),
+		},
+		"valid request with valid SPDK response": {
+			in: cachedVolumeName,
+			out: &bridgepb.StatsCachedVolumeResponse{
+				Stats:       &pb.VolumeStats{ReadBytesCount: 8192, ReadOpsCount: 10, WriteBytesCount: 4096, WriteOpsCount: 5},
+				ReadHits:    7,
+				ReadMisses:  3,
+				WriteHits:   1,
+				WriteMisses: 4,
+				PassThrough: 2,
+			},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":{"tick_rate":0,"ticks":0,"bdevs":[{"name":"cache-test","bytes_read":8192,"num_read_ops":10,"bytes_written":4096,"num_write_ops":5}]}}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":{"requests":{` +
+					`"rd_hits":{"count":7,"percentage":"46.6","units":"Requests"},` +
+					`"rd_partial_misses":{"count":1,"percentage":"6.6","units":"Requests"},` +
+					`"rd_full_misses":{"count":2,"percentage":"13.3","units":"Requests"},` +
+					`"wr_hits":{"count":1,"percentage":"6.6","units":"Requests"},` +
+					`"wr_partial_misses":{"count":0,"percentage":"0","units":"Requests"},` +
+					`"wr_full_misses":{"count":4,"percentage":"26.6","units":"Requests"},` +
+					`"rd_pt":{"count":1,"percentage":"6.6","units":"Requests"},` +
+					`"wr_pt":{"count":1,"percentage":"6.6","units":"Requests"}}}}`,
+			},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+			addTestCachedVolume(testEnv)
+
+			request := &bridgepb.StatsCachedVolumeRequest{Name: tt.in}
+			response, err := testEnv.client.StatsCachedVolume(testEnv.ctx, request)
+
+			if !proto.Equal(response, tt.out) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
@@ -0,0 +1,322 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package middleend implements the MiddleEnd APIs (service) of the storage Server
+package middleend
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
+
+	"go.einride.tech/aip/fieldbehavior"
+	"go.einride.tech/aip/resourceid"
+	"go.einride.tech/aip/resourcename"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// defaultCompressPmPath is the directory where SPDK keeps compression
+// metadata unless changed with SetCompressPmPath
+const defaultCompressPmPath = "/var/tmp/opi-compress"
+
+// compression keeps track of compressed volumes
+type compression struct {
+	pmPath  string
+	volumes map[string]*bridgepb.CompressedVolume
+}
+
+// bdevCompressCreateParams holds the parameters required to create a compress Block Device
+type bdevCompressCreateParams struct {
+	BaseBdevName string `json:"base_bdev_name"`
+	PmPath       string `json:"pm_path"`
+	LbSize       uint32 `json:"lb_size,omitempty"`
+}
+
+// bdevCompressCreateResult is the result of creating a compress Block Device
+type bdevCompressCreateResult string
+
+// bdevCompressDeleteParams holds the parameters required to delete a compress Block Device
+type bdevCompressDeleteParams struct {
+	Name string `json:"name"`
+}
+
+// bdevCompressDeleteResult is the result of deleting a compress Block Device
+type bdevCompressDeleteResult bool
+
+func sortCompressedVolumes(volumes []*bridgepb.CompressedVolume) {
+	sort.Slice(volumes, func(i int, j int) bool {
+		return volumes[i].Name < volumes[j].Name
+	})
+}
+
+// SetCompressPmPath sets the directory where SPDK keeps metadata of
+// compressed volumes. Applies to volumes created afterwards
+func (s *Server) SetCompressPmPath(pmPath string) {
+	s.compression.pmPath = pmPath
+}
+
+func (s *Server) getCompressedVolume(name string) (*bridgepb.CompressedVolume, error) {
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// fetch object from the database
+	compressedVolume, ok := s.compression.volumes[name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	return compressedVolume, nil
+}
+
+// CreateCompressedVolume creates a compressed volume on top of a registered
+// volume. Created volume can be referenced as any other volume
+func (s *Server) CreateCompressedVolume(_ context.Context, in *bridgepb.CreateCompressedVolumeRequest) (*bridgepb.CompressedVolume, error) {
+	log.Printf("CreateCompressedVolume: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// see https://google.aip.dev/133#user-specified-ids
+	resourceID := resourceid.NewSystemGenerated()
+	if in.CompressedVolumeId != "" {
+		err := resourceid.ValidateUserSettable(in.CompressedVolumeId)
+		if err != nil {
+			log.Printf("error: %v", err)
+			return nil, err
+		}
+		log.Printf("client provided the ID of a resource %v, ignoring the name field %v", in.CompressedVolumeId, in.CompressedVolume.Name)
+		resourceID = in.CompressedVolumeId
+	}
+	name := server.ResourceIDToVolumeName(resourceID)
+	if err := verifyCompressedVolume(in.CompressedVolume); err != nil {
+		log.Printf("error: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// idempotent API when called with same key, should return same object
+	if compressedVolume, ok := s.compression.volumes[name]; ok {
+		log.Printf("Already existing CompressedVolume with id %v", name)
+		return server.ProtoClone(compressedVolume), nil
+	}
+	base, err := s.registry.Get(in.CompressedVolume.VolumeNameRef)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	params := bdevCompressCreateParams{
+		BaseBdevName: base.BdevName,
+		PmPath:       s.compression.pmPath,
+		LbSize:       in.CompressedVolume.LogicalBlockSize,
+	}
+	var result bdevCompressCreateResult
+	err = s.rpc.Call("bdev_compress_create", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if result == "" {
+		msg := fmt.Sprintf("Could not create Compress Dev: %s", params.BaseBdevName)
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	response := server.ProtoClone(in.CompressedVolume)
+	response.Name = name
+	s.compression.volumes[name] = response
+	s.acquireVolume(base.Name, name)
+	s.registerCompressedVolume(name, string(result), response.LogicalBlockSize, base)
+	log.Printf("CreateCompressedVolume: Sending to client: %v", server.Redact(response))
+	return server.ProtoClone(response), nil
+}
+
+// DeleteCompressedVolume deletes a compressed volume. Compressed data is
+// removed from the underlying volume
+func (s *Server) DeleteCompressedVolume(_ context.Context, in *bridgepb.DeleteCompressedVolumeRequest) (*emptypb.Empty, error) {
+	log.Printf("DeleteCompressedVolume: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if _, ok := s.compression.volumes[in.Name]; !ok && in.AllowMissing {
+		return &emptypb.Empty{}, nil
+	}
+	compressedVolume, err := s.getCompressedVolume(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.registry.CheckUnused(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	bdevName, err := s.bdevName(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	params := bdevCompressDeleteParams{
+		Name: bdevName,
+	}
+	var result bdevCompressDeleteResult
+	err = s.rpc.Call("bdev_compress_delete", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not delete Compress Dev: %s", params.Name)
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	delete(s.compression.volumes, in.Name)
+	s.registry.Release(compressedVolume.VolumeNameRef, in.Name)
+	if err := s.registry.Unregister(in.Name); err != nil {
+		log.Printf("error: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// UpdateCompressedVolume updates a compressed volume. Compressed data
+// layout is persisted by SPDK, so neither the underlying volume nor the
+// logical block size can be changed
+func (s *Server) UpdateCompressedVolume(_ context.Context, in *bridgepb.UpdateCompressedVolumeRequest) (*bridgepb.CompressedVolume, error) {
+	log.Printf("UpdateCompressedVolume: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	compressedVolume, err := s.getCompressedVolume(in.CompressedVolume.Name)
+	if err != nil {
+		return nil, err
+	}
+	if compressedVolume.VolumeNameRef != in.CompressedVolume.VolumeNameRef {
+		msg := fmt.Sprintf("Change of underlying volume %v to a new one %v is forbidden",
+			compressedVolume.VolumeNameRef, in.CompressedVolume.VolumeNameRef)
+		log.Println("error:", msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	if compressedVolume.LogicalBlockSize != in.CompressedVolume.LogicalBlockSize {
+		msg := fmt.Sprintf("Change of logical block size %v to %v is forbidden",
+			compressedVolume.LogicalBlockSize, in.CompressedVolume.LogicalBlockSize)
+		log.Println("error:", msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	return server.ProtoClone(compressedVolume), nil
+}
+
+// ListCompressedVolumes lists compressed volumes
+func (s *Server) ListCompressedVolumes(_ context.Context, in *bridgepb.ListCompressedVolumesRequest) (*bridgepb.ListCompressedVolumesResponse, error) {
+	log.Printf("ListCompressedVolumes: Received from client: %v", server.Redact(in))
+	size, offset, err := server.ExtractPagination(in.PageSize, in.PageToken, s.Pagination)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+
+	volumes := []*bridgepb.CompressedVolume{}
+	for _, compressedVolume := range s.compression.volumes {
+		volumes = append(volumes, server.ProtoClone(compressedVolume))
+	}
+	sortCompressedVolumes(volumes)
+
+	token := ""
+	log.Printf("Limiting result len(%d) to [%d:%d]", len(volumes), offset, size)
+	volumes, hasMoreElements := server.LimitPagination(volumes, offset, size)
+	if hasMoreElements {
+		token = uuid.New().String()
+		s.Pagination[token] = offset + size
+	}
+	return &bridgepb.ListCompressedVolumesResponse{CompressedVolumes: volumes, NextPageToken: token}, nil
+}
+
+// GetCompressedVolume gets a compressed volume
+func (s *Server) GetCompressedVolume(_ context.Context, in *bridgepb.GetCompressedVolumeRequest) (*bridgepb.CompressedVolume, error) {
+	log.Printf("GetCompressedVolume: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	compressedVolume, err := s.getCompressedVolume(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	return server.ProtoClone(compressedVolume), nil
+}
+
+// StatsCompressedVolume gets a compressed volume stats. Compression ratio
+// is calculated from bytes written to the compressed and the underlying
+// volumes, so it includes compression metadata. Counters saturate at
+// math.MaxInt32, see server.VolumeStats.ToProto, while StatsVolume reports
+// them in 64 bits
+func (s *Server) StatsCompressedVolume(_ context.Context, in *bridgepb.StatsCompressedVolumeRequest) (*bridgepb.StatsCompressedVolumeResponse, error) {
+	log.Printf("StatsCompressedVolume: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	compressedVolume, err := s.getCompressedVolume(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := s.volumeStats(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	baseStats, err := s.volumeStats(compressedVolume.VolumeNameRef)
+	if err != nil {
+		return nil, err
+	}
+	ratio := 0.0
+	if baseStats.WriteBytes > 0 {
+		ratio = float64(stats.WriteBytes) / float64(baseStats.WriteBytes)
+	}
+	return &bridgepb.StatsCompressedVolumeResponse{
+		Stats:            stats.ToProto(),
+		CompressionRatio: ratio,
+	}, nil
+}
+
+func verifyCompressedVolume(compressedVolume *bridgepb.CompressedVolume) error {
+	switch compressedVolume.LogicalBlockSize {
+	case 0, 512, 4096:
+		return nil
+	default:
+		return fmt.Errorf("logical block size %d is not supported, must be 512 or 4096", compressedVolume.LogicalBlockSize)
+	}
+}
+
+// registerCompressedVolume makes a created compress bdev resolvable by
+// services referencing volumes
+func (s *Server) registerCompressedVolume(name string, bdevName string, logicalBlockSize uint32, base volume.Volume) {
+	blockSize := base.BlockSize
+	blocksCount := base.BlocksCount
+	if logicalBlockSize != 0 {
+		blockSize = int64(logicalBlockSize)
+		blocksCount = base.BlocksCount * base.BlockSize / blockSize
+	}
+	err := s.registry.Register(volume.Volume{
+		Name:        name,
+		BdevName:    bdevName,
+		Type:        volume.TypeCompressed,
+		Owner:       bridgepb.CompressedVolumeService_ServiceDesc.ServiceName,
+		BlockSize:   blockSize,
+		BlocksCount: blocksCount,
+	})
+	if err != nil {
+		log.Printf("error: %v", err)
+	}
+}
@@ -0,0 +1,417 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package middleend implements the MiddleEnd APIs (service) of the storage Server
+package middleend
+
+import (
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
+)
+
+var (
+	compressedVolumeID   = "compress-test"
+	compressedVolumeName = server.ResourceIDToVolumeName(compressedVolumeID)
+	compressedVolume     = bridgepb.CompressedVolume{
+		Name:             compressedVolumeName,
+		VolumeNameRef:    "volume-test",
+		LogicalBlockSize: 4096,
+	}
+	compressedBdevName = "COMP_volume-test"
+)
+
+func addTestCompressedVolume(env *testEnv) {
+	v := server.ProtoClone(&compressedVolume)
+	env.opiSpdkServer.compression.volumes[compressedVolumeName] = v
+	env.opiSpdkServer.registerCompressedVolume(compressedVolumeName, compressedBdevName, v.LogicalBlockSize, volume.Volume{})
+	env.opiSpdkServer.acquireVolume(v.VolumeNameRef, compressedVolumeName)
+}
+
+func TestMiddleEnd_CreateCompressedVolume(t *testing.T) {
+	tests := map[string]struct {
+		id      string
+		in      *bridgepb.CompressedVolume
+		out     *bridgepb.CompressedVolume
+		spdk    []string
+		errCode codes.Code
+		errMsg  string
+		exist   bool
+	}{
+		"illegal resource_id": {
+			id:      "CapitalLettersNotAllowed",
+			in:      &compressedVolume,
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.Unknown,
+			errMsg:  fmt.Sprintf("user-settable ID must only contain lowercase, numbers and hyphens (%v)", "got: 'C' in position 0"),
+		},
+		"missing volume": {
+			id:      compressedVolumeID,
+			in:      &bridgepb.CompressedVolume{},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.Unknown,
+			errMsg:  "missing required field: compressed_volume.volume_name_ref",
+		},
+		"unsupported logical block size": {
+			id:      compressedVolumeID,
+			in:      &bridgepb.CompressedVolume{VolumeNameRef: "volume-test", LogicalBlockSize: 1024},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  "logical block size 1024 is not supported, must be 512 or 4096",
+		},
+		"unknown volume": {
+			id:      compressedVolumeID,
+			in:      &bridgepb.CompressedVolume{VolumeNameRef: "unknown"},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  "unable to find volume unknown",
+		},
+		"valid request with invalid SPDK response": {
+			id:      compressedVolumeID,
+			in:      &compressedVolume,
+			out:     nil,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":""}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("Could not create Compress Dev: %v", "volume-test"),
+		},
+		"valid request with error code from SPDK response": {
+			id:      compressedVolumeID,
+			in:      &compressedVolume,
+			out:     nil,
+			spdk:    []string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":""}`},
+			errCode: codes.Unknown,
+			errMsg:  fmt.Sprintf("bdev_compress_create: %v", "json response error: myopierr"),
+		},
+		"valid request with valid SPDK response": {
+			id:      compressedVolumeID,
+			in:      &compressedVolume,
+			out:     &compressedVolume,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":"COMP_volume-test"}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"already exists": {
+			id:      compressedVolumeID,
+			in:      &compressedVolume,
+			out:     &compressedVolume,
+			spdk:    []string{},
+			errCode: codes.OK,
+			errMsg:  "",
+			exist:   true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+			if tt.exist {
+				addTestCompressedVolume(testEnv)
+			}
+
+			request := &bridgepb.CreateCompressedVolumeRequest{CompressedVolume: tt.in, CompressedVolumeId: tt.id}
+			response, err := testEnv.client.CreateCompressedVolume(testEnv.ctx, request)
+
+			if !proto.Equal(response, tt.out) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+
+			if tt.out != nil {
+				vol, err := testEnv.opiSpdkServer.registry.Get(compressedVolumeName)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if vol.BdevName != compressedBdevName || vol.BlockSize != 4096 {
+					t.Error("expected registered volume with bdev", compressedBdevName, "received", vol)
+				}
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_DeleteCompressedVolume(t *testing.T) {
+	tests := map[string]struct {
+		in      string
+		spdk    []string
+		errCode codes.Code
+		errMsg  string
+		missing bool
+		inUse   bool
+	}{
+		"unknown key": {
+			in:      server.ResourceIDToVolumeName("unknown-id"),
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %v", server.ResourceIDToVolumeName("unknown-id")),
+		},
+		"unknown key with missing allowed": {
+			in:      server.ResourceIDToVolumeName("unknown-id"),
+			spdk:    []string{},
+			errCode: codes.OK,
+			errMsg:  "",
+			missing: true,
+		},
+		"volume in use": {
+			in:      compressedVolumeName,
+			spdk:    []string{},
+			errCode: codes.FailedPrecondition,
+			errMsg:  fmt.Sprintf("volume %v is in use by [namespace]", compressedVolumeName),
+			inUse:   true,
+		},
+		"valid request with invalid SPDK response": {
+			in:      compressedVolumeName,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("Could not delete Compress Dev: %v", compressedBdevName),
+		},
+		"valid request with error code from SPDK response": {
+			in:      compressedVolumeName,
+			spdk:    []string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":false}`},
+			errCode: codes.Unknown,
+			errMsg:  fmt.Sprintf("bdev_compress_delete: %v", "json response error: myopierr"),
+		},
+		"valid request with valid SPDK response": {
+			in:      compressedVolumeName,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+			addTestCompressedVolume(testEnv)
+			if tt.inUse {
+				testEnv.opiSpdkServer.acquireVolume(compressedVolumeName, "namespace")
+			}
+
+			request := &bridgepb.DeleteCompressedVolumeRequest{Name: tt.in, AllowMissing: tt.missing}
+			_, err := testEnv.client.DeleteCompressedVolume(testEnv.ctx, request)
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+
+			_, registered := testEnv.opiSpdkServer.compression.volumes[compressedVolumeName]
+			deleted := tt.errCode == codes.OK && tt.in == compressedVolumeName
+			if registered == deleted {
+				t.Error("compressed volume present: expected", !deleted, "received", registered)
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_UpdateCompressedVolume(t *testing.T) {
+	tests := map[string]struct {
+		in      *bridgepb.CompressedVolume
+		out     *bridgepb.CompressedVolume
+		errCode codes.Code
+		errMsg  string
+	}{
+		"unknown key": {
+			in:      &bridgepb.CompressedVolume{Name: server.ResourceIDToVolumeName("unknown-id"), VolumeNameRef: "volume-test"},
+			out:     nil,
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %v", server.ResourceIDToVolumeName("unknown-id")),
+		},
+		"change underlying volume": {
+			in:      &bridgepb.CompressedVolume{Name: compressedVolumeName, VolumeNameRef: "volume-42", LogicalBlockSize: 4096},
+			out:     nil,
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("Change of underlying volume %v to a new one %v is forbidden", "volume-test", "volume-42"),
+		},
+		"change logical block size": {
+			in:      &bridgepb.CompressedVolume{Name: compressedVolumeName, VolumeNameRef: "volume-test", LogicalBlockSize: 512},
+			out:     nil,
+			errCode: codes.InvalidArgument,
+			errMsg:  "Change of logical block size 4096 to 512 is forbidden",
+		},
+		"nothing changed": {
+			in:      &compressedVolume,
+			out:     &compressedVolume,
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment([]string{})
+			defer testEnv.Close()
+			addTestCompressedVolume(testEnv)
+
+			request := &bridgepb.UpdateCompressedVolumeRequest{CompressedVolume: tt.in}
+			response, err := testEnv.client.UpdateCompressedVolume(testEnv.ctx, request)
+
+			if !proto.Equal(response, tt.out) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_ListCompressedVolumes(t *testing.T) {
+	tests := map[string]struct {
+		size    int32
+		token   string
+		out     []*bridgepb.CompressedVolume
+		errCode codes.Code
+		errMsg  string
+	}{
+		"valid request": {
+			out:     []*bridgepb.CompressedVolume{&compressedVolume},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"negative page size": {
+			size:    -10,
+			out:     nil,
+			errCode: codes.InvalidArgument,
+			errMsg:  "negative PageSize is not allowed",
+		},
+		"unknown page token": {
+			token:   "unknown-pagination-token",
+			out:     nil,
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find pagination token %s", "unknown-pagination-token"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment([]string{})
+			defer testEnv.Close()
+			addTestCompressedVolume(testEnv)
+
+			request := &bridgepb.ListCompressedVolumesRequest{PageSize: tt.size, PageToken: tt.token}
+			response, err := testEnv.client.ListCompressedVolumes(testEnv.ctx, request)
+
+			if !server.EqualProtoSlices(response.GetCompressedVolumes(), tt.out) {
+				t.Error("response: expected", tt.out, "received", response.GetCompressedVolumes())
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_StatsCompressedVolume(t *testing.T) {
+	tests := map[string]struct {
+		in      string
+		out     *bridgepb.StatsCompressedVolumeResponse
+		spdk    []string
+		errCode codes.Code
+		errMsg  string
+	}{
+		"unknown key": {
+			in:      server.ResourceIDToVolumeName("unknown-id"),
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %v", server.ResourceIDToVolumeName("unknown-id")),
+		},
+		"valid request with invalid SPDK response": {
+			in:      compressedVolumeName,
+			out:     nil,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":{"tick_rate":0,"ticks":0,"bdevs":[]}}`},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("expecting exactly 1 result, got %v", 0),
+		},
+		"valid request with error code from SPDK response": {
+			in:      compressedVolumeName,
+			out:     nil,
+			spdk:    []string{`{"id":%d,"error":{"code":1,"message":"myopierr"}}`},
+			errCode: codes.Unknown,
+			errMsg:  fmt.Sprintf("bdev_get_iostat: %v", "json response error: myopierr"),
+		},
+		"nothing written": {
+			in: compressedVolumeName,
+			out: &bridgepb.StatsCompressedVolumeResponse{
+				Stats:            &pb.VolumeStats{ReadBytesCount: 4096, ReadOpsCount: 1},
+				CompressionRatio: 0,
+			},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":{"tick_rate":2490000000,"ticks":1,"bdevs":[{"name":"COMP_volume-test","bytes_read":4096,"num_read_ops":1}]}}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":{"tick_rate":2490000000,"ticks":1,"bdevs":[{"name":"volume-test","bytes_read":1024,"num_read_ops":1}]}}`,
+			},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"valid request with valid SPDK response": {
+			in: compressedVolumeName,
+			out: &bridgepb.StatsCompressedVolumeResponse{
+				Stats:            &pb.VolumeStats{WriteBytesCount: 8192, WriteOpsCount: 2, WriteLatencyTicks: 10},
+				CompressionRatio: 4,
+			},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":{"tick_rate":2490000000,"ticks":1,"bdevs":[{"name":"COMP_volume-test","bytes_written":8192,"num_write_ops":2,"write_latency_ticks":24900}]}}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":{"tick_rate":2490000000,"ticks":1,"bdevs":[{"name":"volume-test","bytes_written":2048,"num_write_ops":2}]}}`,
+			},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+			addTestCompressedVolume(testEnv)
+
+			request := &bridgepb.StatsCompressedVolumeRequest{Name: tt.in}
+			response, err := testEnv.client.StatsCompressedVolume(testEnv.ctx, request)
+
+			if !proto.Equal(response, tt.out) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
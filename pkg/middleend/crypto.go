@@ -0,0 +1,269 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package middleend implements the MiddleEnd APIs (service) of the storage Server
+package middleend
+
+import (
+	"encoding/hex"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AccelModule is an SPDK accel module executing crypto operations
+type AccelModule string
+
+// Accel modules able to execute crypto operations
+const (
+	AccelModuleSoftware      AccelModule = "software"
+	AccelModuleDpdkCryptodev AccelModule = "dpdk_cryptodev"
+	AccelModuleMlx5          AccelModule = "mlx5"
+)
+
+// DPDK crypto drivers of the dpdk_cryptodev accel module
+const (
+	DpdkCryptodevDriverAesniMb = "crypto_aesni_mb"
+	DpdkCryptodevDriverQat     = "crypto_qat"
+	DpdkCryptodevDriverMlx5    = "mlx5_pci"
+)
+
+// TweakMode tells how the AES_XTS tweak is derived from the block address
+type TweakMode string
+
+// Tweak modes of AES_XTS keys
+const (
+	TweakModeSimpleLba         TweakMode = "SIMPLE_LBA"
+	TweakModeJoinNegLbaWithLba TweakMode = "JOIN_NEG_LBA_WITH_LBA"
+	TweakModeIncr512FullLba    TweakMode = "INCR_512_FULL_LBA"
+	TweakModeIncr512UpperLba   TweakMode = "INCR_512_UPPER_LBA"
+)
+
+// CryptoOptions select how crypto operations of encrypted volumes are executed
+type CryptoOptions struct {
+	// Module executes encrypt and decrypt operations. The module assigned
+	// in SPDK is kept if empty
+	Module AccelModule
+	// Driver is the DPDK crypto driver used by the dpdk_cryptodev module.
+	// The SPDK default is kept if empty
+	Driver string
+	// TweakMode applies to AES_XTS keys created afterwards. SPDK uses
+	// SIMPLE_LBA if empty
+	TweakMode TweakMode
+}
+
+// Validate checks that the options can be applied together
+func (o *CryptoOptions) Validate() error {
+	switch o.Module {
+	case "", AccelModuleSoftware, AccelModuleDpdkCryptodev, AccelModuleMlx5:
+	default:
+		return fmt.Errorf("not supported accel module: %v", o.Module)
+	}
+	switch o.Driver {
+	case "":
+	case DpdkCryptodevDriverAesniMb, DpdkCryptodevDriverQat, DpdkCryptodevDriverMlx5:
+		if o.Module != AccelModuleDpdkCryptodev {
+			return fmt.Errorf("driver %v requires %v accel module", o.Driver, AccelModuleDpdkCryptodev)
+		}
+	default:
+		return fmt.Errorf("not supported %v driver: %v", AccelModuleDpdkCryptodev, o.Driver)
+	}
+	switch o.TweakMode {
+	case "", TweakModeSimpleLba:
+	case TweakModeJoinNegLbaWithLba, TweakModeIncr512FullLba, TweakModeIncr512UpperLba:
+		if o.Module != AccelModuleMlx5 {
+			return fmt.Errorf("tweak mode %v requires %v accel module", o.TweakMode, AccelModuleMlx5)
+		}
+	default:
+		return fmt.Errorf("not supported tweak mode: %v", o.TweakMode)
+	}
+	return nil
+}
+
+// supports tells if cipher can be executed with the options. Any cipher is
+// accepted if no module is selected, as the module assigned in SPDK is not
+// known
+func (o *CryptoOptions) supports(cipher pb.EncryptionType) bool {
+	if cipher != pb.EncryptionType_ENCRYPTION_TYPE_AES_CBC_128 {
+		return true
+	}
+	switch o.Module {
+	case "":
+		return true
+	case AccelModuleDpdkCryptodev:
+		return o.Driver != DpdkCryptodevDriverMlx5
+	default:
+		return false
+	}
+}
+
+// cipherSpec describes how an EncryptionType is passed to SPDK
+type cipherSpec struct {
+	// name is the cipher in SPDK
+	name string
+	// keyBits is the expected key size, for AES_XTS both halves together
+	keyBits int
+	// xts tells if the key is split into key and key2 and can be tweaked
+	xts bool
+}
+
+var ciphers = map[pb.EncryptionType]cipherSpec{
+	pb.EncryptionType_ENCRYPTION_TYPE_AES_CBC_128: {name: "AES_CBC", keyBits: 128},
+	pb.EncryptionType_ENCRYPTION_TYPE_AES_XTS_128: {name: "AES_XTS", keyBits: 256, xts: true},
+	pb.EncryptionType_ENCRYPTION_TYPE_AES_XTS_256: {name: "AES_XTS", keyBits: 512, xts: true},
+}
+
+// accelCryptoKeyCreateParams holds the parameters required to create a
+// Crypto Key, including the tweak mode not covered by spdk.AccelCryptoKeyCreateParams
+type accelCryptoKeyCreateParams struct {
+	Cipher    string    `json:"cipher"`
+	Key       string    `json:"key"`
+	Key2      string    `json:"key2,omitempty"`
+	TweakMode TweakMode `json:"tweak_mode,omitempty"`
+	Name      string    `json:"name"`
+}
+
+// accelAssignOpcParams holds the parameters required to assign an
+// operation to an accel module
+type accelAssignOpcParams struct {
+	Opname string      `json:"opname"`
+	Module AccelModule `json:"module"`
+}
+
+// dpdkCryptodevSetDriverParams holds the parameters required to select the
+// driver of the dpdk_cryptodev accel module
+type dpdkCryptodevSetDriverParams struct {
+	DriverName string `json:"driver_name"`
+}
+
+// rpcGetMethodsParams holds the parameters required to list SPDK RPC methods
+type rpcGetMethodsParams struct {
+	Current bool `json:"current"`
+}
+
+// ConfigureCrypto selects the accel module executing crypto operations and
+// the tweak mode of new keys. SPDK only allows to assign accel modules
+// before its subsystems are initialized, i.e. when started with
+// --wait-for-rpc, so the subsystems are initialized once the module is
+// assigned. If SPDK already initialized them, FailedPrecondition is returned
+func (s *Server) ConfigureCrypto(options CryptoOptions) error {
+	log.Printf("ConfigureCrypto: Received from client: %v", options)
+	if err := options.Validate(); err != nil {
+		log.Printf("error: %v", err)
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if options.Module != "" {
+		if err := s.verifyWaitingForInit(); err != nil {
+			return err
+		}
+	}
+	switch options.Module {
+	case AccelModuleDpdkCryptodev:
+		if err := s.callAccelRPC("dpdk_cryptodev_scan_accel_module", struct{}{}); err != nil {
+			return err
+		}
+		if options.Driver != "" {
+			params := dpdkCryptodevSetDriverParams{DriverName: options.Driver}
+			if err := s.callAccelRPC("dpdk_cryptodev_set_driver", &params); err != nil {
+				return err
+			}
+		}
+	case AccelModuleMlx5:
+		if err := s.callAccelRPC("mlx5_scan_accel_module", struct{}{}); err != nil {
+			return err
+		}
+	}
+	if options.Module != "" {
+		for _, opname := range []string{"encrypt", "decrypt"} {
+			params := accelAssignOpcParams{Opname: opname, Module: options.Module}
+			if err := s.callAccelRPC("accel_assign_opc", &params); err != nil {
+				return err
+			}
+		}
+		if err := s.callAccelRPC("framework_start_init", struct{}{}); err != nil {
+			return err
+		}
+	}
+	s.crypto = options
+	return nil
+}
+
+// verifyWaitingForInit checks that SPDK waits for framework_start_init,
+// which is the only state where accel modules can be assigned
+func (s *Server) verifyWaitingForInit() error {
+	params := rpcGetMethodsParams{Current: true}
+	var result []string
+	err := s.rpc.Call("rpc_get_methods", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	for _, method := range result {
+		if method == "framework_start_init" {
+			return nil
+		}
+	}
+	msg := "SPDK subsystems are already initialized, accel modules can only be assigned when SPDK is started with --wait-for-rpc"
+	log.Print(msg)
+	return status.Error(codes.FailedPrecondition, msg)
+}
+
+func (s *Server) callAccelRPC(method string, params any) error {
+	var result bool
+	err := s.rpc.Call(method, params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not configure crypto: %s", method)
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+	return nil
+}
+
+func (s *Server) verifyEncryptedVolume(volume *pb.EncryptedVolume) error {
+	spec, ok := ciphers[volume.Cipher]
+	if !ok {
+		return fmt.Errorf("only AES_XTS_256, AES_XTS_128 and AES_CBC_128 are supported")
+	}
+	if !s.crypto.supports(volume.Cipher) {
+		return fmt.Errorf("%v is not supported by %v accel module",
+			strings.TrimPrefix(volume.Cipher.String(), "ENCRYPTION_TYPE_"), s.crypto.Module)
+	}
+
+	keyLengthInBits := len(volume.Key) * 8
+	if keyLengthInBits != spec.keyBits {
+		return fmt.Errorf("expected key size %vb, provided size %vb",
+			spec.keyBits, keyLengthInBits)
+	}
+
+	return nil
+}
+
+func (s *Server) getAccelCryptoKeyCreateParams(volume *pb.EncryptedVolume) accelCryptoKeyCreateParams {
+	var params accelCryptoKeyCreateParams
+
+	spec := ciphers[volume.Cipher]
+	params.Cipher = spec.name
+	if spec.xts {
+		keyHalf := len(volume.Key) / 2
+		params.Key = hex.EncodeToString(volume.Key[:keyHalf])
+		params.Key2 = hex.EncodeToString(volume.Key[keyHalf:])
+		params.TweakMode = s.crypto.TweakMode
+	} else {
+		params.Key = hex.EncodeToString(volume.Key)
+	}
+	params.Name = path.Base(volume.Name)
+
+	return params
+}
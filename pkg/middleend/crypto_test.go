@@ -0,0 +1,290 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package middleend implements the MiddleEnd APIs (service) of the storage Server
+package middleend
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+)
+
+func TestMiddleEnd_ConfigureCrypto(t *testing.T) {
+	tests := map[string]struct {
+		in      CryptoOptions
+		spdk    []string
+		errCode codes.Code
+		errMsg  string
+	}{
+		"no module": {
+			in:      CryptoOptions{},
+			spdk:    []string{},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"software module": {
+			in: CryptoOptions{Module: AccelModuleSoftware},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":["rpc_get_methods","framework_start_init","accel_assign_opc"]}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"dpdk_cryptodev module with driver": {
+			in: CryptoOptions{Module: AccelModuleDpdkCryptodev, Driver: DpdkCryptodevDriverQat},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":["rpc_get_methods","framework_start_init","accel_assign_opc"]}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"mlx5 module with tweak mode": {
+			in: CryptoOptions{Module: AccelModuleMlx5, TweakMode: TweakModeIncr512FullLba},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":["rpc_get_methods","framework_start_init","accel_assign_opc"]}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"driver fails": {
+			in: CryptoOptions{Module: AccelModuleDpdkCryptodev, Driver: DpdkCryptodevDriverAesniMb},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":["rpc_get_methods","framework_start_init","accel_assign_opc"]}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":false}`,
+			},
+			errCode: codes.InvalidArgument,
+			errMsg:  "Could not configure crypto: dpdk_cryptodev_set_driver",
+		},
+		"assign fails": {
+			in: CryptoOptions{Module: AccelModuleSoftware},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":["rpc_get_methods","framework_start_init","accel_assign_opc"]}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":false}`,
+			},
+			errCode: codes.InvalidArgument,
+			errMsg:  "Could not configure crypto: accel_assign_opc",
+		},
+		"init fails": {
+			in: CryptoOptions{Module: AccelModuleSoftware},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":["rpc_get_methods","framework_start_init","accel_assign_opc"]}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":false}`,
+			},
+			errCode: codes.InvalidArgument,
+			errMsg:  "Could not configure crypto: framework_start_init",
+		},
+		"subsystems already initialized": {
+			in:      CryptoOptions{Module: AccelModuleSoftware},
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":["rpc_get_methods","bdev_get_bdevs","accel_assign_opc"]}`},
+			errCode: codes.FailedPrecondition,
+			errMsg:  "SPDK subsystems are already initialized, accel modules can only be assigned when SPDK is started with --wait-for-rpc",
+		},
+		"unknown module": {
+			in:      CryptoOptions{Module: "isal"},
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  "not supported accel module: isal",
+		},
+		"unknown driver": {
+			in:      CryptoOptions{Module: AccelModuleDpdkCryptodev, Driver: "crypto_null"},
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  "not supported dpdk_cryptodev driver: crypto_null",
+		},
+		"driver without dpdk_cryptodev module": {
+			in:      CryptoOptions{Module: AccelModuleSoftware, Driver: DpdkCryptodevDriverQat},
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  "driver crypto_qat requires dpdk_cryptodev accel module",
+		},
+		"tweak mode without mlx5 module": {
+			in:      CryptoOptions{Module: AccelModuleSoftware, TweakMode: TweakModeJoinNegLbaWithLba},
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  "tweak mode JOIN_NEG_LBA_WITH_LBA requires mlx5 accel module",
+		},
+		"unknown tweak mode": {
+			in:      CryptoOptions{Module: AccelModuleMlx5, TweakMode: "RANDOM"},
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  "not supported tweak mode: RANDOM",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			// methods are called directly, not over grpc
+			err := testEnv.opiSpdkServer.ConfigureCrypto(tt.in)
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+
+			expected := tt.in
+			if tt.errCode != codes.OK {
+				expected = CryptoOptions{}
+			}
+			if testEnv.opiSpdkServer.crypto != expected {
+				t.Error("options: expected", expected, "received", testEnv.opiSpdkServer.crypto)
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_VerifyEncryptedVolumeCipherSupport(t *testing.T) {
+	cbcVolume := &pb.EncryptedVolume{
+		VolumeNameRef: encryptedVolume.VolumeNameRef,
+		Cipher:        pb.EncryptionType_ENCRYPTION_TYPE_AES_CBC_128,
+		Key:           []byte("0123456789abcdef"),
+	}
+	tests := map[string]struct {
+		options CryptoOptions
+		in      *pb.EncryptedVolume
+		errMsg  string
+	}{
+		"AES_CBC_128 without module": {
+			options: CryptoOptions{},
+			in:      cbcVolume,
+			errMsg:  "",
+		},
+		"AES_CBC_128 with dpdk_cryptodev module": {
+			options: CryptoOptions{Module: AccelModuleDpdkCryptodev, Driver: DpdkCryptodevDriverAesniMb},
+			in:      cbcVolume,
+			errMsg:  "",
+		},
+		"AES_CBC_128 with dpdk_cryptodev module and mlx5_pci driver": {
+			options: CryptoOptions{Module: AccelModuleDpdkCryptodev, Driver: DpdkCryptodevDriverMlx5},
+			in:      cbcVolume,
+			errMsg:  "AES_CBC_128 is not supported by dpdk_cryptodev accel module",
+		},
+		"AES_CBC_128 with software module": {
+			options: CryptoOptions{Module: AccelModuleSoftware},
+			in:      cbcVolume,
+			errMsg:  "AES_CBC_128 is not supported by software accel module",
+		},
+		"AES_CBC_128 with mlx5 module": {
+			options: CryptoOptions{Module: AccelModuleMlx5},
+			in:      cbcVolume,
+			errMsg:  "AES_CBC_128 is not supported by mlx5 accel module",
+		},
+		"AES_XTS_128 with mlx5 module": {
+			options: CryptoOptions{Module: AccelModuleMlx5},
+			in:      &encryptedVolume,
+			errMsg:  "",
+		},
+		"AES_CBC_128 with invalid key size": {
+			options: CryptoOptions{},
+			in: &pb.EncryptedVolume{
+				Cipher: pb.EncryptionType_ENCRYPTION_TYPE_AES_CBC_128,
+				Key:    []byte("0123456789abcdef0123456789abcdef"),
+			},
+			errMsg: "expected key size 128b, provided size 256b",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := &Server{crypto: tt.options}
+
+			err := s.verifyEncryptedVolume(tt.in)
+
+			errMsg := ""
+			if err != nil {
+				errMsg = err.Error()
+			}
+			if errMsg != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", errMsg)
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_GetAccelCryptoKeyCreateParams(t *testing.T) {
+	tests := map[string]struct {
+		options CryptoOptions
+		in      *pb.EncryptedVolume
+		out     accelCryptoKeyCreateParams
+	}{
+		"AES_XTS_128": {
+			options: CryptoOptions{},
+			in: &pb.EncryptedVolume{
+				Name:   encryptedVolumeName,
+				Cipher: pb.EncryptionType_ENCRYPTION_TYPE_AES_XTS_128,
+				Key:    []byte("0123456789abcdef0123456789abcdef"),
+			},
+			out: accelCryptoKeyCreateParams{
+				Cipher: "AES_XTS",
+				Key:    "30313233343536373839616263646566",
+				Key2:   "30313233343536373839616263646566",
+				Name:   encryptedVolumeID,
+			},
+		},
+		"AES_XTS_128 with tweak mode": {
+			options: CryptoOptions{Module: AccelModuleMlx5, TweakMode: TweakModeIncr512UpperLba},
+			in: &pb.EncryptedVolume{
+				Name:   encryptedVolumeName,
+				Cipher: pb.EncryptionType_ENCRYPTION_TYPE_AES_XTS_128,
+				Key:    []byte("0123456789abcdef0123456789abcdef"),
+			},
+			out: accelCryptoKeyCreateParams{
+				Cipher:    "AES_XTS",
+				Key:       "30313233343536373839616263646566",
+				Key2:      "30313233343536373839616263646566",
+				TweakMode: TweakModeIncr512UpperLba,
+				Name:      encryptedVolumeID,
+			},
+		},
+		"AES_CBC_128 ignores tweak mode": {
+			options: CryptoOptions{Module: AccelModuleMlx5, TweakMode: TweakModeIncr512UpperLba},
+			in: &pb.EncryptedVolume{
+				Name:   encryptedVolumeName,
+				Cipher: pb.EncryptionType_ENCRYPTION_TYPE_AES_CBC_128,
+				Key:    []byte("0123456789abcdef"),
+			},
+			out: accelCryptoKeyCreateParams{
+				Cipher: "AES_CBC",
+				Key:    "30313233343536373839616263646566",
+				Name:   encryptedVolumeID,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := &Server{crypto: tt.options}
+
+			params := s.getAccelCryptoKeyCreateParams(tt.in)
+
+			if !reflect.DeepEqual(params, tt.out) {
+				t.Error("params: expected", tt.out, "received", params)
+			}
+		})
+	}
+}
@@ -7,7 +7,8 @@ package middleend
 
 import (
 	"context"
-	"encoding/hex"
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
 	"log"
 	"path"
@@ -16,6 +17,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/opiproject/gospdk/spdk"
 	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/kms"
 	"github.com/opiproject/opi-spdk-bridge/pkg/server"
 
 	"go.einride.tech/aip/fieldbehavior"
@@ -34,7 +37,7 @@ func sortEncryptedVolumes(volumes []*pb.EncryptedVolume) {
 
 // CreateEncryptedVolume creates an encrypted volume
 func (s *Server) CreateEncryptedVolume(_ context.Context, in *pb.CreateEncryptedVolumeRequest) (*pb.EncryptedVolume, error) {
-	log.Printf("CreateEncryptedVolume: Received from client: %v", in)
+	log.Printf("CreateEncryptedVolume: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -67,11 +70,20 @@ func (s *Server) CreateEncryptedVolume(_ context.Context, in *pb.CreateEncrypted
 	volume, ok := s.volumes.encVolumes[in.EncryptedVolume.Name]
 	if ok {
 		log.Printf("Already existing EncryptedVolume with id %v", in.EncryptedVolume.Name)
-		return volume, nil
+		kms.Zero(in.EncryptedVolume.Key)
+		return server.ProtoClone(volume), nil
+	}
+
+	base, err := s.registry.Get(in.EncryptedVolume.VolumeNameRef)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
 	}
 
 	// first create a key
 	params1 := s.getAccelCryptoKeyCreateParams(in.EncryptedVolume)
+	fingerprint := keyFingerprint(in.EncryptedVolume.Key)
+	kms.Zero(in.EncryptedVolume.Key)
 	var result1 spdk.AccelCryptoKeyCreateResult
 	err1 := s.rpc.Call("accel_crypto_key_create", &params1, &result1)
 	if err1 != nil {
@@ -80,18 +92,18 @@ func (s *Server) CreateEncryptedVolume(_ context.Context, in *pb.CreateEncrypted
 	}
 	log.Printf("Received from SPDK: %v", result1)
 	if !result1 {
-		msg := fmt.Sprintf("Could not create Crypto Key: %s", string(in.EncryptedVolume.Key))
+		msg := fmt.Sprintf("Could not create Crypto Key: %s", params1.Name)
 		log.Print(msg)
 		return nil, status.Errorf(codes.InvalidArgument, msg)
 	}
 	// create bdev now
 	params := spdk.BdevCryptoCreateParams{
 		Name:         resourceID,
-		BaseBdevName: in.EncryptedVolume.VolumeNameRef,
+		BaseBdevName: base.BdevName,
 		KeyName:      resourceID,
 	}
 	var result spdk.BdevCryptoCreateResult
-	err := s.rpc.Call("bdev_crypto_create", &params, &result)
+	err = s.rpc.Call("bdev_crypto_create", &params, &result)
 	if err != nil {
 		log.Printf("error: %v", err)
 		return nil, err
@@ -102,15 +114,21 @@ func (s *Server) CreateEncryptedVolume(_ context.Context, in *pb.CreateEncrypted
 		log.Print(msg)
 		return nil, status.Errorf(codes.InvalidArgument, msg)
 	}
-	response := server.ProtoClone(in.EncryptedVolume)
+	response := withoutKey(in.EncryptedVolume)
 	s.volumes.encVolumes[in.EncryptedVolume.Name] = response
-	log.Printf("CreateEncryptedVolume: Sending to client: %v", response)
-	return response, nil
+	s.volumes.encKeys[in.EncryptedVolume.Name] = &bridgepb.EncryptedVolumeKey{
+		KeyName:     path.Base(in.EncryptedVolume.Name),
+		Fingerprint: fingerprint,
+	}
+	s.acquireVolume(base.Name, response.Name)
+	s.registerEncryptedVolume(response.Name, base)
+	log.Printf("CreateEncryptedVolume: Sending to client: %v", server.Redact(response))
+	return server.ProtoClone(response), nil
 }
 
 // DeleteEncryptedVolume deletes an encrypted volume
 func (s *Server) DeleteEncryptedVolume(_ context.Context, in *pb.DeleteEncryptedVolumeRequest) (*emptypb.Empty, error) {
-	log.Printf("DeleteEncryptedVolume: Received from client: %v", in)
+	log.Printf("DeleteEncryptedVolume: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -131,6 +149,10 @@ func (s *Server) DeleteEncryptedVolume(_ context.Context, in *pb.DeleteEncrypted
 		log.Printf("error: %v", err)
 		return nil, err
 	}
+	if err := s.registry.CheckUnused(volume.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
 	resourceID := path.Base(volume.Name)
 	bdevCryptoDeleteParams := spdk.BdevCryptoDeleteParams{
 		Name: resourceID,
@@ -148,8 +170,12 @@ func (s *Server) DeleteEncryptedVolume(_ context.Context, in *pb.DeleteEncrypted
 		return nil, status.Errorf(codes.InvalidArgument, msg)
 	}
 
+	keyName := resourceID
+	if key, ok := s.volumes.encKeys[volume.Name]; ok {
+		keyName = key.KeyName
+	}
 	keyDestroyParams := spdk.AccelCryptoKeyDestroyParams{
-		KeyName: resourceID,
+		KeyName: keyName,
 	}
 	var keyDestroyResult spdk.AccelCryptoKeyDestroyResult
 	err = s.rpc.Call("accel_crypto_key_destroy", &keyDestroyParams, &keyDestroyResult)
@@ -165,12 +191,25 @@ func (s *Server) DeleteEncryptedVolume(_ context.Context, in *pb.DeleteEncrypted
 	}
 
 	delete(s.volumes.encVolumes, volume.Name)
+	delete(s.volumes.encKeys, volume.Name)
+	delete(s.volumes.encRotations, volume.Name)
+	s.registry.Release(volume.VolumeNameRef, volume.Name)
+	if err := s.registry.Unregister(volume.Name); err != nil {
+		log.Printf("error: %v", err)
+	}
 	return &emptypb.Empty{}, nil
 }
 
-// UpdateEncryptedVolume updates an encrypted volume
-func (s *Server) UpdateEncryptedVolume(_ context.Context, in *pb.UpdateEncryptedVolumeRequest) (*pb.EncryptedVolume, error) {
-	log.Printf("UpdateEncryptedVolume: Received from client: %v", in)
+// UpdateEncryptedVolume updates an encrypted volume. Data is not
+// re-encrypted with the new key, so volumes data was written to are rejected
+func (s *Server) UpdateEncryptedVolume(ctx context.Context, in *pb.UpdateEncryptedVolumeRequest) (*pb.EncryptedVolume, error) {
+	log.Printf("UpdateEncryptedVolume: Received from client: %v", server.Redact(in))
+	return s.updateEncryptedVolume(ctx, in, false)
+}
+
+// updateEncryptedVolume rekeys an encrypted volume, discarding data written
+// with the previous key if discardWrittenData is set
+func (s *Server) updateEncryptedVolume(ctx context.Context, in *pb.UpdateEncryptedVolumeRequest, discardWrittenData bool) (*pb.EncryptedVolume, error) {
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -191,78 +230,27 @@ func (s *Server) UpdateEncryptedVolume(_ context.Context, in *pb.UpdateEncrypted
 		log.Printf("error: %v", err)
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	resourceID := path.Base(in.EncryptedVolume.Name)
-	// first delete old bdev
-	params1 := spdk.BdevCryptoDeleteParams{
-		Name: resourceID,
-	}
-	var result1 spdk.BdevCryptoDeleteResult
-	err1 := s.rpc.Call("bdev_crypto_delete", &params1, &result1)
-	if err1 != nil {
-		log.Printf("error: %v", err1)
-		return nil, err1
-	}
-	log.Printf("Received from SPDK: %v", result1)
-	if !result1 {
-		msg := fmt.Sprintf("Could not delete Crypto: %s", params1.Name)
-		log.Print(msg)
-		return nil, status.Errorf(codes.InvalidArgument, msg)
-	}
-	// now delete a key
-	params0 := spdk.AccelCryptoKeyDestroyParams{
-		KeyName: resourceID,
-	}
-	var result0 spdk.AccelCryptoKeyDestroyResult
-	err0 := s.rpc.Call("accel_crypto_key_destroy", &params0, &result0)
-	if err0 != nil {
-		log.Printf("error: %v", err0)
-		return nil, err0
-	}
-	log.Printf("Received from SPDK: %v", result0)
-	if !result0 {
-		msg := fmt.Sprintf("Could not destroy Crypto Key: %v", params0.KeyName)
-		log.Print(msg)
-		return nil, status.Errorf(codes.InvalidArgument, msg)
-	}
-	params2 := s.getAccelCryptoKeyCreateParams(in.EncryptedVolume)
-	var result2 spdk.AccelCryptoKeyCreateResult
-	err2 := s.rpc.Call("accel_crypto_key_create", &params2, &result2)
-	if err2 != nil {
-		log.Printf("error: %v", err2)
-		return nil, err2
-	}
-	log.Printf("Received from SPDK: %v", result2)
-	if !result2 {
-		msg := fmt.Sprintf("Could not create Crypto Key: %s", string(in.EncryptedVolume.Key))
-		log.Print(msg)
-		return nil, status.Errorf(codes.InvalidArgument, msg)
-	}
-	// create bdev now
-	params3 := spdk.BdevCryptoCreateParams{
-		Name:         resourceID,
-		BaseBdevName: in.EncryptedVolume.VolumeNameRef,
-		KeyName:      resourceID,
-	}
-	var result3 spdk.BdevCryptoCreateResult
-	err3 := s.rpc.Call("bdev_crypto_create", &params3, &result3)
-	if err3 != nil {
-		log.Printf("error: %v", err3)
-		return nil, err3
+	current, ok := s.volumes.encVolumes[in.EncryptedVolume.Name]
+	if !ok {
+		kms.Zero(in.EncryptedVolume.Key)
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.EncryptedVolume.Name)
+		log.Printf("error: %v", err)
+		return nil, err
 	}
-	log.Printf("Received from SPDK: %v", result3)
-	if result3 == "" {
-		msg := fmt.Sprintf("Could not create Crypto Dev: %s", params3.Name)
+	// keys are not retained, so a rekey needs a new key to be supplied
+	fingerprint := keyFingerprint(in.EncryptedVolume.Key)
+	if key, ok := s.volumes.encKeys[in.EncryptedVolume.Name]; ok && key.Fingerprint == fingerprint {
+		kms.Zero(in.EncryptedVolume.Key)
+		msg := fmt.Sprintf("key of EncryptedVolume %s is already in use, a new key must be supplied", in.EncryptedVolume.Name)
 		log.Print(msg)
 		return nil, status.Errorf(codes.InvalidArgument, msg)
 	}
-	// return result
-	response := server.ProtoClone(in.EncryptedVolume)
-	return response, nil
+	return s.rotateEncryptedVolumeKey(ctx, in.EncryptedVolume, current, fingerprint, discardWrittenData)
 }
 
 // ListEncryptedVolumes lists encrypted volumes
 func (s *Server) ListEncryptedVolumes(_ context.Context, in *pb.ListEncryptedVolumesRequest) (*pb.ListEncryptedVolumesResponse, error) {
-	log.Printf("ListEncryptedVolumes: Received from client: %v", in)
+	log.Printf("ListEncryptedVolumes: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -300,7 +288,7 @@ func (s *Server) ListEncryptedVolumes(_ context.Context, in *pb.ListEncryptedVol
 
 // GetEncryptedVolume gets an encrypted volume
 func (s *Server) GetEncryptedVolume(_ context.Context, in *pb.GetEncryptedVolumeRequest) (*pb.EncryptedVolume, error) {
-	log.Printf("GetEncryptedVolume: Received from client: %v", in)
+	log.Printf("GetEncryptedVolume: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -337,9 +325,11 @@ func (s *Server) GetEncryptedVolume(_ context.Context, in *pb.GetEncryptedVolume
 	return &pb.EncryptedVolume{Name: result[0].Name}, nil
 }
 
-// StatsEncryptedVolume gets an encrypted volume stats
+// StatsEncryptedVolume gets an encrypted volume stats. Counters saturate at
+// math.MaxInt32, so StatsVolume is to be used for volumes which moved more
+// than 2 GiB. Latencies are in microseconds, see server.VolumeStats.ToProto
 func (s *Server) StatsEncryptedVolume(_ context.Context, in *pb.StatsEncryptedVolumeRequest) (*pb.StatsEncryptedVolumeResponse, error) {
-	log.Printf("StatsEncryptedVolume: Received from client: %v", in)
+	log.Printf("StatsEncryptedVolume: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -351,70 +341,51 @@ func (s *Server) StatsEncryptedVolume(_ context.Context, in *pb.StatsEncryptedVo
 		return nil, err
 	}
 	// fetch object from the database
-	volume, ok := s.volumes.encVolumes[in.Name]
-	if !ok {
+	if _, ok := s.volumes.encVolumes[in.Name]; !ok {
 		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
 		log.Printf("error: %v", err)
 		return nil, err
 	}
-	resourceID := path.Base(volume.Name)
-	params := spdk.BdevGetIostatParams{
-		Name: resourceID,
-	}
-	// See https://mholt.github.io/json-to-go/
-	var result spdk.BdevGetIostatResult
-	err := s.rpc.Call("bdev_get_iostat", &params, &result)
+	stats, err := s.volumeStats(in.Name)
 	if err != nil {
-		log.Printf("error: %v", err)
 		return nil, err
 	}
-	log.Printf("Received from SPDK: %v", result)
-	if len(result.Bdevs) != 1 {
-		msg := fmt.Sprintf("expecting exactly 1 result, got %d", len(result.Bdevs))
-		log.Print(msg)
-		return nil, status.Errorf(codes.InvalidArgument, msg)
-	}
-	return &pb.StatsEncryptedVolumeResponse{Stats: &pb.VolumeStats{
-		ReadBytesCount:    int32(result.Bdevs[0].BytesRead),
-		ReadOpsCount:      int32(result.Bdevs[0].NumReadOps),
-		WriteBytesCount:   int32(result.Bdevs[0].BytesWritten),
-		WriteOpsCount:     int32(result.Bdevs[0].NumWriteOps),
-		UnmapBytesCount:   int32(result.Bdevs[0].BytesUnmapped),
-		UnmapOpsCount:     int32(result.Bdevs[0].NumUnmapOps),
-		ReadLatencyTicks:  int32(result.Bdevs[0].ReadLatencyTicks),
-		WriteLatencyTicks: int32(result.Bdevs[0].WriteLatencyTicks),
-		UnmapLatencyTicks: int32(result.Bdevs[0].UnmapLatencyTicks),
-	}}, nil
+	return &pb.StatsEncryptedVolumeResponse{Stats: stats.ToProto()}, nil
 }
 
-func (s *Server) verifyEncryptedVolume(volume *pb.EncryptedVolume) error {
-	keyLengthInBits := len(volume.Key) * 8
-	expectedKeyLengthInBits := 0
-	switch {
-	case volume.Cipher == pb.EncryptionType_ENCRYPTION_TYPE_AES_XTS_256:
-		expectedKeyLengthInBits = 512
-	case volume.Cipher == pb.EncryptionType_ENCRYPTION_TYPE_AES_XTS_128:
-		expectedKeyLengthInBits = 256
-	default:
-		return fmt.Errorf("only AES_XTS_256 and AES_XTS_128 are supported")
+// GetEncryptedVolumeKey returns a reference to the key of an encrypted
+// volume. Keys are not retained by the bridge, so the key itself cannot be
+// retrieved
+func (s *Server) GetEncryptedVolumeKey(_ context.Context, in *bridgepb.GetEncryptedVolumeKeyRequest) (*bridgepb.EncryptedVolumeKey, error) {
+	log.Printf("GetEncryptedVolumeKey: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
 	}
-
-	if keyLengthInBits != expectedKeyLengthInBits {
-		return fmt.Errorf("expected key size %vb, provided size %vb",
-			expectedKeyLengthInBits, keyLengthInBits)
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
 	}
-
-	return nil
+	key, ok := s.volumes.encKeys[in.Name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	return server.ProtoClone(key), nil
 }
 
-func (s *Server) getAccelCryptoKeyCreateParams(volume *pb.EncryptedVolume) spdk.AccelCryptoKeyCreateParams {
-	var params spdk.AccelCryptoKeyCreateParams
-
-	params.Cipher = "AES_XTS"
-	keyHalf := len(volume.Key) / 2
-	params.Key = hex.EncodeToString(volume.Key[:keyHalf])
-	params.Key2 = hex.EncodeToString(volume.Key[keyHalf:])
-	params.Name = path.Base(volume.Name)
+// keyFingerprint identifies a key by its SHA-256 hash
+func keyFingerprint(key []byte) string {
+	sum := sha256.Sum256(key)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:])
+}
 
-	return params
+// withoutKey returns a copy of volume without key material
+func withoutKey(volume *pb.EncryptedVolume) *pb.EncryptedVolume {
+	clone := server.ProtoClone(volume)
+	clone.Key = nil
+	return clone
 }
@@ -6,12 +6,19 @@
 package middleend
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
+	"log"
+	"os"
 	"reflect"
+	"strings"
 	"testing"
 
 	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
 	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
@@ -36,7 +43,7 @@ func TestMiddleEnd_CreateEncryptedVolume(t *testing.T) {
 			nil,
 			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
 			codes.InvalidArgument,
-			fmt.Sprintf("Could not create Crypto Key: %v", "0123456789abcdef0123456789abcdef"),
+			fmt.Sprintf("Could not create Crypto Key: %v", encryptedVolumeID),
 			false,
 		},
 		"valid request with invalid marshal SPDK response": {
@@ -130,7 +137,7 @@ func TestMiddleEnd_CreateEncryptedVolume(t *testing.T) {
 			nil,
 			[]string{},
 			codes.InvalidArgument,
-			"only AES_XTS_256 and AES_XTS_128 are supported",
+			"only AES_XTS_256, AES_XTS_128 and AES_CBC_128 are supported",
 			false,
 		},
 		"valid request with valid SPDK response and AES_XTS_256 cipher": {
@@ -150,17 +157,21 @@ func TestMiddleEnd_CreateEncryptedVolume(t *testing.T) {
 			"",
 			false,
 		},
-		"invalid request with AES_CBC_128 cipher": {
+		"valid request with valid SPDK response and AES_CBC_128 cipher": {
 			encryptedVolumeID,
 			&pb.EncryptedVolume{
 				VolumeNameRef: encryptedVolume.VolumeNameRef,
 				Cipher:        pb.EncryptionType_ENCRYPTION_TYPE_AES_CBC_128,
 				Key:           []byte("0123456789abcdef"),
 			},
-			nil,
-			[]string{},
-			codes.InvalidArgument,
-			"only AES_XTS_256 and AES_XTS_128 are supported",
+			&pb.EncryptedVolume{
+				VolumeNameRef: encryptedVolume.VolumeNameRef,
+				Cipher:        pb.EncryptionType_ENCRYPTION_TYPE_AES_CBC_128,
+				Key:           []byte("0123456789abcdef"),
+			},
+			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":"my_crypto_bdev"}`},
+			codes.OK,
+			"",
 			false,
 		},
 		"invalid request with AES_CBC_192 cipher": {
@@ -173,7 +184,7 @@ func TestMiddleEnd_CreateEncryptedVolume(t *testing.T) {
 			nil,
 			[]string{},
 			codes.InvalidArgument,
-			"only AES_XTS_256 and AES_XTS_128 are supported",
+			"only AES_XTS_256, AES_XTS_128 and AES_CBC_128 are supported",
 			false,
 		},
 		"invalid request with AES_CBC_256 cipher": {
@@ -186,7 +197,7 @@ func TestMiddleEnd_CreateEncryptedVolume(t *testing.T) {
 			nil,
 			[]string{},
 			codes.InvalidArgument,
-			"only AES_XTS_256 and AES_XTS_128 are supported",
+			"only AES_XTS_256, AES_XTS_128 and AES_CBC_128 are supported",
 			false,
 		},
 		"invalid request with unspecified cipher": {
@@ -228,6 +239,19 @@ func TestMiddleEnd_CreateEncryptedVolume(t *testing.T) {
 			fmt.Sprintf("expected key size %vb, provided size %vb", 512, (4 * 8)),
 			false,
 		},
+		"unknown underlying volume": {
+			encryptedVolumeID,
+			&pb.EncryptedVolume{
+				VolumeNameRef: "unknown-volume",
+				Key:           encryptedVolume.Key,
+				Cipher:        encryptedVolume.Cipher,
+			},
+			nil,
+			[]string{},
+			codes.NotFound,
+			"unable to find volume unknown-volume",
+			false,
+		},
 		"already exists": {
 			encryptedVolumeID,
 			&encryptedVolume,
@@ -279,10 +303,12 @@ func TestMiddleEnd_CreateEncryptedVolume(t *testing.T) {
 			if tt.exist {
 				testEnv.opiSpdkServer.volumes.encVolumes[encryptedVolumeName] = server.ProtoClone(&encryptedVolume)
 				testEnv.opiSpdkServer.volumes.encVolumes[encryptedVolumeName].Name = encryptedVolumeName
+				testEnv.opiSpdkServer.volumes.encVolumes[encryptedVolumeName].Key = nil
 			}
 			if tt.out != nil {
 				tt.out = server.ProtoClone(tt.out)
 				tt.out.Name = encryptedVolumeName
+				tt.out.Key = nil
 			}
 
 			request := &pb.CreateEncryptedVolumeRequest{EncryptedVolume: tt.in, EncryptedVolumeId: tt.id}
@@ -330,157 +356,26 @@ func TestMiddleEnd_UpdateEncryptedVolume(t *testing.T) {
 		// 	fmt.Sprintf("invalid field path: %s", "'*' must not be used with other paths"),
 		//  false,
 		// },
-		"bdev delete fails": {
-			nil,
-			encryptedVolumeWithName,
-			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
-			codes.InvalidArgument,
-			fmt.Sprintf("Could not delete Crypto: %s", encryptedVolumeID),
-			false,
-		},
-		"bdev delete empty": {
-			nil,
-			encryptedVolumeWithName,
-			nil,
-			[]string{""},
-			codes.Unknown,
-			fmt.Sprintf("bdev_crypto_delete: %v", "EOF"),
-			false,
-		},
-		"bdev delete ID mismatch": {
-			nil,
-			encryptedVolumeWithName,
-			nil,
-			[]string{`{"id":0,"error":{"code":0,"message":""},"result":false}`},
-			codes.Unknown,
-			fmt.Sprintf("bdev_crypto_delete: %v", "json response ID mismatch"),
-			false,
-		},
-		"bdev delete exception": {
-			nil,
-			encryptedVolumeWithName,
-			nil,
-			[]string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":false}`},
-			codes.Unknown,
-			fmt.Sprintf("bdev_crypto_delete: %v", "json response error: myopierr"),
-			false,
-		},
-		"bdev delete ok ; key delete fails": {
+		"unknown volume": {
 			nil,
 			encryptedVolumeWithName,
 			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":false}`},
-			codes.InvalidArgument,
-			fmt.Sprintf("Could not destroy Crypto Key: %v", encryptedVolumeID),
-			false,
-		},
-		"bdev delete ok ; key delete empty": {
-			nil,
-			encryptedVolumeWithName,
-			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, ""},
-			codes.Unknown,
-			fmt.Sprintf("accel_crypto_key_destroy: %v", "EOF"),
-			false,
-		},
-		"bdev delete ok ; key delete ID mismatch": {
-			nil,
-			encryptedVolumeWithName,
-			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":0,"error":{"code":0,"message":""},"result":false}`},
-			codes.Unknown,
-			fmt.Sprintf("accel_crypto_key_destroy: %v", "json response ID mismatch"),
-			false,
-		},
-		"bdev delete ok ; key delete exception": {
-			nil,
-			encryptedVolumeWithName,
-			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":1,"message":"myopierr"},"result":false}`},
-			codes.Unknown,
-			fmt.Sprintf("accel_crypto_key_destroy: %v", "json response error: myopierr"),
-			false,
-		},
-		"bdev delete ok ; key delete ok ; key create fails": {
-			nil,
-			encryptedVolumeWithName,
-			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":false}`},
-			codes.InvalidArgument,
-			fmt.Sprintf("Could not create Crypto Key: %v", "0123456789abcdef0123456789abcdef"),
-			false,
-		},
-		"bdev delete ok ; key delete ok ; key create empty": {
-			nil,
-			encryptedVolumeWithName,
-			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":true}`, ""},
-			codes.Unknown,
-			fmt.Sprintf("accel_crypto_key_create: %v", "EOF"),
-			false,
-		},
-		"bdev delete ok ; key delete ok ; key create ID mismatch": {
-			nil,
-			encryptedVolumeWithName,
-			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":0,"error":{"code":0,"message":""},"result":false}`},
-			codes.Unknown,
-			fmt.Sprintf("accel_crypto_key_create: %v", "json response ID mismatch"),
-			false,
-		},
-		"bdev delete ok ; key delete ok ; key create exception": {
-			nil,
-			encryptedVolumeWithName,
-			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":1,"message":"myopierr"},"result":false}`},
-			codes.Unknown,
-			fmt.Sprintf("accel_crypto_key_create: %v", "json response error: myopierr"),
-			false,
-		},
-		"bdev delete ok ; key delete ok ; key create ok ; bdev create fails": {
-			nil,
-			encryptedVolumeWithName,
-			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":""}`},
-			codes.InvalidArgument,
-			fmt.Sprintf("Could not create Crypto Dev: %v", encryptedVolumeID),
-			false,
-		},
-		"bdev delete ok ; key delete ok ; key create ok ; bdev create empty": {
-			nil,
-			encryptedVolumeWithName,
-			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":true}`, ""},
-			codes.Unknown,
-			fmt.Sprintf("bdev_crypto_create: %v", "EOF"),
+			[]string{},
+			codes.NotFound,
+			fmt.Sprintf("unable to find key %s", encryptedVolumeName),
 			false,
 		},
-		"bdev delete ok ; key delete ok ; key create ok ; bdev create ID mismatch": {
+		"missing key": {
 			nil,
-			encryptedVolumeWithName,
+			&pb.EncryptedVolume{
+				Name:          encryptedVolumeName,
+				VolumeNameRef: encryptedVolume.VolumeNameRef,
+				Cipher:        encryptedVolume.Cipher,
+			},
 			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":0,"error":{"code":0,"message":""},"result":""}`},
+			[]string{},
 			codes.Unknown,
-			fmt.Sprintf("bdev_crypto_create: %v", "json response ID mismatch"),
-			false,
-		},
-		"bdev delete ok ; key delete ok ; key create ok ; bdev create exception": {
-			nil,
-			encryptedVolumeWithName,
-			nil,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":1,"message":"myopierr"},"result":""}`},
-			codes.Unknown,
-			fmt.Sprintf("bdev_crypto_create: %v", "json response error: myopierr"),
-			false,
-		},
-		"use AES_XTS_128 cipher ; bdev delete ok ; key delete ok ; key create ok ; bdev create ok": {
-			nil,
-			encryptedVolumeWithName,
-			encryptedVolumeWithName,
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":"mytest"}`},
-			codes.OK,
-			"",
+			"missing required field: encrypted_volume.key",
 			false,
 		},
 		"use AES_XTS_192 cipher": {
@@ -494,10 +389,10 @@ func TestMiddleEnd_UpdateEncryptedVolume(t *testing.T) {
 			nil,
 			[]string{},
 			codes.InvalidArgument,
-			"only AES_XTS_256 and AES_XTS_128 are supported",
+			"only AES_XTS_256, AES_XTS_128 and AES_CBC_128 are supported",
 			false,
 		},
-		"use AES_XTS_256 cipher ; bdev delete ok ; key delete ok ; key create ok ; bdev create ok": {
+		"use AES_XTS_256 cipher of unknown volume": {
 			nil,
 			&pb.EncryptedVolume{
 				Name:          encryptedVolumeID,
@@ -505,18 +400,13 @@ func TestMiddleEnd_UpdateEncryptedVolume(t *testing.T) {
 				Cipher:        pb.EncryptionType_ENCRYPTION_TYPE_AES_XTS_256,
 				Key:           []byte("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"),
 			},
-			&pb.EncryptedVolume{
-				Name:          encryptedVolumeID,
-				VolumeNameRef: encryptedVolume.VolumeNameRef,
-				Cipher:        pb.EncryptionType_ENCRYPTION_TYPE_AES_XTS_256,
-				Key:           []byte("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"),
-			},
-			[]string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":true}`, `{"id":%d,"error":{"code":0,"message":""},"result":"mytest"}`},
-			codes.OK,
-			"",
+			nil,
+			[]string{},
+			codes.NotFound,
+			fmt.Sprintf("unable to find key %s", encryptedVolumeID),
 			false,
 		},
-		"use AES_CBC_128 cipher": {
+		"use AES_CBC_128 cipher of unknown volume": {
 			nil,
 			&pb.EncryptedVolume{
 				Name:          encryptedVolumeID,
@@ -526,8 +416,8 @@ func TestMiddleEnd_UpdateEncryptedVolume(t *testing.T) {
 			},
 			nil,
 			[]string{},
-			codes.InvalidArgument,
-			"only AES_XTS_256 and AES_XTS_128 are supported",
+			codes.NotFound,
+			fmt.Sprintf("unable to find key %s", encryptedVolumeID),
 			false,
 		},
 		"use AES_CBC_192 cipher": {
@@ -541,7 +431,7 @@ func TestMiddleEnd_UpdateEncryptedVolume(t *testing.T) {
 			nil,
 			[]string{},
 			codes.InvalidArgument,
-			"only AES_XTS_256 and AES_XTS_128 are supported",
+			"only AES_XTS_256, AES_XTS_128 and AES_CBC_128 are supported",
 			false,
 		},
 		"use AES_CBC_256 cipher": {
@@ -555,7 +445,7 @@ func TestMiddleEnd_UpdateEncryptedVolume(t *testing.T) {
 			nil,
 			[]string{},
 			codes.InvalidArgument,
-			"only AES_XTS_256 and AES_XTS_128 are supported",
+			"only AES_XTS_256, AES_XTS_128 and AES_CBC_128 are supported",
 			false,
 		},
 		"use UNSPECIFIED cipher": {
@@ -636,6 +526,11 @@ func TestMiddleEnd_UpdateEncryptedVolume(t *testing.T) {
 			testEnv := createTestEnvironment(tt.spdk)
 			defer testEnv.Close()
 
+			if tt.out != nil {
+				tt.out = server.ProtoClone(tt.out)
+				tt.out.Key = nil
+			}
+
 			request := &pb.UpdateEncryptedVolumeRequest{EncryptedVolume: tt.in, UpdateMask: tt.mask, AllowMissing: tt.missing}
 			response, err := testEnv.client.UpdateEncryptedVolume(testEnv.ctx, request)
 
@@ -995,7 +890,7 @@ func TestMiddleEnd_StatsEncryptedVolume(t *testing.T) {
 				ReadLatencyTicks:  7,
 				WriteLatencyTicks: 8,
 			},
-			[]string{`{"jsonrpc":"2.0","id":%d,"result":{"tick_rate":2490000000,"ticks":18787040917434338,"bdevs":[{"name":"crypto-test","bytes_read":1,"num_read_ops":2,"bytes_written":3,"num_write_ops":4,"bytes_unmapped":0,"num_unmap_ops":0,"read_latency_ticks":7,"write_latency_ticks":8,"unmap_latency_ticks":0}]}}`},
+			[]string{`{"jsonrpc":"2.0","id":%d,"result":{"tick_rate":2490000000,"ticks":18787040917434338,"bdevs":[{"name":"crypto-test","bytes_read":1,"num_read_ops":2,"bytes_written":3,"num_write_ops":4,"bytes_unmapped":0,"num_unmap_ops":0,"read_latency_ticks":17430,"write_latency_ticks":19920,"unmap_latency_ticks":0}]}}`},
 			codes.OK,
 			"",
 		},
@@ -1023,6 +918,7 @@ func TestMiddleEnd_StatsEncryptedVolume(t *testing.T) {
 
 			fname1 := server.ResourceIDToVolumeName(tt.in)
 			testEnv.opiSpdkServer.volumes.encVolumes[encryptedVolumeName] = server.ProtoClone(&encryptedVolume)
+			testEnv.opiSpdkServer.registerEncryptedVolume(encryptedVolumeName, volume.Volume{})
 
 			request := &pb.StatsEncryptedVolumeRequest{Name: fname1}
 			response, err := testEnv.client.StatsEncryptedVolume(testEnv.ctx, request)
@@ -1182,3 +1078,160 @@ func TestMiddleEnd_DeleteEncryptedVolume(t *testing.T) {
 		})
 	}
 }
+
+func TestMiddleEnd_EncryptedVolumeKeyNotLogged(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	encryptedVolumeWithName := server.ProtoClone(&encryptedVolume)
+	encryptedVolumeWithName.Name = encryptedVolumeName
+	keyHalf := len(encryptedVolume.Key) / 2
+	secrets := []string{
+		string(encryptedVolume.Key),
+		hex.EncodeToString(encryptedVolume.Key[:keyHalf]),
+		hex.EncodeToString(encryptedVolume.Key[keyHalf:]),
+	}
+	tests := map[string]struct {
+		spdk []string
+		call func(*testEnv) error
+	}{
+		"create": {
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":"crypto-test"}`,
+			},
+			call: func(env *testEnv) error {
+				_, err := env.client.CreateEncryptedVolume(env.ctx, &pb.CreateEncryptedVolumeRequest{
+					EncryptedVolume: &encryptedVolume, EncryptedVolumeId: encryptedVolumeID})
+				return err
+			},
+		},
+		"create key fails": {
+			spdk: []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			call: func(env *testEnv) error {
+				_, err := env.client.CreateEncryptedVolume(env.ctx, &pb.CreateEncryptedVolumeRequest{
+					EncryptedVolume: &encryptedVolume, EncryptedVolumeId: encryptedVolumeID})
+				return err
+			},
+		},
+		"update": {
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":"crypto-test"}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":{"tick_rate":2490000000,"ticks":1,"bdevs":[{"name":"crypto-test","num_write_ops":0}]}}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":"crypto-test"}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			call: func(env *testEnv) error {
+				previous := server.ProtoClone(&encryptedVolume)
+				previous.Key = []byte("fedcba9876543210fedcba9876543210")
+				_, err := env.client.CreateEncryptedVolume(env.ctx, &pb.CreateEncryptedVolumeRequest{
+					EncryptedVolume: previous, EncryptedVolumeId: encryptedVolumeID})
+				if err != nil {
+					return err
+				}
+				_, err = env.client.UpdateEncryptedVolume(env.ctx, &pb.UpdateEncryptedVolumeRequest{
+					EncryptedVolume: encryptedVolumeWithName})
+				return err
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var logs bytes.Buffer
+			log.SetOutput(server.NewRedactingWriter(&logs))
+			defer log.SetOutput(os.Stderr)
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			err := tt.call(testEnv)
+
+			for _, secret := range secrets {
+				if strings.Contains(logs.String(), secret) {
+					t.Errorf("key material %v found in logs:\n%v", secret, logs.String())
+				}
+				if err != nil && strings.Contains(err.Error(), secret) {
+					t.Errorf("key material %v found in error: %v", secret, err)
+				}
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_EncryptedVolumeKeyNotRetained(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	newKey := []byte("fedcba9876543210fedcba9876543210")
+	testEnv := createTestEnvironment([]string{
+		// create
+		`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+		`{"id":%d,"error":{"code":0,"message":""},"result":"crypto-test"}`,
+		// get
+		`{"jsonrpc":"2.0","id":%d,"result":[{"name":"crypto-test","block_size":512,"num_blocks":131072}]}`,
+		// update with a new key rotates it
+		`{"id":%d,"error":{"code":0,"message":""},"result":{"tick_rate":2490000000,"ticks":1,"bdevs":[{"name":"crypto-test","num_write_ops":0}]}}`,
+		`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+		`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+		`{"id":%d,"error":{"code":0,"message":""},"result":"crypto-test"}`,
+		`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+	})
+	defer testEnv.Close()
+
+	created, err := testEnv.client.CreateEncryptedVolume(testEnv.ctx, &pb.CreateEncryptedVolumeRequest{
+		EncryptedVolume: &encryptedVolume, EncryptedVolumeId: encryptedVolumeID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(created.Key) != 0 {
+		t.Error("create response contains key", created.Key)
+	}
+	if stored := testEnv.opiSpdkServer.volumes.encVolumes[encryptedVolumeName]; len(stored.Key) != 0 {
+		t.Error("stored volume contains key", stored.Key)
+	}
+	got, err := testEnv.client.GetEncryptedVolume(testEnv.ctx, &pb.GetEncryptedVolumeRequest{Name: encryptedVolumeName})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Key) != 0 {
+		t.Error("get response contains key", got.Key)
+	}
+
+	ref, err := testEnv.client.GetEncryptedVolumeKey(testEnv.ctx, &bridgepb.GetEncryptedVolumeKeyRequest{Name: encryptedVolumeName})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedRef := &bridgepb.EncryptedVolumeKey{KeyName: encryptedVolumeID, Fingerprint: keyFingerprint(encryptedVolume.Key)}
+	if !proto.Equal(ref, expectedRef) {
+		t.Error("key reference: expected", expectedRef, "received", ref)
+	}
+
+	sameKey := server.ProtoClone(&encryptedVolume)
+	sameKey.Name = encryptedVolumeName
+	_, err = testEnv.client.UpdateEncryptedVolume(testEnv.ctx, &pb.UpdateEncryptedVolumeRequest{EncryptedVolume: sameKey})
+	expectedMsg := fmt.Sprintf("key of EncryptedVolume %s is already in use, a new key must be supplied", encryptedVolumeName)
+	if er := status.Convert(err); er.Code() != codes.InvalidArgument || er.Message() != expectedMsg {
+		t.Error("error: expected", codes.InvalidArgument, expectedMsg, "received", er.Code(), er.Message())
+	}
+
+	rekeyed := server.ProtoClone(sameKey)
+	rekeyed.Key = newKey
+	updated, err := testEnv.client.UpdateEncryptedVolume(testEnv.ctx, &pb.UpdateEncryptedVolumeRequest{EncryptedVolume: rekeyed})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(updated.Key) != 0 {
+		t.Error("update response contains key", updated.Key)
+	}
+	ref, err = testEnv.client.GetEncryptedVolumeKey(testEnv.ctx, &bridgepb.GetEncryptedVolumeKeyRequest{Name: encryptedVolumeName})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ref.Fingerprint != keyFingerprint(newKey) {
+		t.Error("fingerprint: expected", keyFingerprint(newKey), "received", ref.Fingerprint)
+	}
+
+	_, err = testEnv.client.GetEncryptedVolumeKey(testEnv.ctx, &bridgepb.GetEncryptedVolumeKeyRequest{Name: "unknown-id"})
+	if er := status.Convert(err); er.Code() != codes.NotFound {
+		t.Error("error code: expected", codes.NotFound, "received", er.Code())
+	}
+}
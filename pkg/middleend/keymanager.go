@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package middleend implements the MiddleEnd APIs (service) of the storage Server
+package middleend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/kms"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+
+	"go.einride.tech/aip/fieldbehavior"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// SetKeyManager sets the key manager resolving key references of encrypted
+// volumes, so that raw keys do not need to be passed in requests
+func (s *Server) SetKeyManager(keyManager kms.KeyManager) {
+	s.keyManager = keyManager
+}
+
+// keyRefRequiredFields are the required fields of requests with key
+// references. Fields of the encrypted volume are checked once its key is
+// resolved
+var keyRefRequiredFields = &fieldmaskpb.FieldMask{Paths: []string{"encrypted_volume", "key_ref"}}
+
+// CreateEncryptedVolumeWithKeyRef creates an encrypted volume with the key
+// referenced in the key manager. The key is unwrapped in memory only for
+// the duration of accel_crypto_key_create
+func (s *Server) CreateEncryptedVolumeWithKeyRef(ctx context.Context, in *bridgepb.CreateEncryptedVolumeWithKeyRefRequest) (*pb.EncryptedVolume, error) {
+	log.Printf("CreateEncryptedVolumeWithKeyRef: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFieldsWithMask(in, keyRefRequiredFields); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	volume, fingerprint, err := s.resolveKeyRef(ctx, in.EncryptedVolume, in.KeyRef)
+	if err != nil {
+		return nil, err
+	}
+	defer kms.Zero(volume.Key)
+	response, err := s.CreateEncryptedVolume(ctx, &pb.CreateEncryptedVolumeRequest{EncryptedVolumeId: in.EncryptedVolumeId, EncryptedVolume: volume})
+	if err != nil {
+		return nil, err
+	}
+	s.setKeyRef(response.Name, fingerprint, in.KeyRef)
+	return response, nil
+}
+
+// UpdateEncryptedVolumeWithKeyRef rekeys an encrypted volume with the key
+// referenced in the key manager. Volumes data was written to are rekeyed
+// only if the request discards the data
+func (s *Server) UpdateEncryptedVolumeWithKeyRef(ctx context.Context, in *bridgepb.UpdateEncryptedVolumeWithKeyRefRequest) (*pb.EncryptedVolume, error) {
+	log.Printf("UpdateEncryptedVolumeWithKeyRef: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFieldsWithMask(in, keyRefRequiredFields); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	volume, fingerprint, err := s.resolveKeyRef(ctx, in.EncryptedVolume, in.KeyRef)
+	if err != nil {
+		return nil, err
+	}
+	defer kms.Zero(volume.Key)
+	response, err := s.updateEncryptedVolume(ctx, &pb.UpdateEncryptedVolumeRequest{EncryptedVolume: volume}, in.DiscardWrittenData)
+	if err != nil {
+		return nil, err
+	}
+	s.setKeyRef(response.Name, fingerprint, in.KeyRef)
+	return response, nil
+}
+
+// resolveKeyRef returns a copy of in carrying the key referenced by keyRef
+// along with the key fingerprint. Keys which cannot be unwrapped or do not
+// fit the cipher of in are not errors of the request, but of the key manager
+func (s *Server) resolveKeyRef(ctx context.Context, in *pb.EncryptedVolume, keyRef string) (*pb.EncryptedVolume, string, error) {
+	if s.keyManager == nil {
+		err := status.Error(codes.Unimplemented, "key manager is not configured")
+		log.Printf("error: %v", err)
+		return nil, "", err
+	}
+	if len(in.Key) != 0 {
+		err := status.Error(codes.InvalidArgument, "key and key reference are mutually exclusive")
+		log.Printf("error: %v", err)
+		return nil, "", err
+	}
+	key, err := s.keyManager.Key(ctx, keyRef)
+	switch {
+	case errors.Is(err, kms.ErrKeyNotFound):
+		err = status.Errorf(codes.NotFound, "unable to find key reference %s", keyRef)
+		log.Printf("error: %v", err)
+		return nil, "", err
+	case err != nil:
+		msg := fmt.Sprintf("Could not resolve key reference %s: %v", keyRef, err)
+		log.Print(msg)
+		return nil, "", status.Errorf(keyManagerErrorCode(err), msg)
+	}
+	if spec, ok := ciphers[in.Cipher]; ok && len(key)*8 != spec.keyBits {
+		kms.Zero(key)
+		msg := fmt.Sprintf("key reference %s resolves to a key of %db, expected %db", keyRef, len(key)*8, spec.keyBits)
+		log.Print(msg)
+		return nil, "", status.Errorf(codes.FailedPrecondition, msg)
+	}
+	volume := server.ProtoClone(in)
+	volume.Key = key
+	return volume, keyFingerprint(key), nil
+}
+
+// keyManagerErrorCode returns the code of errors of key managers resolving
+// key references other than kms.ErrKeyNotFound
+func keyManagerErrorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, kms.ErrInvalidKeyRef):
+		return codes.InvalidArgument
+	case errors.Is(err, kms.ErrKeyUnwrap):
+		return codes.FailedPrecondition
+	case errors.Is(err, kms.ErrUnavailable):
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
+
+// setKeyRef records keyRef for the key of volume, unless a request with an
+// already existing volume returned a volume with another key
+func (s *Server) setKeyRef(name string, fingerprint string, keyRef string) {
+	if key, ok := s.volumes.encKeys[name]; ok && key.Fingerprint == fingerprint {
+		key.KeyRef = keyRef
+	}
+}
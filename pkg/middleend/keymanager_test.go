@@ -0,0 +1,335 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package middleend implements the MiddleEnd APIs (service) of the storage Server
+package middleend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/kms"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+)
+
+var (
+	testKek    = []byte("kek-0123456789abcdef0123456789ab")
+	testKeyRef = "volume-key"
+	testDek    = []byte("fedcba9876543210fedcba9876543210")
+)
+
+type fixedKeyManager struct {
+	key []byte
+	err error
+}
+
+func (m fixedKeyManager) Key(context.Context, string) ([]byte, error) {
+	return append([]byte(nil), m.key...), m.err
+}
+
+func createFixedKeyManager(key []byte, err error) func(*testing.T) kms.KeyManager {
+	return func(*testing.T) kms.KeyManager { return fixedKeyManager{key: key, err: err} }
+}
+
+func createTestKeyManager(t *testing.T) kms.KeyManager {
+	dir := t.TempDir()
+	kekPath := filepath.Join(dir, "kek")
+	if err := os.WriteFile(kekPath, testKek, 0600); err != nil {
+		t.Fatal(err)
+	}
+	wrapped, err := kms.WrapKey(testKek, testKeyRef, testDek)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, testKeyRef), wrapped, 0600); err != nil {
+		t.Fatal(err)
+	}
+	keyManager, err := kms.NewLocalKeyManager(kekPath, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return keyManager
+}
+
+func TestMiddleEnd_CreateEncryptedVolumeWithKeyRef(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	volumeWithoutKey := server.ProtoClone(&encryptedVolume)
+	volumeWithoutKey.Key = nil
+	expectedVolume := server.ProtoClone(volumeWithoutKey)
+	expectedVolume.Name = encryptedVolumeName
+
+	tests := map[string]struct {
+		in         *pb.EncryptedVolume
+		keyRef     string
+		keyManager func(t *testing.T) kms.KeyManager
+		out        *pb.EncryptedVolume
+		spdk       []string
+		errCode    codes.Code
+		errMsg     string
+	}{
+		"valid key reference": {
+			in:         volumeWithoutKey,
+			keyRef:     testKeyRef,
+			keyManager: createTestKeyManager,
+			out:        expectedVolume,
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":"crypto-test"}`,
+			},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"unknown key reference": {
+			in:         volumeWithoutKey,
+			keyRef:     "unknown-key",
+			keyManager: createTestKeyManager,
+			out:        nil,
+			spdk:       []string{},
+			errCode:    codes.NotFound,
+			errMsg:     "unable to find key reference unknown-key",
+		},
+		"key and key reference": {
+			in:         &encryptedVolume,
+			keyRef:     testKeyRef,
+			keyManager: createTestKeyManager,
+			out:        nil,
+			spdk:       []string{},
+			errCode:    codes.InvalidArgument,
+			errMsg:     "key and key reference are mutually exclusive",
+		},
+		"missing volume": {
+			in:         nil,
+			keyRef:     testKeyRef,
+			keyManager: createTestKeyManager,
+			out:        nil,
+			spdk:       []string{},
+			errCode:    codes.Unknown,
+			errMsg:     "missing required field: encrypted_volume",
+		},
+		"missing key reference": {
+			in:         volumeWithoutKey,
+			keyRef:     "",
+			keyManager: createTestKeyManager,
+			out:        nil,
+			spdk:       []string{},
+			errCode:    codes.Unknown,
+			errMsg:     "missing required field: key_ref",
+		},
+		"key manager not configured": {
+			in:         volumeWithoutKey,
+			keyRef:     testKeyRef,
+			keyManager: nil,
+			out:        nil,
+			spdk:       []string{},
+			errCode:    codes.Unimplemented,
+			errMsg:     "key manager is not configured",
+		},
+		"key manager unavailable": {
+			in:         volumeWithoutKey,
+			keyRef:     testKeyRef,
+			keyManager: createFixedKeyManager(nil, fmt.Errorf("%w: connection refused", kms.ErrUnavailable)),
+			out:        nil,
+			spdk:       []string{},
+			errCode:    codes.Unavailable,
+			errMsg:     "Could not resolve key reference volume-key: key manager is unavailable: connection refused",
+		},
+		"invalid key reference": {
+			in:         volumeWithoutKey,
+			keyRef:     "../kek",
+			keyManager: createTestKeyManager,
+			out:        nil,
+			spdk:       []string{},
+			errCode:    codes.InvalidArgument,
+			errMsg:     `Could not resolve key reference ../kek: invalid key reference "../kek"`,
+		},
+		"key cannot be unwrapped": {
+			in:         volumeWithoutKey,
+			keyRef:     testKeyRef,
+			keyManager: createFixedKeyManager(nil, fmt.Errorf("%w volume-key: cipher: message authentication failed", kms.ErrKeyUnwrap)),
+			out:        nil,
+			spdk:       []string{},
+			errCode:    codes.FailedPrecondition,
+			errMsg:     "Could not resolve key reference volume-key: could not unwrap key volume-key: cipher: message authentication failed",
+		},
+		"key manager fails": {
+			in:         volumeWithoutKey,
+			keyRef:     testKeyRef,
+			keyManager: createFixedKeyManager(nil, errors.New("KMIP Get of volume-key failed with status 1: permission denied")),
+			out:        nil,
+			spdk:       []string{},
+			errCode:    codes.Internal,
+			errMsg:     "Could not resolve key reference volume-key: KMIP Get of volume-key failed with status 1: permission denied",
+		},
+		"key of wrong size": {
+			in:         volumeWithoutKey,
+			keyRef:     testKeyRef,
+			keyManager: createFixedKeyManager(testDek[:16], nil),
+			out:        nil,
+			spdk:       []string{},
+			errCode:    codes.FailedPrecondition,
+			errMsg:     "key reference volume-key resolves to a key of 128b, expected 256b",
+		},
+		"key create fails": {
+			in:         volumeWithoutKey,
+			keyRef:     testKeyRef,
+			keyManager: createTestKeyManager,
+			out:        nil,
+			spdk:       []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			errCode:    codes.InvalidArgument,
+			errMsg:     "Could not create Crypto Key: crypto-test",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+			if tt.keyManager != nil {
+				testEnv.opiSpdkServer.SetKeyManager(tt.keyManager(t))
+			}
+
+			request := &bridgepb.CreateEncryptedVolumeWithKeyRefRequest{EncryptedVolume: tt.in, EncryptedVolumeId: encryptedVolumeID, KeyRef: tt.keyRef}
+			response, err := testEnv.client.CreateEncryptedVolumeWithKeyRef(testEnv.ctx, request)
+
+			if !proto.Equal(response, tt.out) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+
+			if tt.errCode == codes.OK {
+				key, err := testEnv.client.GetEncryptedVolumeKey(testEnv.ctx, &bridgepb.GetEncryptedVolumeKeyRequest{Name: encryptedVolumeName})
+				if err != nil {
+					t.Fatal(err)
+				}
+				expectedKey := &bridgepb.EncryptedVolumeKey{
+					KeyName:     encryptedVolumeID,
+					Fingerprint: keyFingerprint(testDek),
+					KeyRef:      testKeyRef,
+				}
+				if !proto.Equal(key, expectedKey) {
+					t.Error("key: expected", expectedKey, "received", key)
+				}
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_UpdateEncryptedVolumeWithKeyRef(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	volumeWithoutKey := server.ProtoClone(&encryptedVolume)
+	volumeWithoutKey.Name = encryptedVolumeName
+	volumeWithoutKey.Key = nil
+
+	tests := map[string]struct {
+		existingKey []byte
+		discard     bool
+		out         *pb.EncryptedVolume
+		spdk        []string
+		errCode     codes.Code
+		errMsg      string
+	}{
+		"rekey with referenced key": {
+			existingKey: encryptedVolume.Key,
+			discard:     false,
+			out:         volumeWithoutKey,
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":{"tick_rate":2490000000,"ticks":1,"bdevs":[{"name":"crypto-test","num_write_ops":0}]}}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":"crypto-test"}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"data written to volume": {
+			existingKey: encryptedVolume.Key,
+			discard:     false,
+			out:         nil,
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":{"tick_rate":2490000000,"ticks":1,"bdevs":[{"name":"crypto-test","num_write_ops":3}]}}`,
+			},
+			errCode: codes.FailedPrecondition,
+			errMsg:  fmt.Sprintf("EncryptedVolume %s holds data written with its current key, which is not re-encrypted; migrate the data to a new EncryptedVolume instead", encryptedVolumeName),
+		},
+		"written data discarded": {
+			existingKey: encryptedVolume.Key,
+			discard:     true,
+			out:         volumeWithoutKey,
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":"crypto-test"}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"referenced key already in use": {
+			existingKey: testDek,
+			discard:     false,
+			out:         nil,
+			spdk:        []string{},
+			errCode:     codes.InvalidArgument,
+			errMsg:      "key of EncryptedVolume " + encryptedVolumeName + " is already in use, a new key must be supplied",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+			testEnv.opiSpdkServer.SetKeyManager(createTestKeyManager(t))
+			testEnv.opiSpdkServer.volumes.encVolumes[encryptedVolumeName] = server.ProtoClone(volumeWithoutKey)
+			base, err := testEnv.opiSpdkServer.registry.Get(volumeWithoutKey.VolumeNameRef)
+			if err != nil {
+				t.Fatal(err)
+			}
+			testEnv.opiSpdkServer.registerEncryptedVolume(encryptedVolumeName, base)
+			testEnv.opiSpdkServer.volumes.encKeys[encryptedVolumeName] = &bridgepb.EncryptedVolumeKey{
+				KeyName:     encryptedVolumeID,
+				Fingerprint: keyFingerprint(tt.existingKey),
+			}
+
+			request := &bridgepb.UpdateEncryptedVolumeWithKeyRefRequest{EncryptedVolume: volumeWithoutKey, KeyRef: testKeyRef, DiscardWrittenData: tt.discard}
+			response, err := testEnv.client.UpdateEncryptedVolumeWithKeyRef(testEnv.ctx, request)
+
+			if !proto.Equal(response, tt.out) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+
+			key := testEnv.opiSpdkServer.volumes.encKeys[encryptedVolumeName]
+			if tt.errCode == codes.OK && key.KeyRef != testKeyRef {
+				t.Error("key reference: expected", testKeyRef, "received", key.KeyRef)
+			}
+			if tt.errCode != codes.OK && key.KeyRef != "" {
+				t.Error("key reference: expected none, received", key.KeyRef)
+			}
+		})
+	}
+}
@@ -6,35 +6,109 @@
 package middleend
 
 import (
+	"log"
+	"path"
+
 	"github.com/opiproject/gospdk/spdk"
 	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/kms"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
 )
 
 // VolumeParameters contains MiddleEnd volume related structures
 type VolumeParameters struct {
 	qosVolumes map[string]*pb.QosVolume
 	encVolumes map[string]*pb.EncryptedVolume
+	// encKeys identify keys of encrypted volumes, which are not retained
+	encKeys map[string]*bridgepb.EncryptedVolumeKey
+	// encRotations are key rotation histories of encrypted volumes
+	encRotations map[string][]*bridgepb.KeyRotation
+
+	cachedVolumes map[string]*bridgepb.CachedVolume
 }
 
 // Server contains middleend related OPI services
 type Server struct {
 	pb.UnimplementedMiddleendEncryptionServiceServer
 	pb.UnimplementedMiddleendQosVolumeServiceServer
+	bridgepb.UnimplementedCompressedVolumeServiceServer
+	bridgepb.UnimplementedCachedVolumeServiceServer
+	bridgepb.UnimplementedEncryptedVolumeKeyServiceServer
+	bridgepb.UnimplementedQosVolumeBurstServiceServer
+	bridgepb.UnimplementedQosPolicyServiceServer
+	bridgepb.UnimplementedQosGroupServiceServer
+	bridgepb.UnimplementedVolumeStatsServiceServer
 
-	rpc        spdk.JSONRPC
-	volumes    VolumeParameters
-	Pagination map[string]int
+	rpc         spdk.JSONRPC
+	volumes     VolumeParameters
+	Pagination  map[string]int
+	registry    *volume.Registry
+	compression compression
+	keyManager  kms.KeyManager
+	crypto      CryptoOptions
+	qos         qosController
+	stats       *server.StatsTracker
 }
 
 // NewServer creates initialized instance of MiddleEnd server communicating
-// with provided jsonRPC
-func NewServer(jsonRPC spdk.JSONRPC) *Server {
+// with provided jsonRPC. Volumes are resolved through and encrypted volumes
+// are registered in registry
+func NewServer(jsonRPC spdk.JSONRPC, registry *volume.Registry) *Server {
+	if registry == nil {
+		log.Panic("nil for volume.Registry is not allowed")
+	}
 	return &Server{
 		rpc: jsonRPC,
 		volumes: VolumeParameters{
-			qosVolumes: make(map[string]*pb.QosVolume),
-			encVolumes: make(map[string]*pb.EncryptedVolume),
+			qosVolumes:   make(map[string]*pb.QosVolume),
+			encVolumes:   make(map[string]*pb.EncryptedVolume),
+			encKeys:      make(map[string]*bridgepb.EncryptedVolumeKey),
+			encRotations: make(map[string][]*bridgepb.KeyRotation),
+
+			cachedVolumes: make(map[string]*bridgepb.CachedVolume),
 		},
 		Pagination: make(map[string]int),
+		registry:   registry,
+		compression: compression{
+			pmPath:  defaultCompressPmPath,
+			volumes: make(map[string]*bridgepb.CompressedVolume),
+		},
+		qos:   newQosController(),
+		stats: server.NewStatsTracker(),
+	}
+}
+
+// acquireVolume marks a resolved volume as used by a created object
+func (s *Server) acquireVolume(volumeName string, user string) {
+	if _, err := s.registry.Acquire(volumeName, user); err != nil {
+		log.Printf("error: %v", err)
+	}
+}
+
+// bdevName resolves a volume reference to the name of its bdev
+func (s *Server) bdevName(volumeNameRef string) (string, error) {
+	vol, err := s.registry.Get(volumeNameRef)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return "", err
+	}
+	return vol.BdevName, nil
+}
+
+// registerEncryptedVolume makes a created crypto bdev resolvable by services
+// referencing volumes
+func (s *Server) registerEncryptedVolume(name string, base volume.Volume) {
+	err := s.registry.Register(volume.Volume{
+		Name:        name,
+		BdevName:    path.Base(name),
+		Type:        volume.TypeEncrypted,
+		Owner:       pb.MiddleendEncryptionService_ServiceDesc.ServiceName,
+		BlockSize:   base.BlockSize,
+		BlocksCount: base.BlocksCount,
+	})
+	if err != nil {
+		log.Printf("error: %v", err)
 	}
 }
@@ -17,7 +17,9 @@ import (
 
 	"github.com/opiproject/gospdk/spdk"
 	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
 	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
 )
 
 var checkGlobalTestProtoObjectsNotChanged = server.CheckTestProtoObjectsNotChanged(
@@ -25,11 +27,21 @@ var checkGlobalTestProtoObjectsNotChanged = server.CheckTestProtoObjectsNotChang
 	&encryptedVolume,
 )
 
+// testVolumeIDs are bdevs referenced by middleend objects in tests
+var testVolumeIDs = []string{"volume-42", "volume-test"}
+
 // TODO: move test infrastructure code to a separate (test/server) package to avoid duplication
 
 type middleendClient struct {
 	pb.MiddleendEncryptionServiceClient
 	pb.MiddleendQosVolumeServiceClient
+	bridgepb.CompressedVolumeServiceClient
+	bridgepb.CachedVolumeServiceClient
+	bridgepb.EncryptedVolumeKeyServiceClient
+	bridgepb.QosVolumeBurstServiceClient
+	bridgepb.QosPolicyServiceClient
+	bridgepb.QosGroupServiceClient
+	bridgepb.VolumeStatsServiceClient
 }
 
 type testEnv struct {
@@ -54,7 +66,7 @@ func createTestEnvironment(spdkResponses []string) *testEnv {
 	env := &testEnv{}
 	env.testSocket = server.GenerateSocketName("middleend")
 	env.ln, env.jsonRPC = server.CreateTestSpdkServer(env.testSocket, spdkResponses)
-	env.opiSpdkServer = NewServer(env.jsonRPC)
+	env.opiSpdkServer = NewServer(env.jsonRPC, createTestVolumeRegistry())
 
 	ctx := context.Background()
 	conn, err := grpc.DialContext(ctx,
@@ -70,16 +82,45 @@ func createTestEnvironment(spdkResponses []string) *testEnv {
 	env.client = &middleendClient{
 		pb.NewMiddleendEncryptionServiceClient(env.conn),
 		pb.NewMiddleendQosVolumeServiceClient(env.conn),
+		bridgepb.NewCompressedVolumeServiceClient(env.conn),
+		bridgepb.NewCachedVolumeServiceClient(env.conn),
+		bridgepb.NewEncryptedVolumeKeyServiceClient(env.conn),
+		bridgepb.NewQosVolumeBurstServiceClient(env.conn),
+		bridgepb.NewQosPolicyServiceClient(env.conn),
+		bridgepb.NewQosGroupServiceClient(env.conn),
+		bridgepb.NewVolumeStatsServiceClient(env.conn),
 	}
 
 	return env
 }
 
+func createTestVolumeRegistry() *volume.Registry {
+	registry := volume.NewRegistry()
+	for _, id := range testVolumeIDs {
+		err := registry.Register(volume.Volume{
+			Name:     server.ResourceIDToVolumeName(id),
+			BdevName: id,
+			Type:     volume.TypeNull,
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	return registry
+}
+
 func dialer(opiSpdkServer *Server) func(context.Context, string) (net.Conn, error) {
 	listener := bufconn.Listen(1024 * 1024)
 	server := grpc.NewServer()
 	pb.RegisterMiddleendEncryptionServiceServer(server, opiSpdkServer)
 	pb.RegisterMiddleendQosVolumeServiceServer(server, opiSpdkServer)
+	bridgepb.RegisterCompressedVolumeServiceServer(server, opiSpdkServer)
+	bridgepb.RegisterCachedVolumeServiceServer(server, opiSpdkServer)
+	bridgepb.RegisterEncryptedVolumeKeyServiceServer(server, opiSpdkServer)
+	bridgepb.RegisterQosVolumeBurstServiceServer(server, opiSpdkServer)
+	bridgepb.RegisterQosPolicyServiceServer(server, opiSpdkServer)
+	bridgepb.RegisterQosGroupServiceServer(server, opiSpdkServer)
+	bridgepb.RegisterVolumeStatsServiceServer(server, opiSpdkServer)
 
 	go func() {
 		if err := server.Serve(listener); err != nil {
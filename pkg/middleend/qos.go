@@ -31,7 +31,7 @@ func sortQosVolumes(volumes []*pb.QosVolume) {
 
 // CreateQosVolume creates a QoS volume
 func (s *Server) CreateQosVolume(_ context.Context, in *pb.CreateQosVolumeRequest) (*pb.QosVolume, error) {
-	log.Printf("CreateQosVolume: Received from client: %v", in)
+	log.Printf("CreateQosVolume: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -59,19 +59,24 @@ func (s *Server) CreateQosVolume(_ context.Context, in *pb.CreateQosVolumeReques
 		return volume, nil
 	}
 
-	if err := s.setMaxLimit(in.QosVolume.VolumeNameRef, in.QosVolume.Limits.Max); err != nil {
+	bdevName, err := s.bdevName(in.QosVolume.VolumeNameRef)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyQosLimits(in.QosVolume.Name, bdevName, in.QosVolume.Limits); err != nil {
 		return nil, err
 	}
 
 	response := server.ProtoClone(in.QosVolume)
 	s.volumes.qosVolumes[in.QosVolume.Name] = response
-	log.Printf("CreateQosVolume: Sending to client: %v", response)
+	s.acquireVolume(in.QosVolume.VolumeNameRef, response.Name)
+	log.Printf("CreateQosVolume: Sending to client: %v", server.Redact(response))
 	return response, nil
 }
 
 // DeleteQosVolume deletes a QoS volume
 func (s *Server) DeleteQosVolume(_ context.Context, in *pb.DeleteQosVolumeRequest) (*emptypb.Empty, error) {
-	log.Printf("DeleteQosVolume: Received from client: %v", in)
+	log.Printf("DeleteQosVolume: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -93,17 +98,22 @@ func (s *Server) DeleteQosVolume(_ context.Context, in *pb.DeleteQosVolumeReques
 		return nil, err
 	}
 
-	if err := s.cleanMaxLimit(qosVolume.VolumeNameRef); err != nil {
+	bdevName, err := s.bdevName(qosVolume.VolumeNameRef)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.removeQosLimits(in.Name, bdevName); err != nil {
 		return nil, err
 	}
 
 	delete(s.volumes.qosVolumes, in.Name)
+	s.registry.Release(qosVolume.VolumeNameRef, qosVolume.Name)
 	return &emptypb.Empty{}, nil
 }
 
 // UpdateQosVolume updates a QoS volume
 func (s *Server) UpdateQosVolume(_ context.Context, in *pb.UpdateQosVolumeRequest) (*pb.QosVolume, error) {
-	log.Printf("UpdateQosVolume: Received from client: %v", in)
+	log.Printf("UpdateQosVolume: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -128,8 +138,12 @@ func (s *Server) UpdateQosVolume(_ context.Context, in *pb.UpdateQosVolumeReques
 		log.Println("error:", msg)
 		return nil, status.Errorf(codes.InvalidArgument, msg)
 	}
+	bdevName, err := s.bdevName(in.QosVolume.VolumeNameRef)
+	if err != nil {
+		return nil, err
+	}
 	log.Println("Set new max limit values")
-	if err := s.setMaxLimit(in.QosVolume.VolumeNameRef, in.QosVolume.Limits.Max); err != nil {
+	if err := s.applyQosLimits(name, bdevName, in.QosVolume.Limits); err != nil {
 		return nil, err
 	}
 
@@ -139,7 +153,7 @@ func (s *Server) UpdateQosVolume(_ context.Context, in *pb.UpdateQosVolumeReques
 
 // ListQosVolumes lists QoS volumes
 func (s *Server) ListQosVolumes(_ context.Context, in *pb.ListQosVolumesRequest) (*pb.ListQosVolumesResponse, error) {
-	log.Printf("ListQosVolume: Received from client: %v", in)
+	log.Printf("ListQosVolume: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -171,7 +185,7 @@ func (s *Server) ListQosVolumes(_ context.Context, in *pb.ListQosVolumesRequest)
 
 // GetQosVolume gets a QoS volume
 func (s *Server) GetQosVolume(_ context.Context, in *pb.GetQosVolumeRequest) (*pb.QosVolume, error) {
-	log.Printf("GetQosVolume: Received from client: %v", in)
+	log.Printf("GetQosVolume: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -192,9 +206,11 @@ func (s *Server) GetQosVolume(_ context.Context, in *pb.GetQosVolumeRequest) (*p
 	return volume, nil
 }
 
-// StatsQosVolume gets a QoS volume stats
+// StatsQosVolume gets a QoS volume stats. Counters saturate at
+// math.MaxInt32, so StatsVolume is to be used for volumes which moved more
+// than 2 GiB. Latencies are in microseconds, see server.VolumeStats.ToProto
 func (s *Server) StatsQosVolume(_ context.Context, in *pb.StatsQosVolumeRequest) (*pb.StatsQosVolumeResponse, error) {
-	log.Printf("StatsQosVolume: Received from client: %v", in)
+	log.Printf("StatsQosVolume: Received from client: %v", server.Redact(in))
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		log.Printf("error: %v", err)
@@ -212,11 +228,16 @@ func (s *Server) StatsQosVolume(_ context.Context, in *pb.StatsQosVolumeRequest)
 		log.Printf("error: %v", err)
 		return nil, err
 	}
+	vol, err := s.registry.Get(volume.VolumeNameRef)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
 	params := spdk.BdevGetIostatParams{
-		Name: volume.VolumeNameRef,
+		Name: vol.BdevName,
 	}
 	var result spdk.BdevGetIostatResult
-	err := s.rpc.Call("bdev_get_iostat", &params, &result)
+	err = s.rpc.Call("bdev_get_iostat", &params, &result)
 	if err != nil {
 		log.Printf("error: %v", err)
 		return nil, spdk.ErrFailedSpdkCall
@@ -227,18 +248,13 @@ func (s *Server) StatsQosVolume(_ context.Context, in *pb.StatsQosVolumeRequest)
 		return nil, spdk.ErrUnexpectedSpdkCallResult
 	}
 
-	return &pb.StatsQosVolumeResponse{
-		Stats: &pb.VolumeStats{
-			ReadBytesCount:    int32(result.Bdevs[0].BytesRead),
-			ReadOpsCount:      int32(result.Bdevs[0].NumReadOps),
-			WriteBytesCount:   int32(result.Bdevs[0].BytesWritten),
-			WriteOpsCount:     int32(result.Bdevs[0].NumWriteOps),
-			UnmapBytesCount:   int32(result.Bdevs[0].BytesUnmapped),
-			UnmapOpsCount:     int32(result.Bdevs[0].NumUnmapOps),
-			ReadLatencyTicks:  int32(result.Bdevs[0].ReadLatencyTicks),
-			WriteLatencyTicks: int32(result.Bdevs[0].WriteLatencyTicks),
-			UnmapLatencyTicks: int32(result.Bdevs[0].UnmapLatencyTicks),
-		}}, nil
+	stats := server.NewVolumeStats(&result)
+	if err := s.volumeHistogram(vol, stats); err != nil {
+		return nil, err
+	}
+	s.stats.Update(vol.BdevName, stats)
+	log.Printf("Rates of %v: %+v", vol.BdevName, stats.Rates)
+	return &pb.StatsQosVolumeResponse{Stats: stats.ToProto()}, nil
 }
 
 func (s *Server) verifyQosVolume(volume *pb.QosVolume) error {
@@ -250,36 +266,32 @@ func (s *Server) verifyQosVolume(volume *pb.QosVolume) error {
 		return err
 	}
 
-	if volume.Limits.Min != nil {
-		return fmt.Errorf("QoS volume min_limit is not supported")
-	}
-	if volume.Limits.Max.RdIopsKiops != 0 {
-		return fmt.Errorf("QoS volume max_limit rd_iops_kiops is not supported")
-	}
-	if volume.Limits.Max.WrIopsKiops != 0 {
-		return fmt.Errorf("QoS volume max_limit wr_iops_kiops is not supported")
-	}
+	return verifyQosLimits(volume.Limits)
+}
 
-	if volume.Limits.Max.RdBandwidthMbs == 0 &&
-		volume.Limits.Max.WrBandwidthMbs == 0 &&
-		volume.Limits.Max.RwBandwidthMbs == 0 &&
-		volume.Limits.Max.RdIopsKiops == 0 &&
-		volume.Limits.Max.WrIopsKiops == 0 &&
-		volume.Limits.Max.RwIopsKiops == 0 {
+func verifyQosLimits(limits *pb.Limits) error {
+	maxLimit := limits.GetMax()
+	minLimit := limits.GetMin()
+	if minLimit == nil && isEmptyQosLimit(maxLimit) {
 		return fmt.Errorf("QoS volume max_limit should set limit")
 	}
-
-	if volume.Limits.Max.RwIopsKiops < 0 {
-		return fmt.Errorf("QoS volume max_limit rw_iops_kiops cannot be negative")
+	if minLimit != nil && isEmptyQosLimit(minLimit) {
+		return fmt.Errorf("QoS volume min_limit should set limit")
 	}
-	if volume.Limits.Max.RdBandwidthMbs < 0 {
-		return fmt.Errorf("QoS volume max_limit rd_bandwidth_mbs cannot be negative")
-	}
-	if volume.Limits.Max.WrBandwidthMbs < 0 {
-		return fmt.Errorf("QoS volume max_limit wr_bandwidth_mbs cannot be negative")
+
+	maxFields := qosLimitFields(maxLimit)
+	for _, field := range maxFields {
+		if field.value < 0 {
+			return fmt.Errorf("QoS volume max_limit %v cannot be negative", field.name)
+		}
 	}
-	if volume.Limits.Max.RwBandwidthMbs < 0 {
-		return fmt.Errorf("QoS volume max_limit rw_bandwidth_mbs cannot be negative")
+	for i, field := range qosLimitFields(minLimit) {
+		if field.value < 0 {
+			return fmt.Errorf("QoS volume min_limit %v cannot be negative", field.name)
+		}
+		if maxFields[i].value > 0 && field.value > maxFields[i].value {
+			return fmt.Errorf("QoS volume min_limit %v cannot exceed max_limit", field.name)
+		}
 	}
 
 	return nil
@@ -312,3 +324,30 @@ func (s *Server) setMaxLimit(underlyingVolume string, limit *pb.QosLimit) error
 func (s *Server) cleanMaxLimit(underlyingVolume string) error {
 	return s.setMaxLimit(underlyingVolume, &pb.QosLimit{})
 }
+
+// qosLimitField is a named value of a QoS limit
+type qosLimitField struct {
+	name  string
+	value int64
+}
+
+// qosLimitFields lists values of limit in the order of their declaration
+func qosLimitFields(limit *pb.QosLimit) []qosLimitField {
+	return []qosLimitField{
+		{"rd_iops_kiops", limit.GetRdIopsKiops()},
+		{"wr_iops_kiops", limit.GetWrIopsKiops()},
+		{"rw_iops_kiops", limit.GetRwIopsKiops()},
+		{"rd_bandwidth_mbs", limit.GetRdBandwidthMbs()},
+		{"wr_bandwidth_mbs", limit.GetWrBandwidthMbs()},
+		{"rw_bandwidth_mbs", limit.GetRwBandwidthMbs()},
+	}
+}
+
+func isEmptyQosLimit(limit *pb.QosLimit) bool {
+	for _, field := range qosLimitFields(limit) {
+		if field.value != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -62,7 +62,7 @@ func TestMiddleEnd_CreateQosVolume(t *testing.T) {
 		existBefore bool
 		existAfter  bool
 	}{
-		"min_limit is not supported": {
+		"min_limit is guaranteed": {
 			id: testQosVolumeID,
 			in: &pb.QosVolume{
 				VolumeNameRef: "volume-42",
@@ -72,14 +72,21 @@ func TestMiddleEnd_CreateQosVolume(t *testing.T) {
 					},
 				},
 			},
-			out:         nil,
-			spdk:        []string{},
-			errCode:     codes.InvalidArgument,
-			errMsg:      "QoS volume min_limit is not supported",
+			out: &pb.QosVolume{
+				VolumeNameRef: "volume-42",
+				Limits: &pb.Limits{
+					Min: &pb.QosLimit{
+						RdIopsKiops: 100000,
+					},
+				},
+			},
+			spdk:        []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode:     codes.OK,
+			errMsg:      "",
 			existBefore: false,
-			existAfter:  false,
+			existAfter:  true,
 		},
-		"max_limit rd_iops_kiops is not supported": {
+		"max_limit rd_iops_kiops is set": {
 			id: testQosVolumeID,
 			in: &pb.QosVolume{
 				VolumeNameRef: "volume-42",
@@ -89,14 +96,21 @@ func TestMiddleEnd_CreateQosVolume(t *testing.T) {
 					},
 				},
 			},
-			out:         nil,
-			spdk:        []string{},
-			errCode:     codes.InvalidArgument,
-			errMsg:      "QoS volume max_limit rd_iops_kiops is not supported",
+			out: &pb.QosVolume{
+				VolumeNameRef: "volume-42",
+				Limits: &pb.Limits{
+					Max: &pb.QosLimit{
+						RdIopsKiops: 100000,
+					},
+				},
+			},
+			spdk:        []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode:     codes.OK,
+			errMsg:      "",
 			existBefore: false,
-			existAfter:  false,
+			existAfter:  true,
 		},
-		"max_limit wr_iops_kiops is not supported": {
+		"max_limit wr_iops_kiops is set": {
 			id: testQosVolumeID,
 			in: &pb.QosVolume{
 				VolumeNameRef: "volume-42",
@@ -106,12 +120,19 @@ func TestMiddleEnd_CreateQosVolume(t *testing.T) {
 					},
 				},
 			},
-			out:         nil,
-			spdk:        []string{},
-			errCode:     codes.InvalidArgument,
-			errMsg:      "QoS volume max_limit wr_iops_kiops is not supported",
+			out: &pb.QosVolume{
+				VolumeNameRef: "volume-42",
+				Limits: &pb.Limits{
+					Max: &pb.QosLimit{
+						WrIopsKiops: 100000,
+					},
+				},
+			},
+			spdk:        []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode:     codes.OK,
+			errMsg:      "",
 			existBefore: false,
-			existAfter:  false,
+			existAfter:  true,
 		},
 		"max_limit rw_iops_kiops is negative": {
 			id: testQosVolumeID,
@@ -181,6 +202,58 @@ func TestMiddleEnd_CreateQosVolume(t *testing.T) {
 			existBefore: false,
 			existAfter:  false,
 		},
+		"min_limit with all zero limits": {
+			id: testQosVolumeID,
+			in: &pb.QosVolume{
+				VolumeNameRef: "volume-42",
+				Limits: &pb.Limits{
+					Min: &pb.QosLimit{},
+				},
+			},
+			out:         nil,
+			spdk:        []string{},
+			errCode:     codes.InvalidArgument,
+			errMsg:      "QoS volume min_limit should set limit",
+			existBefore: false,
+			existAfter:  false,
+		},
+		"min_limit wr_iops_kiops is negative": {
+			id: testQosVolumeID,
+			in: &pb.QosVolume{
+				VolumeNameRef: "volume-42",
+				Limits: &pb.Limits{
+					Min: &pb.QosLimit{
+						WrIopsKiops: -1,
+					},
+				},
+			},
+			out:         nil,
+			spdk:        []string{},
+			errCode:     codes.InvalidArgument,
+			errMsg:      "QoS volume min_limit wr_iops_kiops cannot be negative",
+			existBefore: false,
+			existAfter:  false,
+		},
+		"min_limit exceeds max_limit": {
+			id: testQosVolumeID,
+			in: &pb.QosVolume{
+				VolumeNameRef: "volume-42",
+				Limits: &pb.Limits{
+					Min: &pb.QosLimit{
+						RwBandwidthMbs: 20,
+					},
+					Max: &pb.QosLimit{
+						RwBandwidthMbs: 10,
+					},
+				},
+			},
+			out:         nil,
+			spdk:        []string{},
+			errCode:     codes.InvalidArgument,
+			errMsg:      "QoS volume min_limit rw_bandwidth_mbs cannot exceed max_limit",
+			existBefore: false,
+			existAfter:  false,
+		},
 		"max_limit with all zero limits": {
 			id: testQosVolumeID,
 			in: &pb.QosVolume{
@@ -267,6 +340,19 @@ func TestMiddleEnd_CreateQosVolume(t *testing.T) {
 			existBefore: false,
 			existAfter:  true,
 		},
+		"unknown underlying volume": {
+			id: testQosVolumeID,
+			in: &pb.QosVolume{
+				VolumeNameRef: "unknown-volume",
+				Limits:        testQosVolume.Limits,
+			},
+			out:         nil,
+			spdk:        []string{},
+			errCode:     codes.NotFound,
+			errMsg:      "unable to find volume unknown-volume",
+			existBefore: false,
+			existAfter:  false,
+		},
 		"no required field": {
 			id:          testQosVolumeID,
 			in:          nil,
@@ -488,7 +574,7 @@ func TestMiddleEnd_UpdateQosVolume(t *testing.T) {
 		// 	existBefore: true,
 		//	missing:	 false,
 		// },
-		"min_limit is not supported": {
+		"min_limit is guaranteed": {
 			mask: nil,
 			in: &pb.QosVolume{
 				Name:          testQosVolumeName,
@@ -499,14 +585,22 @@ func TestMiddleEnd_UpdateQosVolume(t *testing.T) {
 					},
 				},
 			},
-			out:         nil,
-			spdk:        []string{},
-			errCode:     codes.InvalidArgument,
-			errMsg:      "QoS volume min_limit is not supported",
+			out: &pb.QosVolume{
+				Name:          testQosVolumeName,
+				VolumeNameRef: "volume-42",
+				Limits: &pb.Limits{
+					Min: &pb.QosLimit{
+						RdIopsKiops: 100000,
+					},
+				},
+			},
+			spdk:        []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode:     codes.OK,
+			errMsg:      "",
 			existBefore: true,
 			missing:     false,
 		},
-		"max_limit rd_iops_kiops is not supported": {
+		"max_limit rd_iops_kiops is set": {
 			mask: nil,
 			in: &pb.QosVolume{
 				Name:          testQosVolumeName,
@@ -517,14 +611,22 @@ func TestMiddleEnd_UpdateQosVolume(t *testing.T) {
 					},
 				},
 			},
-			out:         nil,
-			spdk:        []string{},
-			errCode:     codes.InvalidArgument,
-			errMsg:      "QoS volume max_limit rd_iops_kiops is not supported",
+			out: &pb.QosVolume{
+				Name:          testQosVolumeName,
+				VolumeNameRef: "volume-42",
+				Limits: &pb.Limits{
+					Max: &pb.QosLimit{
+						RdIopsKiops: 100000,
+					},
+				},
+			},
+			spdk:        []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode:     codes.OK,
+			errMsg:      "",
 			existBefore: true,
 			missing:     false,
 		},
-		"max_limit wr_iops_kiops is not supported": {
+		"max_limit wr_iops_kiops is set": {
 			mask: nil,
 			in: &pb.QosVolume{
 				Name:          testQosVolumeName,
@@ -535,10 +637,18 @@ func TestMiddleEnd_UpdateQosVolume(t *testing.T) {
 					},
 				},
 			},
-			out:         nil,
-			spdk:        []string{},
-			errCode:     codes.InvalidArgument,
-			errMsg:      "QoS volume max_limit wr_iops_kiops is not supported",
+			out: &pb.QosVolume{
+				Name:          testQosVolumeName,
+				VolumeNameRef: "volume-42",
+				Limits: &pb.Limits{
+					Max: &pb.QosLimit{
+						WrIopsKiops: 100000,
+					},
+				},
+			},
+			spdk:        []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode:     codes.OK,
+			errMsg:      "",
 			existBefore: true,
 			missing:     false,
 		},
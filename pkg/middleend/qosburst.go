@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package middleend implements the MiddleEnd APIs (service) of the storage Server
+package middleend
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+
+	"go.einride.tech/aip/fieldbehavior"
+	"go.einride.tech/aip/resourcename"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// qosCredits are burst credits of a QoS volume
+type qosCredits struct {
+	ios float64
+	mbs float64
+}
+
+// qosCreditCapacity is the maximum of credits a volume can collect
+func qosCreditCapacity(burst *bridgepb.QosBurst, limits *pb.Limits) qosCredits {
+	seconds := burst.GetDuration().AsDuration().Seconds()
+	var capacity qosCredits
+	if burst.RwIopsKiops > 0 {
+		capacity.ios = float64((burst.RwIopsKiops-limits.GetMax().GetRwIopsKiops())*1000) * seconds
+	}
+	if burst.RwBandwidthMbs > 0 {
+		capacity.mbs = float64(burst.RwBandwidthMbs-limits.GetMax().GetRwBandwidthMbs()) * seconds
+	}
+	return capacity
+}
+
+// clamp limits credits to the capacity
+func (c *qosCredits) clamp(burst *bridgepb.QosBurst, limits *pb.Limits) {
+	capacity := qosCreditCapacity(burst, limits)
+	c.ios = clampCredits(c.ios, capacity.ios)
+	c.mbs = clampCredits(c.mbs, capacity.mbs)
+}
+
+func clampCredits(credits float64, capacity float64) float64 {
+	switch {
+	case credits < 0:
+		return 0
+	case credits > capacity:
+		return capacity
+	default:
+		return credits
+	}
+}
+
+// updateCredits earns credits for I/O not used below max_limit and spends
+// credits for I/O above it in the last sampling period
+func (v *qosControlledVolume) updateCredits() {
+	if v.burst == nil || v.rates.Interval.Seconds() == 0 {
+		return
+	}
+	maxLimit := v.limits.GetMax()
+	if v.burst.RwIopsKiops > 0 {
+		baseline := float64(maxLimit.GetRwIopsKiops() * 1000)
+		v.credits.ios += (baseline - v.rates.ReadIops - v.rates.WriteIops) * v.rates.Interval.Seconds()
+	}
+	if v.burst.RwBandwidthMbs > 0 {
+		baseline := float64(maxLimit.GetRwBandwidthMbs())
+		v.credits.mbs += (baseline - v.rates.ReadMbs - v.rates.WriteMbs) * v.rates.Interval.Seconds()
+	}
+	v.credits.clamp(v.burst, v.limits)
+}
+
+// SetQosVolumeBurst lets a QoS volume burst above its max_limit, or stops
+// bursting if no burst is set. A volume starts with full credits
+func (s *Server) SetQosVolumeBurst(_ context.Context, in *bridgepb.SetQosVolumeBurstRequest) (*bridgepb.QosVolumeStatus, error) {
+	log.Printf("SetQosVolumeBurst: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	s.qos.mu.Lock()
+	defer s.qos.mu.Unlock()
+	v, ok := s.qos.volumes[in.Name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	var burst *bridgepb.QosBurst
+	var credits qosCredits
+	if in.Burst != nil {
+		if err := verifyQosBurst(in.Burst, v.limits); err != nil {
+			log.Printf("error: %v", err)
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		burst = server.ProtoClone(in.Burst)
+		credits = qosCreditCapacity(burst, v.limits)
+	}
+
+	previousBurst, previousCredits := v.burst, v.credits
+	v.burst, v.credits = burst, credits
+	limit := v.effectiveLimit()
+	if !proto.Equal(limit, v.applied) {
+		if err := s.setMaxLimit(v.bdevName, limit); err != nil {
+			v.burst, v.credits = previousBurst, previousCredits
+			return nil, err
+		}
+		v.applied = limit
+	}
+	return v.status(), nil
+}
+
+// GetQosVolumeStatus returns limits, burst credits and the limit in effect
+// of a QoS volume
+func (s *Server) GetQosVolumeStatus(_ context.Context, in *bridgepb.GetQosVolumeStatusRequest) (*bridgepb.QosVolumeStatus, error) {
+	log.Printf("GetQosVolumeStatus: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	s.qos.mu.Lock()
+	defer s.qos.mu.Unlock()
+	v, ok := s.qos.volumes[in.Name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	return v.status(), nil
+}
+
+func (v *qosControlledVolume) status() *bridgepb.QosVolumeStatus {
+	return &bridgepb.QosVolumeStatus{
+		Limits:           server.ProtoClone(v.limits),
+		Burst:            server.ProtoClone(v.burst),
+		IoCredits:        int64(v.credits.ios),
+		BandwidthCredits: int64(v.credits.mbs),
+		Effective:        server.ProtoClone(v.applied),
+	}
+}
+
+func verifyQosBurst(burst *bridgepb.QosBurst, limits *pb.Limits) error {
+	if burst.GetDuration().AsDuration() <= 0 {
+		return fmt.Errorf("QoS volume burst duration must be positive")
+	}
+	if burst.RwIopsKiops < 0 {
+		return fmt.Errorf("QoS volume burst rw_iops_kiops cannot be negative")
+	}
+	if burst.RwBandwidthMbs < 0 {
+		return fmt.Errorf("QoS volume burst rw_bandwidth_mbs cannot be negative")
+	}
+	if burst.RwIopsKiops == 0 && burst.RwBandwidthMbs == 0 {
+		return fmt.Errorf("QoS volume burst should set limit")
+	}
+	maxLimit := limits.GetMax()
+	if burst.RwIopsKiops > 0 && burst.RwIopsKiops <= maxLimit.GetRwIopsKiops() {
+		return fmt.Errorf("QoS volume burst rw_iops_kiops must exceed max_limit rw_iops_kiops")
+	}
+	if burst.RwIopsKiops > 0 && maxLimit.GetRwIopsKiops() == 0 {
+		return fmt.Errorf("QoS volume burst rw_iops_kiops requires max_limit rw_iops_kiops")
+	}
+	if burst.RwBandwidthMbs > 0 && burst.RwBandwidthMbs <= maxLimit.GetRwBandwidthMbs() {
+		return fmt.Errorf("QoS volume burst rw_bandwidth_mbs must exceed max_limit rw_bandwidth_mbs")
+	}
+	if burst.RwBandwidthMbs > 0 && maxLimit.GetRwBandwidthMbs() == 0 {
+		return fmt.Errorf("QoS volume burst rw_bandwidth_mbs requires max_limit rw_bandwidth_mbs")
+	}
+	return nil
+}
@@ -0,0 +1,285 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package middleend implements the MiddleEnd APIs (service) of the storage Server
+package middleend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opiproject/gospdk/spdk"
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var testQosBurstLimits = &pb.Limits{
+	Max: &pb.QosLimit{RwIopsKiops: 2, RwBandwidthMbs: 100},
+}
+
+func TestMiddleEnd_SetQosVolumeBurst(t *testing.T) {
+	tests := map[string]struct {
+		name    string
+		in      *bridgepb.QosBurst
+		out     *bridgepb.QosVolumeStatus
+		spdk    []string
+		errCode codes.Code
+		errMsg  string
+	}{
+		"burst with full credits": {
+			name: testQosVolumeName,
+			in:   &bridgepb.QosBurst{RwIopsKiops: 4, Duration: durationpb.New(10 * time.Second)},
+			out: &bridgepb.QosVolumeStatus{
+				Limits:    testQosBurstLimits,
+				Burst:     &bridgepb.QosBurst{RwIopsKiops: 4, Duration: durationpb.New(10 * time.Second)},
+				IoCredits: 20000,
+				Effective: &pb.QosLimit{RwIopsKiops: 4, RwBandwidthMbs: 100},
+			},
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"bandwidth burst": {
+			name: testQosVolumeName,
+			in:   &bridgepb.QosBurst{RwBandwidthMbs: 150, Duration: durationpb.New(time.Second)},
+			out: &bridgepb.QosVolumeStatus{
+				Limits:           testQosBurstLimits,
+				Burst:            &bridgepb.QosBurst{RwBandwidthMbs: 150, Duration: durationpb.New(time.Second)},
+				BandwidthCredits: 50,
+				Effective:        &pb.QosLimit{RwIopsKiops: 2, RwBandwidthMbs: 150},
+			},
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"stop bursting": {
+			name: testQosVolumeName,
+			in:   nil,
+			out: &bridgepb.QosVolumeStatus{
+				Limits:    testQosBurstLimits,
+				Effective: &pb.QosLimit{RwIopsKiops: 2, RwBandwidthMbs: 100},
+			},
+			spdk:    []string{},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"SPDK call result false": {
+			name:    testQosVolumeName,
+			in:      &bridgepb.QosBurst{RwIopsKiops: 4, Duration: durationpb.New(10 * time.Second)},
+			out:     nil,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			errCode: status.Convert(spdk.ErrUnexpectedSpdkCallResult).Code(),
+			errMsg:  status.Convert(spdk.ErrUnexpectedSpdkCallResult).Message(),
+		},
+		"burst below max_limit": {
+			name:    testQosVolumeName,
+			in:      &bridgepb.QosBurst{RwIopsKiops: 2, Duration: durationpb.New(10 * time.Second)},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  "QoS volume burst rw_iops_kiops must exceed max_limit rw_iops_kiops",
+		},
+		"burst without limit": {
+			name:    testQosVolumeName,
+			in:      &bridgepb.QosBurst{Duration: durationpb.New(10 * time.Second)},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  "QoS volume burst should set limit",
+		},
+		"burst without duration": {
+			name:    testQosVolumeName,
+			in:      &bridgepb.QosBurst{RwIopsKiops: 4},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.Unknown,
+			errMsg:  "missing required field: burst.duration",
+		},
+		"burst with zero duration": {
+			name:    testQosVolumeName,
+			in:      &bridgepb.QosBurst{RwIopsKiops: 4, Duration: durationpb.New(0)},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  "QoS volume burst duration must be positive",
+		},
+		"unknown volume": {
+			name:    "unknown-qos-volume",
+			in:      &bridgepb.QosBurst{RwIopsKiops: 4, Duration: durationpb.New(10 * time.Second)},
+			out:     nil,
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  "unable to find key unknown-qos-volume",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+			testEnv.opiSpdkServer.qos.volumes[testQosVolumeName] = &qosControlledVolume{
+				bdevName: "Malloc0",
+				limits:   testQosBurstLimits,
+				applied:  &pb.QosLimit{RwIopsKiops: 2, RwBandwidthMbs: 100},
+			}
+
+			request := &bridgepb.SetQosVolumeBurstRequest{Name: tt.name, Burst: tt.in}
+			response, err := testEnv.client.SetQosVolumeBurst(testEnv.ctx, request)
+
+			if !proto.Equal(response, tt.out) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_QosVolumeBurstCredits(t *testing.T) {
+	burst := &bridgepb.QosBurst{RwIopsKiops: 4, Duration: durationpb.New(10 * time.Second)}
+	tests := map[string]struct {
+		credits   float64
+		iostat    string
+		spdk      []string
+		out       int64
+		effective *pb.QosLimit
+	}{
+		"credits are earned below max_limit": {
+			credits:   0,
+			iostat:    testIostatResponse(2000, 500, 500),
+			spdk:      []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			out:       1000,
+			effective: &pb.QosLimit{RwIopsKiops: 4},
+		},
+		"credits are capped": {
+			credits:   19500,
+			iostat:    testIostatResponse(2000, 0, 0),
+			spdk:      []string{},
+			out:       20000,
+			effective: &pb.QosLimit{RwIopsKiops: 4},
+		},
+		"credits are spent above max_limit": {
+			credits:   10000,
+			iostat:    testIostatResponse(2000, 2000, 2000),
+			spdk:      []string{},
+			out:       8000,
+			effective: &pb.QosLimit{RwIopsKiops: 4},
+		},
+		"max_limit is restored without credits": {
+			credits:   1000,
+			iostat:    testIostatResponse(2000, 2000, 2000),
+			spdk:      []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			out:       0,
+			effective: &pb.QosLimit{RwIopsKiops: 2},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(append([]string{tt.iostat}, tt.spdk...))
+			defer testEnv.Close()
+			applied := &pb.QosLimit{RwIopsKiops: 2}
+			if tt.credits > 0 {
+				applied = &pb.QosLimit{RwIopsKiops: 4}
+			}
+			testEnv.opiSpdkServer.qos.volumes[testQosVolumeName] = &qosControlledVolume{
+				bdevName: "Malloc0",
+				limits:   &pb.Limits{Max: &pb.QosLimit{RwIopsKiops: 2}},
+				applied:  applied,
+				last:     &server.VolumeStats{Uptime: time.Second},
+				burst:    burst,
+				credits:  qosCredits{ios: tt.credits},
+			}
+
+			testEnv.opiSpdkServer.adjustQosLimits()
+
+			request := &bridgepb.GetQosVolumeStatusRequest{Name: testQosVolumeName}
+			response, err := testEnv.client.GetQosVolumeStatus(testEnv.ctx, request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if response.IoCredits != tt.out {
+				t.Error("credits: expected", tt.out, "received", response.IoCredits)
+			}
+			if !proto.Equal(response.Effective, tt.effective) {
+				t.Error("effective: expected", tt.effective, "received", response.Effective)
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_UpdateQosVolumeKeepsBurst(t *testing.T) {
+	tests := map[string]struct {
+		max     *pb.QosLimit
+		spdk    []string
+		errCode codes.Code
+		errMsg  string
+	}{
+		"burst still above max_limit": {
+			max:     &pb.QosLimit{RwIopsKiops: 3},
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"burst no longer above max_limit": {
+			max:     &pb.QosLimit{RwIopsKiops: 5},
+			spdk:    []string{},
+			errCode: codes.InvalidArgument,
+			errMsg:  "QoS volume burst rw_iops_kiops must exceed max_limit rw_iops_kiops",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+			burst := &bridgepb.QosBurst{RwIopsKiops: 4, Duration: durationpb.New(10 * time.Second)}
+			testEnv.opiSpdkServer.volumes.qosVolumes[testQosVolumeName] = &pb.QosVolume{
+				Name:          testQosVolumeName,
+				VolumeNameRef: "volume-42",
+				Limits:        &pb.Limits{Max: &pb.QosLimit{RwIopsKiops: 2}},
+			}
+			testEnv.opiSpdkServer.qos.volumes[testQosVolumeName] = &qosControlledVolume{
+				bdevName: "Malloc0",
+				limits:   &pb.Limits{Max: &pb.QosLimit{RwIopsKiops: 2}},
+				applied:  &pb.QosLimit{RwIopsKiops: 4},
+				burst:    burst,
+				credits:  qosCredits{ios: 20000},
+			}
+
+			request := &pb.UpdateQosVolumeRequest{QosVolume: &pb.QosVolume{
+				Name:          testQosVolumeName,
+				VolumeNameRef: "volume-42",
+				Limits:        &pb.Limits{Max: tt.max},
+			}}
+			_, err := testEnv.client.UpdateQosVolume(testEnv.ctx, request)
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+
+			v := testEnv.opiSpdkServer.qos.volumes[testQosVolumeName]
+			if v.burst != burst {
+				t.Error("burst: expected", burst, "received", v.burst)
+			}
+			if tt.errCode == codes.OK && v.credits.ios != 10000 {
+				t.Error("credits: expected", 10000, "received", v.credits.ios)
+			}
+		})
+	}
+}
@@ -0,0 +1,320 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package middleend implements the MiddleEnd APIs (service) of the storage Server
+package middleend
+
+import (
+	"context"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/opiproject/gospdk/spdk"
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// qosController enforces limits of QoS volumes which bdev_set_qos_limit has
+// no knob for. Read-only and write-only IOPS caps are translated into a rw
+// IOPS cap based on the read/write mix observed through bdev_get_iostat.
+// Minimum limits are guaranteed by throttling the QoS volumes without
+// minimum limits while a guaranteed volume gets less than its minimum.
+// Volumes not managed as QoS volumes are not throttled
+type qosController struct {
+	// mu serializes changes of limits in SPDK between QoS volume
+	// requests and adjustments
+	mu      sync.Mutex
+	volumes map[string]*qosControlledVolume
+	// policies are QoS policies, their attached volumes are kept in
+	// volumes as well
+	policies map[string]*qosPolicy
+	// groups are QoS groups, their members are kept in volumes as well
+	groups map[string]*qosGroup
+}
+
+// qosControlledVolume is the state of a QoS volume kept by the controller
+type qosControlledVolume struct {
+	bdevName string
+	limits   *pb.Limits
+	// applied is the limit set in SPDK
+	applied *pb.QosLimit
+	// last is the previous bdev_get_iostat sample
+	last *server.VolumeStats
+	// rates are observed between the last two samples, zero if there are
+	// no rates to observe
+	rates server.VolumeRates
+	// throttledKiops caps rw IOPS of a volume without minimum limits in
+	// favour of guaranteed volumes, 0 if the volume is not throttled
+	throttledKiops int64
+	// burst allows to exceed max_limit while credits last, nil if the
+	// volume does not burst
+	burst   *bridgepb.QosBurst
+	credits qosCredits
+	// group is the QoS group the volume is a member of, empty otherwise.
+	// max_limit of a member is its share of the group budget
+	group string
+}
+
+func newQosController() qosController {
+	return qosController{
+		volumes:  make(map[string]*qosControlledVolume),
+		policies: make(map[string]*qosPolicy),
+		groups:   make(map[string]*qosGroup),
+	}
+}
+
+// applyQosLimits sets limits of a QoS volume in SPDK and hands the volume
+// over to the controller. Observed rates are discarded, a burst configured
+// for the volume is kept
+func (s *Server) applyQosLimits(name string, bdevName string, limits *pb.Limits) error {
+	s.qos.mu.Lock()
+	defer s.qos.mu.Unlock()
+	return s.setQosLimits(name, bdevName, limits)
+}
+
+// setQosLimits is applyQosLimits for callers holding s.qos.mu
+func (s *Server) setQosLimits(name string, bdevName string, limits *pb.Limits) error {
+	for other, v := range s.qos.volumes {
+		if other != name && v.bdevName == bdevName {
+			err := status.Errorf(codes.FailedPrecondition, "bdev %s already has QoS limits of %s", bdevName, other)
+			log.Printf("error: %v", err)
+			return err
+		}
+	}
+	v := &qosControlledVolume{
+		bdevName: bdevName,
+		limits:   server.ProtoClone(limits),
+	}
+	if previous, ok := s.qos.volumes[name]; ok && previous.burst != nil {
+		if err := verifyQosBurst(previous.burst, limits); err != nil {
+			log.Printf("error: %v", err)
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		v.burst = previous.burst
+		v.credits = previous.credits
+		v.credits.clamp(v.burst, limits)
+	}
+	limit := v.effectiveLimit()
+	if err := s.setMaxLimit(bdevName, limit); err != nil {
+		return err
+	}
+	v.applied = limit
+	s.qos.volumes[name] = v
+	return nil
+}
+
+// removeQosLimits cleans limits of a QoS volume in SPDK and stops
+// controlling the volume
+func (s *Server) removeQosLimits(name string, bdevName string) error {
+	s.qos.mu.Lock()
+	defer s.qos.mu.Unlock()
+	return s.unsetQosLimits(name, bdevName)
+}
+
+// unsetQosLimits is removeQosLimits for callers holding s.qos.mu
+func (s *Server) unsetQosLimits(name string, bdevName string) error {
+	if err := s.cleanMaxLimit(bdevName); err != nil {
+		return err
+	}
+	delete(s.qos.volumes, name)
+	return nil
+}
+
+// RunQosController adjusts limits of QoS volumes every interval until ctx
+// is done
+func (s *Server) RunQosController(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.adjustQosLimits()
+		}
+	}
+}
+
+// adjustQosLimits samples QoS volumes and updates limits set in SPDK where
+// the observed rates require it. Limits of QoS policies which failed to be
+// applied to volumes are retried first and members of QoS groups are
+// updated before sampling. Budgets of QoS groups are divided based on the
+// new samples. A failure is logged and only affects the volume it occurs
+// for
+func (s *Server) adjustQosLimits() {
+	s.qos.mu.Lock()
+	defer s.qos.mu.Unlock()
+	s.retryQosPolicies(time.Now())
+	s.syncQosGroups()
+
+	names := make([]string, 0, len(s.qos.volumes))
+	guaranteed := false
+	for name, v := range s.qos.volumes {
+		names = append(names, name)
+		guaranteed = guaranteed || v.guaranteed()
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		v := s.qos.volumes[name]
+		if !guaranteed && !v.directional() && v.burst == nil && v.group == "" {
+			v.last = nil
+			v.rates = server.VolumeRates{}
+			continue
+		}
+		if err := s.sampleQosVolume(v); err != nil {
+			log.Printf("error: could not sample QoS volume %v: %v", name, err)
+			continue
+		}
+		v.updateCredits()
+	}
+	s.divideQosGroups()
+
+	deficit := false
+	for _, name := range names {
+		if v := s.qos.volumes[name]; v.guaranteed() && v.belowMin() {
+			log.Printf("QoS volume %v gets less than its min_limit: %+v", name, v.rates)
+			deficit = true
+		}
+	}
+
+	for _, name := range names {
+		v := s.qos.volumes[name]
+		if !v.guaranteed() {
+			v.throttle(deficit)
+		}
+		limit := v.effectiveLimit()
+		if proto.Equal(limit, v.applied) {
+			continue
+		}
+		log.Printf("Adjusting max limit of QoS volume %v to %v", name, limit)
+		if err := s.setMaxLimit(v.bdevName, limit); err != nil {
+			log.Printf("error: could not adjust QoS volume %v: %v", name, err)
+			continue
+		}
+		v.applied = limit
+	}
+}
+
+func (s *Server) sampleQosVolume(v *qosControlledVolume) error {
+	params := spdk.BdevGetIostatParams{
+		Name: v.bdevName,
+	}
+	var result spdk.BdevGetIostatResult
+	err := s.rpc.Call("bdev_get_iostat", &params, &result)
+	if err != nil {
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if len(result.Bdevs) != 1 {
+		return spdk.ErrUnexpectedSpdkCallResult
+	}
+	sample := server.NewVolumeStats(&result)
+	v.rates = server.VolumeRates{}
+	if rates := sample.RatesSince(v.last); rates != nil {
+		v.rates = *rates
+	}
+	v.last = sample
+	return nil
+}
+
+// directional tells if the volume has read-only or write-only IOPS caps
+func (v *qosControlledVolume) directional() bool {
+	return v.limits.GetMax().GetRdIopsKiops() > 0 || v.limits.GetMax().GetWrIopsKiops() > 0
+}
+
+// guaranteed tells if the volume has minimum limits
+func (v *qosControlledVolume) guaranteed() bool {
+	return v.limits.GetMin() != nil
+}
+
+// belowMin tells if the volume gets less than its minimum in a direction
+// it is used in. An idle volume is not below its minimum, as the bridge
+// cannot tell a volume which is starved from one which has no demand
+func (v *qosControlledVolume) belowMin() bool {
+	minLimit := v.limits.GetMin()
+	r := v.rates
+	reading := r.ReadIops > 0
+	writing := r.WriteIops > 0
+	return (reading && r.ReadIops < float64(minLimit.GetRdIopsKiops()*1000)) ||
+		(writing && r.WriteIops < float64(minLimit.GetWrIopsKiops()*1000)) ||
+		((reading || writing) && r.ReadIops+r.WriteIops < float64(minLimit.GetRwIopsKiops()*1000)) ||
+		(reading && r.ReadMbs < float64(minLimit.GetRdBandwidthMbs())) ||
+		(writing && r.WriteMbs < float64(minLimit.GetWrBandwidthMbs())) ||
+		((reading || writing) && r.ReadMbs+r.WriteMbs < float64(minLimit.GetRwBandwidthMbs()))
+}
+
+// throttle halves the rw IOPS cap of an active volume while a guaranteed
+// volume is below its minimum, and raises it by a quarter otherwise until
+// the volume no longer uses it or its own cap is reached
+func (v *qosControlledVolume) throttle(deficit bool) {
+	observedKiops := int64((v.rates.ReadIops + v.rates.WriteIops) / 1000)
+	switch {
+	case deficit && (observedKiops > 0 || v.throttledKiops > 0):
+		current := observedKiops
+		if v.throttledKiops > 0 && (current == 0 || v.throttledKiops < current) {
+			current = v.throttledKiops
+		}
+		v.throttledKiops = current / 2
+		if v.throttledKiops < 1 {
+			v.throttledKiops = 1
+		}
+	case !deficit && v.throttledKiops > 0:
+		maxKiops := v.limits.GetMax().GetRwIopsKiops()
+		v.throttledKiops += (v.throttledKiops + 3) / 4
+		if observedKiops*2 < v.throttledKiops || (maxKiops > 0 && v.throttledKiops >= maxKiops) {
+			v.throttledKiops = 0
+		}
+	}
+}
+
+// effectiveLimit is the limit to set in SPDK. The burst caps replace
+// max_limit while there are credits left. Read-only and write-only IOPS
+// caps are converted into the rw IOPS cap keeping each direction within its
+// cap for the observed read/write mix. Without observed I/O the lowest cap
+// is used, as the mix is not known
+func (v *qosControlledVolume) effectiveLimit() *pb.QosLimit {
+	maxLimit := v.limits.GetMax()
+	limit := &pb.QosLimit{
+		RwIopsKiops:    maxLimit.GetRwIopsKiops(),
+		RdBandwidthMbs: maxLimit.GetRdBandwidthMbs(),
+		WrBandwidthMbs: maxLimit.GetWrBandwidthMbs(),
+		RwBandwidthMbs: maxLimit.GetRwBandwidthMbs(),
+	}
+	if v.burst != nil && v.burst.RwIopsKiops > 0 && v.credits.ios > 0 {
+		limit.RwIopsKiops = v.burst.RwIopsKiops
+	}
+	if v.burst != nil && v.burst.RwBandwidthMbs > 0 && v.credits.mbs > 0 {
+		limit.RwBandwidthMbs = v.burst.RwBandwidthMbs
+	}
+	lower := func(kiops int64) {
+		if kiops > 0 && (limit.RwIopsKiops == 0 || kiops < limit.RwIopsKiops) {
+			limit.RwIopsKiops = kiops
+		}
+	}
+	iops := v.rates.ReadIops + v.rates.WriteIops
+	for _, direction := range []struct {
+		kiops int64
+		iops  float64
+	}{
+		{maxLimit.GetRdIopsKiops(), v.rates.ReadIops},
+		{maxLimit.GetWrIopsKiops(), v.rates.WriteIops},
+	} {
+		switch {
+		case direction.kiops == 0:
+		case iops == 0:
+			lower(direction.kiops)
+		case direction.iops > 0:
+			lower(int64(float64(direction.kiops) * iops / direction.iops))
+		}
+	}
+	lower(v.throttledKiops)
+	return limit
+}
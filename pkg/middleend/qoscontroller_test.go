@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package middleend implements the MiddleEnd APIs (service) of the storage Server
+package middleend
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"google.golang.org/protobuf/proto"
+)
+
+func testIostatResponse(ticks int, readOps int, writeOps int) string {
+	return fmt.Sprintf(`{"id":%%d,"error":{"code":0,"message":""},"result":{"tick_rate":1000,"ticks":%d,`+
+		`"bdevs":[{"name":"Malloc0","num_read_ops":%d,"num_write_ops":%d}]}}`, ticks, readOps, writeOps)
+}
+
+func TestMiddleEnd_AdjustQosLimits(t *testing.T) {
+	tests := map[string]struct {
+		volumes   map[string]*qosControlledVolume
+		spdk      []string
+		applied   map[string]*pb.QosLimit
+		throttled map[string]int64
+	}{
+		"no directional caps nor guarantees": {
+			volumes: map[string]*qosControlledVolume{
+				"qos-a": {
+					limits:  &pb.Limits{Max: &pb.QosLimit{RwIopsKiops: 10}},
+					applied: &pb.QosLimit{RwIopsKiops: 10},
+				},
+			},
+			spdk:    []string{},
+			applied: map[string]*pb.QosLimit{"qos-a": {RwIopsKiops: 10}},
+		},
+		"first sample keeps lowest directional cap": {
+			volumes: map[string]*qosControlledVolume{
+				"qos-a": {
+					limits:  &pb.Limits{Max: &pb.QosLimit{RdIopsKiops: 2, WrIopsKiops: 8}},
+					applied: &pb.QosLimit{RwIopsKiops: 2},
+				},
+			},
+			spdk:    []string{testIostatResponse(1000, 0, 0)},
+			applied: map[string]*pb.QosLimit{"qos-a": {RwIopsKiops: 2}},
+		},
+		"directional caps follow read/write mix": {
+			volumes: map[string]*qosControlledVolume{
+				"qos-a": {
+					limits:  &pb.Limits{Max: &pb.QosLimit{RdIopsKiops: 2, WrIopsKiops: 8, RwBandwidthMbs: 100}},
+					applied: &pb.QosLimit{RwIopsKiops: 2, RwBandwidthMbs: 100},
+					last:    &server.VolumeStats{Uptime: time.Second},
+				},
+			},
+			spdk: []string{
+				testIostatResponse(2000, 1000, 3000),
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			applied: map[string]*pb.QosLimit{"qos-a": {RwIopsKiops: 8, RwBandwidthMbs: 100}},
+		},
+		"rw cap is kept if lower": {
+			volumes: map[string]*qosControlledVolume{
+				"qos-a": {
+					limits:  &pb.Limits{Max: &pb.QosLimit{WrIopsKiops: 8, RwIopsKiops: 4}},
+					applied: &pb.QosLimit{RwIopsKiops: 4},
+					last:    &server.VolumeStats{Uptime: time.Second},
+				},
+			},
+			spdk:    []string{testIostatResponse(2000, 3000, 1000)},
+			applied: map[string]*pb.QosLimit{"qos-a": {RwIopsKiops: 4}},
+		},
+		"failed adjustment is retried": {
+			volumes: map[string]*qosControlledVolume{
+				"qos-a": {
+					limits:  &pb.Limits{Max: &pb.QosLimit{RdIopsKiops: 2}},
+					applied: &pb.QosLimit{RwIopsKiops: 2},
+					last:    &server.VolumeStats{Uptime: time.Second},
+				},
+			},
+			spdk: []string{
+				testIostatResponse(2000, 1000, 1000),
+				`{"id":%d,"error":{"code":0,"message":""},"result":false}`,
+			},
+			applied: map[string]*pb.QosLimit{"qos-a": {RwIopsKiops: 2}},
+		},
+		"best effort volume is throttled below guarantee": {
+			volumes: map[string]*qosControlledVolume{
+				"qos-a": {
+					limits:  &pb.Limits{Min: &pb.QosLimit{RwIopsKiops: 5}},
+					applied: &pb.QosLimit{},
+					last:    &server.VolumeStats{Uptime: time.Second},
+				},
+				"qos-b": {
+					limits:  &pb.Limits{Max: &pb.QosLimit{RwBandwidthMbs: 100}},
+					applied: &pb.QosLimit{RwBandwidthMbs: 100},
+					last:    &server.VolumeStats{Uptime: time.Second},
+				},
+			},
+			spdk: []string{
+				testIostatResponse(2000, 2000, 0),
+				testIostatResponse(2000, 10000, 10000),
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			applied: map[string]*pb.QosLimit{
+				"qos-a": {},
+				"qos-b": {RwIopsKiops: 10, RwBandwidthMbs: 100},
+			},
+			throttled: map[string]int64{"qos-b": 10},
+		},
+		"idle guaranteed volume does not throttle": {
+			volumes: map[string]*qosControlledVolume{
+				"qos-a": {
+					limits:  &pb.Limits{Min: &pb.QosLimit{RwIopsKiops: 5}},
+					applied: &pb.QosLimit{},
+					last:    &server.VolumeStats{Uptime: time.Second},
+				},
+				"qos-b": {
+					limits:  &pb.Limits{Max: &pb.QosLimit{RwBandwidthMbs: 100}},
+					applied: &pb.QosLimit{RwBandwidthMbs: 100},
+					last:    &server.VolumeStats{Uptime: time.Second},
+				},
+			},
+			spdk: []string{
+				testIostatResponse(2000, 0, 0),
+				testIostatResponse(2000, 10000, 10000),
+			},
+			applied: map[string]*pb.QosLimit{
+				"qos-a": {},
+				"qos-b": {RwBandwidthMbs: 100},
+			},
+		},
+		"throttled volume is released once guarantee is met": {
+			volumes: map[string]*qosControlledVolume{
+				"qos-a": {
+					limits:  &pb.Limits{Min: &pb.QosLimit{RwIopsKiops: 5}},
+					applied: &pb.QosLimit{},
+					last:    &server.VolumeStats{Uptime: time.Second},
+				},
+				"qos-b": {
+					limits:         &pb.Limits{Max: &pb.QosLimit{RwIopsKiops: 12}},
+					applied:        &pb.QosLimit{RwIopsKiops: 10},
+					last:           &server.VolumeStats{Uptime: time.Second},
+					throttledKiops: 10,
+				},
+			},
+			spdk: []string{
+				testIostatResponse(2000, 6000, 0),
+				testIostatResponse(2000, 5000, 5000),
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			applied: map[string]*pb.QosLimit{
+				"qos-a": {},
+				"qos-b": {RwIopsKiops: 12},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+			for volumeName, v := range tt.volumes {
+				v.bdevName = "Malloc0"
+				testEnv.opiSpdkServer.qos.volumes[volumeName] = v
+			}
+
+			testEnv.opiSpdkServer.adjustQosLimits()
+
+			for volumeName, expected := range tt.applied {
+				v := testEnv.opiSpdkServer.qos.volumes[volumeName]
+				if !proto.Equal(v.applied, expected) {
+					t.Error(volumeName, "applied: expected", expected, "received", v.applied)
+				}
+				if v.throttledKiops != tt.throttled[volumeName] {
+					t.Error(volumeName, "throttled: expected", tt.throttled[volumeName], "received", v.throttledKiops)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,478 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package middleend implements the MiddleEnd APIs (service) of the storage Server
+package middleend
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+
+	"go.einride.tech/aip/fieldbehavior"
+	"go.einride.tech/aip/resourceid"
+	"go.einride.tech/aip/resourcename"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Shares of a QoS group budget follow the use of the members observed by
+// the QoS controller. A member using at least qosGroupSaturation of its
+// share may need more and competes for the budget, other members are
+// entitled to what they use plus qosGroupHeadroom
+const (
+	qosGroupSaturation = 0.9
+	qosGroupHeadroom   = 1.25
+)
+
+// qosGroup is a QoS group with its members keyed by volume name
+type qosGroup struct {
+	group   *bridgepb.QosGroup
+	members map[string]*bridgepb.QosGroupMember
+}
+
+// qosGroupBdev is a resolved member of a QoS group. Volumes referenced by
+// VolumeNameRefs are pinned, i.e. marked as used by the group. Volumes of
+// frontend objects are used by the frontend already
+type qosGroupBdev struct {
+	bdevName string
+	pinned   bool
+}
+
+// qosGroupVolumeKey identifies a member of a group among volumes kept by
+// the QoS controller
+func qosGroupVolumeKey(groupName string, volumeName string) string {
+	return groupName + "#" + volumeName
+}
+
+func (s *Server) getQosGroup(name string) (*qosGroup, error) {
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// fetch object from the database
+	group, ok := s.qos.groups[name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	return group, nil
+}
+
+// CreateQosGroup creates a QoS group and divides its budget between the
+// members. A member the share cannot be set for is reported in the
+// returned status and retried by the QoS controller
+func (s *Server) CreateQosGroup(_ context.Context, in *bridgepb.CreateQosGroupRequest) (*bridgepb.QosGroupStatus, error) {
+	log.Printf("CreateQosGroup: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// see https://google.aip.dev/133#user-specified-ids
+	resourceID := resourceid.NewSystemGenerated()
+	if in.QosGroupId != "" {
+		err := resourceid.ValidateUserSettable(in.QosGroupId)
+		if err != nil {
+			log.Printf("error: %v", err)
+			return nil, err
+		}
+		log.Printf("client provided the ID of a resource %v, ignoring the name field %v", in.QosGroupId, in.QosGroup.Name)
+		resourceID = in.QosGroupId
+	}
+	name := server.ResourceIDToVolumeName(resourceID)
+	if err := verifyQosGroup(in.QosGroup); err != nil {
+		log.Printf("error: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	s.qos.mu.Lock()
+	defer s.qos.mu.Unlock()
+	// idempotent API when called with same key, should return same object
+	if group, ok := s.qos.groups[name]; ok {
+		log.Printf("Already existing QosGroup with id %v", name)
+		return group.status(), nil
+	}
+	group := &qosGroup{
+		group:   server.ProtoClone(in.QosGroup),
+		members: make(map[string]*bridgepb.QosGroupMember),
+	}
+	group.group.Name = name
+	bdevs, err := s.resolveQosGroup(group.group)
+	if err != nil {
+		return nil, err
+	}
+	s.qos.groups[name] = group
+	s.syncQosGroup(group, bdevs)
+	s.divideQosGroup(group)
+	s.applyQosGroup(group)
+	response := group.status()
+	log.Printf("CreateQosGroup: Sending to client: %v", server.Redact(response))
+	return response, nil
+}
+
+// DeleteQosGroup deletes a QoS group and cleans limits of its members
+func (s *Server) DeleteQosGroup(_ context.Context, in *bridgepb.DeleteQosGroupRequest) (*emptypb.Empty, error) {
+	log.Printf("DeleteQosGroup: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	s.qos.mu.Lock()
+	defer s.qos.mu.Unlock()
+	if _, ok := s.qos.groups[in.Name]; !ok && in.AllowMissing {
+		return &emptypb.Empty{}, nil
+	}
+	group, err := s.getQosGroup(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	for _, volumeName := range group.sortedMemberNames() {
+		if err := s.removeQosGroupMember(group, volumeName); err != nil {
+			return nil, err
+		}
+	}
+	delete(s.qos.groups, in.Name)
+	return &emptypb.Empty{}, nil
+}
+
+// UpdateQosGroup changes the budget or the members of a QoS group and
+// divides the budget again
+func (s *Server) UpdateQosGroup(_ context.Context, in *bridgepb.UpdateQosGroupRequest) (*bridgepb.QosGroupStatus, error) {
+	log.Printf("UpdateQosGroup: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	s.qos.mu.Lock()
+	defer s.qos.mu.Unlock()
+	group, err := s.getQosGroup(in.QosGroup.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyQosGroup(in.QosGroup); err != nil {
+		log.Printf("error: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	updated := server.ProtoClone(in.QosGroup)
+	bdevs, err := s.resolveQosGroup(updated)
+	if err != nil {
+		return nil, err
+	}
+	group.group = updated
+	s.syncQosGroup(group, bdevs)
+	s.divideQosGroup(group)
+	s.applyQosGroup(group)
+	return group.status(), nil
+}
+
+// GetQosGroupStatus gets a QoS group together with its members and their
+// shares of the budget
+func (s *Server) GetQosGroupStatus(_ context.Context, in *bridgepb.GetQosGroupStatusRequest) (*bridgepb.QosGroupStatus, error) {
+	log.Printf("GetQosGroupStatus: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	s.qos.mu.Lock()
+	defer s.qos.mu.Unlock()
+	group, err := s.getQosGroup(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	return group.status(), nil
+}
+
+// resolveQosGroup resolves the members of a group to their bdevs keyed by
+// volume name. References which cannot be resolved are skipped and the
+// first failure is returned together with the resolved members
+func (s *Server) resolveQosGroup(group *bridgepb.QosGroup) (map[string]qosGroupBdev, error) {
+	bdevs := make(map[string]qosGroupBdev)
+	var firstErr error
+	resolve := func(ref string, pinned bool) {
+		vol, err := s.registry.Get(ref)
+		if err != nil {
+			log.Printf("error: %v", err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			return
+		}
+		bdevs[vol.Name] = qosGroupBdev{
+			bdevName: vol.BdevName,
+			pinned:   pinned || bdevs[vol.Name].pinned,
+		}
+	}
+	for _, ref := range group.VolumeNameRefs {
+		resolve(ref, true)
+	}
+	for _, frontendRef := range group.FrontendNameRefs {
+		refs, err := s.registry.VolumesOf(frontendRef)
+		if err != nil {
+			log.Printf("error: %v", err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		for _, ref := range refs {
+			resolve(ref, false)
+		}
+	}
+	return bdevs, firstErr
+}
+
+// syncQosGroups follows changes of members of QoS groups, e.g. namespaces
+// added to a subsystem. Callers hold s.qos.mu
+func (s *Server) syncQosGroups() {
+	for _, name := range s.sortedQosGroupNames() {
+		group := s.qos.groups[name]
+		bdevs, err := s.resolveQosGroup(group.group)
+		if err != nil {
+			log.Printf("error: could not resolve all members of QoS group %v: %v", name, err)
+		}
+		s.syncQosGroup(group, bdevs)
+	}
+}
+
+// syncQosGroup makes bdevs the members of a group. Limits of removed
+// members are cleaned. New members get an equal share of the budget until
+// the budget is divided according to their use
+func (s *Server) syncQosGroup(group *qosGroup, bdevs map[string]qosGroupBdev) {
+	for _, volumeName := range group.sortedMemberNames() {
+		if _, ok := bdevs[volumeName]; ok {
+			continue
+		}
+		if err := s.removeQosGroupMember(group, volumeName); err != nil {
+			log.Printf("error: could not remove %v from QoS group %v: %v", volumeName, group.group.Name, err)
+		}
+	}
+
+	volumeNames := make([]string, 0, len(bdevs))
+	for volumeName := range bdevs {
+		volumeNames = append(volumeNames, volumeName)
+	}
+	sort.Strings(volumeNames)
+	share := group.equalShare(len(volumeNames))
+	for _, volumeName := range volumeNames {
+		key := qosGroupVolumeKey(group.group.Name, volumeName)
+		bdev := bdevs[volumeName]
+		if bdev.pinned {
+			s.acquireVolume(volumeName, key)
+		} else {
+			s.registry.Release(volumeName, key)
+		}
+		member, ok := group.members[volumeName]
+		if !ok {
+			member = &bridgepb.QosGroupMember{VolumeNameRef: volumeName}
+			group.members[volumeName] = member
+		}
+		if _, ok := s.qos.volumes[key]; ok {
+			continue
+		}
+		if err := s.setQosLimits(key, bdev.bdevName, &pb.Limits{Max: share}); err != nil {
+			member.Error = status.Convert(err).Message()
+			continue
+		}
+		s.qos.volumes[key].group = group.group.Name
+		member.Share = server.ProtoClone(share)
+		member.Error = ""
+	}
+}
+
+// removeQosGroupMember cleans limits of a member and removes it from the
+// group. Limits of a volume which no longer exists are not cleaned, as
+// there is no bdev to clean them on
+func (s *Server) removeQosGroupMember(group *qosGroup, volumeName string) error {
+	key := qosGroupVolumeKey(group.group.Name, volumeName)
+	if v, ok := s.qos.volumes[key]; ok {
+		if _, err := s.registry.Get(volumeName); err != nil {
+			delete(s.qos.volumes, key)
+		} else if err := s.unsetQosLimits(key, v.bdevName); err != nil {
+			group.members[volumeName].Error = status.Convert(err).Message()
+			return err
+		}
+	}
+	delete(group.members, volumeName)
+	s.registry.Release(volumeName, key)
+	return nil
+}
+
+// divideQosGroups divides budgets of QoS groups according to the rates
+// observed for the members. Callers hold s.qos.mu
+func (s *Server) divideQosGroups() {
+	for _, name := range s.sortedQosGroupNames() {
+		s.divideQosGroup(s.qos.groups[name])
+	}
+}
+
+// divideQosGroup sets max_limit of the members the QoS controller keeps to
+// their share of the budget. The shares are set in SPDK together with the
+// other limits of the controlled volumes
+func (s *Server) divideQosGroup(group *qosGroup) {
+	var members []*bridgepb.QosGroupMember
+	var volumes []*qosControlledVolume
+	for _, volumeName := range group.sortedMemberNames() {
+		if v, ok := s.qos.volumes[qosGroupVolumeKey(group.group.Name, volumeName)]; ok {
+			members = append(members, group.members[volumeName])
+			volumes = append(volumes, v)
+		}
+	}
+	iopsDemands := make([]float64, len(volumes))
+	mbsDemands := make([]float64, len(volumes))
+	for i, v := range volumes {
+		iopsDemands[i] = qosGroupDemand((v.rates.ReadIops+v.rates.WriteIops)/1000, v.limits.GetMax().GetRwIopsKiops())
+		mbsDemands[i] = qosGroupDemand(v.rates.ReadMbs+v.rates.WriteMbs, v.limits.GetMax().GetRwBandwidthMbs())
+	}
+	iopsShares := divideQosBudget(group.group.Limit.GetRwIopsKiops(), iopsDemands)
+	mbsShares := divideQosBudget(group.group.Limit.GetRwBandwidthMbs(), mbsDemands)
+	for i, v := range volumes {
+		share := &pb.QosLimit{RwIopsKiops: iopsShares[i], RwBandwidthMbs: mbsShares[i]}
+		v.limits = &pb.Limits{Max: share}
+		members[i].Share = server.ProtoClone(share)
+	}
+}
+
+// applyQosGroup sets shares of the members in SPDK where they changed and
+// reports failures in the members
+func (s *Server) applyQosGroup(group *qosGroup) {
+	for _, volumeName := range group.sortedMemberNames() {
+		v, ok := s.qos.volumes[qosGroupVolumeKey(group.group.Name, volumeName)]
+		if !ok {
+			continue
+		}
+		member := group.members[volumeName]
+		limit := v.effectiveLimit()
+		if proto.Equal(limit, v.applied) {
+			member.Error = ""
+			continue
+		}
+		if err := s.setMaxLimit(v.bdevName, limit); err != nil {
+			member.Error = status.Convert(err).Message()
+			continue
+		}
+		v.applied = limit
+		member.Error = ""
+	}
+}
+
+// qosGroupDemand estimates what a member needs of a budget from what it
+// used and the share it was limited to
+func qosGroupDemand(observed float64, share int64) float64 {
+	if observed > 0 && observed >= qosGroupSaturation*float64(share) {
+		return math.Inf(1)
+	}
+	return observed * qosGroupHeadroom
+}
+
+// divideQosBudget divides budget between members by max-min fairness. A
+// member gets its demand, up to an equal share of the budget left by
+// members demanding less. Budget nobody demands is divided equally. Shares
+// are rounded down, but are at least 1 as 0 would lift the limit. A budget
+// of 0 is no limit and so is every share of it
+func divideQosBudget(budget int64, demands []float64) []int64 {
+	shares := make([]int64, len(demands))
+	if budget == 0 || len(demands) == 0 {
+		return shares
+	}
+	exact := make([]float64, len(demands))
+	remaining := float64(budget)
+	unsatisfied := make([]int, len(demands))
+	for i := range demands {
+		unsatisfied[i] = i
+	}
+	for len(unsatisfied) > 0 {
+		fair := remaining / float64(len(unsatisfied))
+		var next []int
+		for _, i := range unsatisfied {
+			if demands[i] <= fair {
+				exact[i] = demands[i]
+				remaining -= demands[i]
+			} else {
+				next = append(next, i)
+			}
+		}
+		if len(next) == len(unsatisfied) {
+			for _, i := range next {
+				exact[i] = fair
+			}
+			remaining = 0
+			break
+		}
+		unsatisfied = next
+	}
+	for i := range exact {
+		shares[i] = int64(exact[i] + remaining/float64(len(demands)))
+		if shares[i] < 1 {
+			shares[i] = 1
+		}
+	}
+	return shares
+}
+
+// equalShare divides the budget equally between n members
+func (g *qosGroup) equalShare(n int) *pb.QosLimit {
+	demands := make([]float64, n)
+	share := &pb.QosLimit{}
+	if n > 0 {
+		share.RwIopsKiops = divideQosBudget(g.group.Limit.GetRwIopsKiops(), demands)[0]
+		share.RwBandwidthMbs = divideQosBudget(g.group.Limit.GetRwBandwidthMbs(), demands)[0]
+	}
+	return share
+}
+
+func verifyQosGroup(group *bridgepb.QosGroup) error {
+	if len(group.VolumeNameRefs) == 0 && len(group.FrontendNameRefs) == 0 {
+		return fmt.Errorf("QoS group should set volume_name_refs or frontend_name_refs")
+	}
+	for _, field := range qosLimitFields(group.Limit) {
+		if field.value < 0 {
+			return fmt.Errorf("QoS group limit %v cannot be negative", field.name)
+		}
+		if field.value > 0 && field.name != "rw_iops_kiops" && field.name != "rw_bandwidth_mbs" {
+			return fmt.Errorf("QoS group limit %v is not supported", field.name)
+		}
+	}
+	if isEmptyQosLimit(group.Limit) {
+		return fmt.Errorf("QoS group limit should set limit")
+	}
+	return nil
+}
+
+func (s *Server) sortedQosGroupNames() []string {
+	names := make([]string, 0, len(s.qos.groups))
+	for name := range s.qos.groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (g *qosGroup) sortedMemberNames() []string {
+	names := make([]string, 0, len(g.members))
+	for name := range g.members {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (g *qosGroup) status() *bridgepb.QosGroupStatus {
+	qosStatus := &bridgepb.QosGroupStatus{Group: server.ProtoClone(g.group)}
+	for _, name := range g.sortedMemberNames() {
+		qosStatus.Members = append(qosStatus.Members, server.ProtoClone(g.members[name]))
+	}
+	return qosStatus
+}
@@ -0,0 +1,409 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package middleend implements the MiddleEnd APIs (service) of the storage Server
+package middleend
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/opiproject/gospdk/spdk"
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	testQosGroupID       = "qos-group-42"
+	testQosGroupName     = server.ResourceIDToVolumeName(testQosGroupID)
+	testQosGroupSubsys   = "//storage.opiproject.org/subsystems/subsystem-test"
+	testQosGroupMember42 = server.ResourceIDToVolumeName("volume-42")
+	testQosGroupMember   = server.ResourceIDToVolumeName("volume-test")
+)
+
+func TestMiddleEnd_CreateQosGroup(t *testing.T) {
+	tests := map[string]struct {
+		in        *bridgepb.QosGroup
+		qosVolume bool
+		spdk      []string
+		out       []*bridgepb.QosGroupMember
+		errCode   codes.Code
+		errMsg    string
+	}{
+		"volumes share budget": {
+			in: &bridgepb.QosGroup{
+				Limit:          &pb.QosLimit{RwIopsKiops: 10},
+				VolumeNameRefs: []string{"volume-42", "volume-test"},
+			},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			out: []*bridgepb.QosGroupMember{
+				{VolumeNameRef: testQosGroupMember42, Share: &pb.QosLimit{RwIopsKiops: 5}},
+				{VolumeNameRef: testQosGroupMember, Share: &pb.QosLimit{RwIopsKiops: 5}},
+			},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"volumes of frontend object": {
+			in: &bridgepb.QosGroup{
+				Limit:            &pb.QosLimit{RwBandwidthMbs: 100},
+				FrontendNameRefs: []string{testQosGroupSubsys},
+			},
+			spdk: []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			out: []*bridgepb.QosGroupMember{
+				{VolumeNameRef: testQosGroupMember42, Share: &pb.QosLimit{RwBandwidthMbs: 100}},
+			},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"member has QoS volume": {
+			in: &bridgepb.QosGroup{
+				Limit:          &pb.QosLimit{RwIopsKiops: 10},
+				VolumeNameRefs: []string{"volume-42", "volume-test"},
+			},
+			qosVolume: true,
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			out: []*bridgepb.QosGroupMember{
+				{VolumeNameRef: testQosGroupMember42, Error: "bdev volume-42 already has QoS limits of " + testQosVolumeName},
+				{VolumeNameRef: testQosGroupMember, Share: &pb.QosLimit{RwIopsKiops: 10}},
+			},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"SPDK call result false": {
+			in: &bridgepb.QosGroup{
+				Limit:          &pb.QosLimit{RwIopsKiops: 10},
+				VolumeNameRefs: []string{"volume-42"},
+			},
+			spdk: []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			out: []*bridgepb.QosGroupMember{
+				{VolumeNameRef: testQosGroupMember42, Error: status.Convert(spdk.ErrUnexpectedSpdkCallResult).Message()},
+			},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"unknown volume": {
+			in: &bridgepb.QosGroup{
+				Limit:          &pb.QosLimit{RwIopsKiops: 10},
+				VolumeNameRefs: []string{"unknown-volume"},
+			},
+			spdk:    []string{},
+			out:     nil,
+			errCode: codes.NotFound,
+			errMsg:  "unable to find volume unknown-volume",
+		},
+		"unknown frontend object": {
+			in: &bridgepb.QosGroup{
+				Limit:            &pb.QosLimit{RwIopsKiops: 10},
+				FrontendNameRefs: []string{"unknown-object"},
+			},
+			spdk:    []string{},
+			out:     nil,
+			errCode: codes.NotFound,
+			errMsg:  "unable to find object unknown-object",
+		},
+		"directional limit": {
+			in: &bridgepb.QosGroup{
+				Limit:          &pb.QosLimit{RdIopsKiops: 10},
+				VolumeNameRefs: []string{"volume-42"},
+			},
+			spdk:    []string{},
+			out:     nil,
+			errCode: codes.InvalidArgument,
+			errMsg:  "QoS group limit rd_iops_kiops is not supported",
+		},
+		"negative limit": {
+			in: &bridgepb.QosGroup{
+				Limit:          &pb.QosLimit{RwBandwidthMbs: -1},
+				VolumeNameRefs: []string{"volume-42"},
+			},
+			spdk:    []string{},
+			out:     nil,
+			errCode: codes.InvalidArgument,
+			errMsg:  "QoS group limit rw_bandwidth_mbs cannot be negative",
+		},
+		"empty limit": {
+			in: &bridgepb.QosGroup{
+				Limit:          &pb.QosLimit{},
+				VolumeNameRefs: []string{"volume-42"},
+			},
+			spdk:    []string{},
+			out:     nil,
+			errCode: codes.InvalidArgument,
+			errMsg:  "QoS group limit should set limit",
+		},
+		"missing members": {
+			in:      &bridgepb.QosGroup{Limit: &pb.QosLimit{RwIopsKiops: 10}},
+			spdk:    []string{},
+			out:     nil,
+			errCode: codes.InvalidArgument,
+			errMsg:  "QoS group should set volume_name_refs or frontend_name_refs",
+		},
+		"missing limit": {
+			in:      &bridgepb.QosGroup{VolumeNameRefs: []string{"volume-42"}},
+			spdk:    []string{},
+			out:     nil,
+			errCode: codes.Unknown,
+			errMsg:  "missing required field: qos_group.limit",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+			testEnv.opiSpdkServer.registry.AddConsumer(&testConsumer{
+				objects: map[string][]string{testQosGroupSubsys: {"volume-42"}},
+			})
+			if tt.qosVolume {
+				testEnv.opiSpdkServer.qos.volumes[testQosVolumeName] = &qosControlledVolume{bdevName: "volume-42"}
+			}
+
+			response, err := testEnv.client.CreateQosGroup(testEnv.ctx, &bridgepb.CreateQosGroupRequest{QosGroup: tt.in, QosGroupId: testQosGroupID})
+
+			if (response == nil) != (tt.out == nil) {
+				t.Fatal("response: expected", tt.out, "received", response)
+			}
+			if response != nil && !server.EqualProtoSlices(response.Members, tt.out) {
+				t.Error("members: expected", tt.out, "received", response.Members)
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_AdjustQosGroup(t *testing.T) {
+	tests := map[string]struct {
+		objects []string
+		spdk    []string
+		out     []*bridgepb.QosGroupMember
+	}{
+		"busy member gets budget of idle member": {
+			objects: []string{"volume-42", "volume-test"},
+			spdk: []string{
+				testIostatResponse(2000, 2500, 2500),
+				testIostatResponse(2000, 1000, 0),
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			out: []*bridgepb.QosGroupMember{
+				{VolumeNameRef: testQosGroupMember42, Share: &pb.QosLimit{RwIopsKiops: 8}},
+				{VolumeNameRef: testQosGroupMember, Share: &pb.QosLimit{RwIopsKiops: 1}},
+			},
+		},
+		"busy members share budget equally": {
+			objects: []string{"volume-42", "volume-test"},
+			spdk: []string{
+				testIostatResponse(2000, 5000, 0),
+				testIostatResponse(2000, 0, 5000),
+			},
+			out: []*bridgepb.QosGroupMember{
+				{VolumeNameRef: testQosGroupMember42, Share: &pb.QosLimit{RwIopsKiops: 5}},
+				{VolumeNameRef: testQosGroupMember, Share: &pb.QosLimit{RwIopsKiops: 5}},
+			},
+		},
+		"removed member leaves budget to others": {
+			objects: []string{"volume-42"},
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				testIostatResponse(2000, 0, 0),
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			out: []*bridgepb.QosGroupMember{
+				{VolumeNameRef: testQosGroupMember42, Share: &pb.QosLimit{RwIopsKiops: 10}},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(append([]string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			}, tt.spdk...))
+			defer testEnv.Close()
+			consumer := &testConsumer{
+				objects: map[string][]string{testQosGroupSubsys: {"volume-42", "volume-test"}},
+			}
+			testEnv.opiSpdkServer.registry.AddConsumer(consumer)
+			_, err := testEnv.client.CreateQosGroup(testEnv.ctx, &bridgepb.CreateQosGroupRequest{
+				QosGroup: &bridgepb.QosGroup{
+					Limit:            &pb.QosLimit{RwIopsKiops: 10},
+					FrontendNameRefs: []string{testQosGroupSubsys},
+				},
+				QosGroupId: testQosGroupID,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, v := range testEnv.opiSpdkServer.qos.volumes {
+				v.last = &server.VolumeStats{Uptime: time.Second}
+			}
+			consumer.objects[testQosGroupSubsys] = tt.objects
+
+			testEnv.opiSpdkServer.adjustQosLimits()
+
+			response, err := testEnv.client.GetQosGroupStatus(testEnv.ctx, &bridgepb.GetQosGroupStatusRequest{Name: testQosGroupName})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !server.EqualProtoSlices(response.Members, tt.out) {
+				t.Error("members: expected", tt.out, "received", response.Members)
+			}
+			for _, member := range tt.out {
+				v := testEnv.opiSpdkServer.qos.volumes[qosGroupVolumeKey(testQosGroupName, member.VolumeNameRef)]
+				if !proto.Equal(v.applied, member.Share) {
+					t.Error(member.VolumeNameRef, "applied: expected", member.Share, "received", v.applied)
+				}
+			}
+			if len(testEnv.opiSpdkServer.qos.volumes) != len(tt.out) {
+				t.Error("controlled volumes: expected", len(tt.out), "received", len(testEnv.opiSpdkServer.qos.volumes))
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_DeleteQosGroup(t *testing.T) {
+	tests := map[string]struct {
+		name         string
+		allowMissing bool
+		spdk         []string
+		errCode      codes.Code
+		errMsg       string
+	}{
+		"valid request": {
+			name: testQosGroupName,
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"SPDK call result false": {
+			name:    testQosGroupName,
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			errCode: status.Convert(spdk.ErrUnexpectedSpdkCallResult).Code(),
+			errMsg:  status.Convert(spdk.ErrUnexpectedSpdkCallResult).Message(),
+		},
+		"unknown group": {
+			name:    "unknown-group",
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  "unable to find key unknown-group",
+		},
+		"unknown group with allow missing": {
+			name:         "unknown-group",
+			allowMissing: true,
+			spdk:         []string{},
+			errCode:      codes.OK,
+			errMsg:       "",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(append([]string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			}, tt.spdk...))
+			defer testEnv.Close()
+			_, err := testEnv.client.CreateQosGroup(testEnv.ctx, &bridgepb.CreateQosGroupRequest{
+				QosGroup: &bridgepb.QosGroup{
+					Limit:          &pb.QosLimit{RwIopsKiops: 10},
+					VolumeNameRefs: []string{"volume-42", "volume-test"},
+				},
+				QosGroupId: testQosGroupID,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = testEnv.client.DeleteQosGroup(testEnv.ctx, &bridgepb.DeleteQosGroupRequest{Name: tt.name, AllowMissing: tt.allowMissing})
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+
+			vol, err := testEnv.opiSpdkServer.registry.Get("volume-test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			deleted := tt.name == testQosGroupName && tt.errCode == codes.OK
+			if (len(vol.Users) == 0) != deleted {
+				t.Error("volume-test users: received", vol.Users)
+			}
+		})
+	}
+}
+
+func TestDivideQosBudget(t *testing.T) {
+	tests := map[string]struct {
+		budget  int64
+		demands []float64
+		out     []int64
+	}{
+		"idle members share budget equally": {
+			budget:  10,
+			demands: []float64{0, 0, 0},
+			out:     []int64{3, 3, 3},
+		},
+		"unused budget goes to busy member": {
+			budget:  10,
+			demands: []float64{math.Inf(1), 1.25},
+			out:     []int64{8, 1},
+		},
+		"busy members share budget equally": {
+			budget:  10,
+			demands: []float64{math.Inf(1), math.Inf(1), 1},
+			out:     []int64{4, 4, 1},
+		},
+		"budget nobody demands is divided": {
+			budget:  10,
+			demands: []float64{2, 4},
+			out:     []int64{4, 6},
+		},
+		"share is at least 1": {
+			budget:  2,
+			demands: []float64{0, 0, 0},
+			out:     []int64{1, 1, 1},
+		},
+		"no budget": {
+			budget:  0,
+			demands: []float64{math.Inf(1), 1},
+			out:     []int64{0, 0},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			shares := divideQosBudget(tt.budget, tt.demands)
+
+			if !reflect.DeepEqual(shares, tt.out) {
+				t.Error("shares: expected", tt.out, "received", shares)
+			}
+		})
+	}
+}
@@ -0,0 +1,320 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package middleend implements the MiddleEnd APIs (service) of the storage Server
+package middleend
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+
+	"go.einride.tech/aip/fieldbehavior"
+	"go.einride.tech/aip/resourceid"
+	"go.einride.tech/aip/resourcename"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Delays between attempts to apply limits of a QoS policy to a volume. The
+// delay doubles with every failed attempt
+const (
+	qosPolicyRetryMinDelay = time.Second
+	qosPolicyRetryMaxDelay = 5 * time.Minute
+)
+
+// qosPolicy is a QoS policy with its attached volumes keyed by name
+type qosPolicy struct {
+	policy      *bridgepb.QosPolicy
+	attachments map[string]*bridgepb.QosPolicyAttachment
+}
+
+// qosPolicyVolumeKey identifies a volume attached to a policy among volumes
+// kept by the QoS controller
+func qosPolicyVolumeKey(policyName string, volumeNameRef string) string {
+	return policyName + ":" + volumeNameRef
+}
+
+func (s *Server) getQosPolicy(name string) (*qosPolicy, error) {
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// fetch object from the database
+	policy, ok := s.qos.policies[name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	return policy, nil
+}
+
+// CreateQosPolicy creates a QoS policy without attached volumes
+func (s *Server) CreateQosPolicy(_ context.Context, in *bridgepb.CreateQosPolicyRequest) (*bridgepb.QosPolicy, error) {
+	log.Printf("CreateQosPolicy: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// see https://google.aip.dev/133#user-specified-ids
+	resourceID := resourceid.NewSystemGenerated()
+	if in.QosPolicyId != "" {
+		err := resourceid.ValidateUserSettable(in.QosPolicyId)
+		if err != nil {
+			log.Printf("error: %v", err)
+			return nil, err
+		}
+		log.Printf("client provided the ID of a resource %v, ignoring the name field %v", in.QosPolicyId, in.QosPolicy.Name)
+		resourceID = in.QosPolicyId
+	}
+	name := server.ResourceIDToVolumeName(resourceID)
+	if err := verifyQosLimits(in.QosPolicy.Limits); err != nil {
+		log.Printf("error: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	s.qos.mu.Lock()
+	defer s.qos.mu.Unlock()
+	// idempotent API when called with same key, should return same object
+	if policy, ok := s.qos.policies[name]; ok {
+		log.Printf("Already existing QosPolicy with id %v", name)
+		return server.ProtoClone(policy.policy), nil
+	}
+	policy := &qosPolicy{
+		policy:      &bridgepb.QosPolicy{Name: name, Limits: server.ProtoClone(in.QosPolicy.Limits)},
+		attachments: make(map[string]*bridgepb.QosPolicyAttachment),
+	}
+	s.qos.policies[name] = policy
+	response := server.ProtoClone(policy.policy)
+	log.Printf("CreateQosPolicy: Sending to client: %v", server.Redact(response))
+	return response, nil
+}
+
+// DeleteQosPolicy deletes a QoS policy. Volumes need to be detached first
+func (s *Server) DeleteQosPolicy(_ context.Context, in *bridgepb.DeleteQosPolicyRequest) (*emptypb.Empty, error) {
+	log.Printf("DeleteQosPolicy: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	s.qos.mu.Lock()
+	defer s.qos.mu.Unlock()
+	if _, ok := s.qos.policies[in.Name]; !ok && in.AllowMissing {
+		return &emptypb.Empty{}, nil
+	}
+	policy, err := s.getQosPolicy(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(policy.attachments) != 0 {
+		err := status.Errorf(codes.FailedPrecondition, "QoS policy %s is attached to %d volumes", in.Name, len(policy.attachments))
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	delete(s.qos.policies, in.Name)
+	return &emptypb.Empty{}, nil
+}
+
+// UpdateQosPolicy changes limits of a QoS policy and applies them to every
+// attached volume. A volume the limits cannot be applied to does not fail
+// the update, it is reported in the returned status and retried later
+func (s *Server) UpdateQosPolicy(_ context.Context, in *bridgepb.UpdateQosPolicyRequest) (*bridgepb.QosPolicyStatus, error) {
+	log.Printf("UpdateQosPolicy: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	s.qos.mu.Lock()
+	defer s.qos.mu.Unlock()
+	policy, err := s.getQosPolicy(in.QosPolicy.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyQosLimits(in.QosPolicy.Limits); err != nil {
+		log.Printf("error: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	policy.policy.Limits = server.ProtoClone(in.QosPolicy.Limits)
+	for _, attachment := range policy.sortedAttachments() {
+		attachment.Applied = false
+		attachment.Attempts = 0
+		s.applyQosPolicy(policy, attachment)
+	}
+	return policy.status(), nil
+}
+
+// GetQosPolicy gets a QoS policy
+func (s *Server) GetQosPolicy(_ context.Context, in *bridgepb.GetQosPolicyRequest) (*bridgepb.QosPolicy, error) {
+	log.Printf("GetQosPolicy: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	s.qos.mu.Lock()
+	defer s.qos.mu.Unlock()
+	policy, err := s.getQosPolicy(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	return server.ProtoClone(policy.policy), nil
+}
+
+// GetQosPolicyStatus gets a QoS policy together with its attached volumes
+// and whether its limits are applied to them
+func (s *Server) GetQosPolicyStatus(_ context.Context, in *bridgepb.GetQosPolicyStatusRequest) (*bridgepb.QosPolicyStatus, error) {
+	log.Printf("GetQosPolicyStatus: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	s.qos.mu.Lock()
+	defer s.qos.mu.Unlock()
+	policy, err := s.getQosPolicy(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	return policy.status(), nil
+}
+
+// AttachQosPolicy applies limits of a QoS policy to a volume and keeps
+// them applied when the policy changes
+func (s *Server) AttachQosPolicy(_ context.Context, in *bridgepb.AttachQosPolicyRequest) (*bridgepb.QosPolicyAttachment, error) {
+	log.Printf("AttachQosPolicy: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	s.qos.mu.Lock()
+	defer s.qos.mu.Unlock()
+	policy, err := s.getQosPolicy(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	if attachment, ok := policy.attachments[in.VolumeNameRef]; ok {
+		log.Printf("Already attached volume %v to QosPolicy %v", in.VolumeNameRef, in.Name)
+		return server.ProtoClone(attachment), nil
+	}
+	bdevName, err := s.bdevName(in.VolumeNameRef)
+	if err != nil {
+		return nil, err
+	}
+	key := qosPolicyVolumeKey(in.Name, in.VolumeNameRef)
+	if err := s.setQosLimits(key, bdevName, policy.policy.Limits); err != nil {
+		return nil, err
+	}
+	attachment := &bridgepb.QosPolicyAttachment{VolumeNameRef: in.VolumeNameRef, Applied: true}
+	policy.attachments[in.VolumeNameRef] = attachment
+	s.acquireVolume(in.VolumeNameRef, key)
+	return server.ProtoClone(attachment), nil
+}
+
+// DetachQosPolicy removes limits of a QoS policy from a volume
+func (s *Server) DetachQosPolicy(_ context.Context, in *bridgepb.DetachQosPolicyRequest) (*emptypb.Empty, error) {
+	log.Printf("DetachQosPolicy: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	s.qos.mu.Lock()
+	defer s.qos.mu.Unlock()
+	policy, err := s.getQosPolicy(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := policy.attachments[in.VolumeNameRef]; !ok {
+		if in.AllowMissing {
+			return &emptypb.Empty{}, nil
+		}
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.VolumeNameRef)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	key := qosPolicyVolumeKey(in.Name, in.VolumeNameRef)
+	if v, ok := s.qos.volumes[key]; ok {
+		if err := s.unsetQosLimits(key, v.bdevName); err != nil {
+			return nil, err
+		}
+	}
+	delete(policy.attachments, in.VolumeNameRef)
+	s.registry.Release(in.VolumeNameRef, key)
+	return &emptypb.Empty{}, nil
+}
+
+// retryQosPolicies applies limits of QoS policies to volumes which failed
+// to apply them before and are due for a retry. Callers hold s.qos.mu
+func (s *Server) retryQosPolicies(now time.Time) {
+	names := make([]string, 0, len(s.qos.policies))
+	for name := range s.qos.policies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		policy := s.qos.policies[name]
+		for _, attachment := range policy.sortedAttachments() {
+			if !attachment.Applied && !now.Before(attachment.RetryAt.AsTime()) {
+				s.applyQosPolicy(policy, attachment)
+			}
+		}
+	}
+}
+
+// applyQosPolicy applies limits of a policy to an attached volume and
+// schedules a retry if that fails. Callers hold s.qos.mu
+func (s *Server) applyQosPolicy(policy *qosPolicy, attachment *bridgepb.QosPolicyAttachment) {
+	err := func() error {
+		bdevName, err := s.bdevName(attachment.VolumeNameRef)
+		if err != nil {
+			return err
+		}
+		return s.setQosLimits(qosPolicyVolumeKey(policy.policy.Name, attachment.VolumeNameRef), bdevName, policy.policy.Limits)
+	}()
+	if err == nil {
+		attachment.Applied = true
+		attachment.Error = ""
+		attachment.Attempts = 0
+		attachment.RetryAt = nil
+		return
+	}
+	attachment.Error = status.Convert(err).Message()
+	attachment.Attempts++
+	delay := qosPolicyRetryMinDelay << (attachment.Attempts - 1)
+	if attachment.Attempts > 16 || delay > qosPolicyRetryMaxDelay {
+		delay = qosPolicyRetryMaxDelay
+	}
+	attachment.RetryAt = timestamppb.New(time.Now().Add(delay))
+	log.Printf("error: could not apply QoS policy %v to %v, retrying in %v: %v",
+		policy.policy.Name, attachment.VolumeNameRef, delay, err)
+}
+
+func (p *qosPolicy) sortedAttachments() []*bridgepb.QosPolicyAttachment {
+	attachments := make([]*bridgepb.QosPolicyAttachment, 0, len(p.attachments))
+	for _, attachment := range p.attachments {
+		attachments = append(attachments, attachment)
+	}
+	sort.Slice(attachments, func(i int, j int) bool {
+		return attachments[i].VolumeNameRef < attachments[j].VolumeNameRef
+	})
+	return attachments
+}
+
+func (p *qosPolicy) status() *bridgepb.QosPolicyStatus {
+	qosStatus := &bridgepb.QosPolicyStatus{Policy: server.ProtoClone(p.policy)}
+	for _, attachment := range p.sortedAttachments() {
+		qosStatus.Volumes = append(qosStatus.Volumes, server.ProtoClone(attachment))
+	}
+	return qosStatus
+}
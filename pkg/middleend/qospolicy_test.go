@@ -0,0 +1,347 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package middleend implements the MiddleEnd APIs (service) of the storage Server
+package middleend
+
+import (
+	"testing"
+
+	"github.com/opiproject/gospdk/spdk"
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	testQosPolicyID   = "qos-policy-42"
+	testQosPolicyName = server.ResourceIDToVolumeName(testQosPolicyID)
+	testQosPolicy     = bridgepb.QosPolicy{
+		Limits: &pb.Limits{Max: &pb.QosLimit{RwIopsKiops: 10}},
+	}
+)
+
+func createTestQosPolicy(t *testing.T, env *testEnv, volumeNameRefs ...string) {
+	env.opiSpdkServer.qos.policies[testQosPolicyName] = &qosPolicy{
+		policy:      &bridgepb.QosPolicy{Name: testQosPolicyName, Limits: server.ProtoClone(testQosPolicy.Limits)},
+		attachments: make(map[string]*bridgepb.QosPolicyAttachment),
+	}
+	for _, ref := range volumeNameRefs {
+		bdevName, err := env.opiSpdkServer.bdevName(ref)
+		if err != nil {
+			t.Fatal(err)
+		}
+		env.opiSpdkServer.qos.policies[testQosPolicyName].attachments[ref] = &bridgepb.QosPolicyAttachment{VolumeNameRef: ref, Applied: true}
+		env.opiSpdkServer.qos.volumes[qosPolicyVolumeKey(testQosPolicyName, ref)] = &qosControlledVolume{
+			bdevName: bdevName,
+			limits:   server.ProtoClone(testQosPolicy.Limits),
+			applied:  server.ProtoClone(testQosPolicy.Limits.Max),
+		}
+	}
+}
+
+func TestMiddleEnd_CreateQosPolicy(t *testing.T) {
+	tests := map[string]struct {
+		in      *bridgepb.QosPolicy
+		out     *bridgepb.QosPolicy
+		exist   bool
+		errCode codes.Code
+		errMsg  string
+	}{
+		"valid request": {
+			in:      &testQosPolicy,
+			out:     &bridgepb.QosPolicy{Name: testQosPolicyName, Limits: testQosPolicy.Limits},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"already exists": {
+			in:      &bridgepb.QosPolicy{Limits: &pb.Limits{Max: &pb.QosLimit{RwIopsKiops: 1}}},
+			out:     &bridgepb.QosPolicy{Name: testQosPolicyName, Limits: testQosPolicy.Limits},
+			exist:   true,
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"invalid limits": {
+			in:      &bridgepb.QosPolicy{Limits: &pb.Limits{Max: &pb.QosLimit{}}},
+			out:     nil,
+			errCode: codes.InvalidArgument,
+			errMsg:  "QoS volume max_limit should set limit",
+		},
+		"missing limits": {
+			in:      &bridgepb.QosPolicy{},
+			out:     nil,
+			errCode: codes.Unknown,
+			errMsg:  "missing required field: qos_policy.limits",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment([]string{})
+			defer testEnv.Close()
+			if tt.exist {
+				createTestQosPolicy(t, testEnv)
+			}
+
+			response, err := testEnv.client.CreateQosPolicy(testEnv.ctx, &bridgepb.CreateQosPolicyRequest{QosPolicy: tt.in, QosPolicyId: testQosPolicyID})
+
+			if !proto.Equal(response, tt.out) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_AttachQosPolicy(t *testing.T) {
+	tests := map[string]struct {
+		policy    string
+		volume    string
+		qosVolume bool
+		spdk      []string
+		errCode   codes.Code
+		errMsg    string
+	}{
+		"valid request": {
+			policy:  testQosPolicyName,
+			volume:  "volume-42",
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"SPDK call result false": {
+			policy:  testQosPolicyName,
+			volume:  "volume-42",
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			errCode: status.Convert(spdk.ErrUnexpectedSpdkCallResult).Code(),
+			errMsg:  status.Convert(spdk.ErrUnexpectedSpdkCallResult).Message(),
+		},
+		"volume has QoS volume": {
+			policy:    testQosPolicyName,
+			volume:    "volume-42",
+			qosVolume: true,
+			spdk:      []string{},
+			errCode:   codes.FailedPrecondition,
+			errMsg:    "bdev volume-42 already has QoS limits of " + testQosVolumeName,
+		},
+		"unknown volume": {
+			policy:  testQosPolicyName,
+			volume:  "unknown-volume",
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  "unable to find volume unknown-volume",
+		},
+		"unknown policy": {
+			policy:  "unknown-policy",
+			volume:  "volume-42",
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  "unable to find key unknown-policy",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+			createTestQosPolicy(t, testEnv)
+			if tt.qosVolume {
+				testEnv.opiSpdkServer.qos.volumes[testQosVolumeName] = &qosControlledVolume{bdevName: "volume-42"}
+			}
+
+			response, err := testEnv.client.AttachQosPolicy(testEnv.ctx, &bridgepb.AttachQosPolicyRequest{Name: tt.policy, VolumeNameRef: tt.volume})
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+
+			_, attached := testEnv.opiSpdkServer.qos.policies[testQosPolicyName].attachments[tt.volume]
+			if attached != (tt.errCode == codes.OK) {
+				t.Error("attached: expected", tt.errCode == codes.OK, "received", attached)
+			}
+			if tt.errCode == codes.OK && (response == nil || !response.Applied) {
+				t.Error("response: expected applied attachment, received", response)
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_UpdateQosPolicy(t *testing.T) {
+	testEnv := createTestEnvironment([]string{
+		`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+		`{"id":%d,"error":{"code":0,"message":""},"result":false}`,
+		`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+	})
+	defer testEnv.Close()
+	createTestQosPolicy(t, testEnv, "volume-42", "volume-test")
+	limits := &pb.Limits{Max: &pb.QosLimit{RwIopsKiops: 20}}
+
+	response, err := testEnv.client.UpdateQosPolicy(testEnv.ctx, &bridgepb.UpdateQosPolicyRequest{
+		QosPolicy: &bridgepb.QosPolicy{Name: testQosPolicyName, Limits: limits},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !proto.Equal(response.Policy.Limits, limits) {
+		t.Error("limits: expected", limits, "received", response.Policy.Limits)
+	}
+	if len(response.Volumes) != 2 {
+		t.Fatal("volumes: expected 2, received", len(response.Volumes))
+	}
+	if v := response.Volumes[0]; v.VolumeNameRef != "volume-42" || !v.Applied || v.Error != "" {
+		t.Error("volume-42: expected applied, received", v)
+	}
+	expectedError := status.Convert(spdk.ErrUnexpectedSpdkCallResult).Message()
+	if v := response.Volumes[1]; v.VolumeNameRef != "volume-test" || v.Applied || v.Error != expectedError || v.Attempts != 1 {
+		t.Error("volume-test: expected failed attempt, received", v)
+	}
+	key := qosPolicyVolumeKey(testQosPolicyName, "volume-test")
+	if applied := testEnv.opiSpdkServer.qos.volumes[key].applied; !proto.Equal(applied, testQosPolicy.Limits.Max) {
+		t.Error("volume-test: expected previous limits in effect, received", applied)
+	}
+
+	// retry is not due yet
+	testEnv.opiSpdkServer.adjustQosLimits()
+	if testEnv.opiSpdkServer.qos.policies[testQosPolicyName].attachments["volume-test"].Applied {
+		t.Error("volume-test: expected retry to be delayed")
+	}
+
+	testEnv.opiSpdkServer.qos.policies[testQosPolicyName].attachments["volume-test"].RetryAt = timestamppb.Now()
+	testEnv.opiSpdkServer.adjustQosLimits()
+
+	response, err = testEnv.client.GetQosPolicyStatus(testEnv.ctx, &bridgepb.GetQosPolicyStatusRequest{Name: testQosPolicyName})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := response.Volumes[1]; !v.Applied || v.Error != "" || v.Attempts != 0 {
+		t.Error("volume-test: expected applied after retry, received", v)
+	}
+	if applied := testEnv.opiSpdkServer.qos.volumes[key].applied; !proto.Equal(applied, limits.Max) {
+		t.Error("volume-test: expected new limits in effect, received", applied)
+	}
+}
+
+func TestMiddleEnd_DetachQosPolicy(t *testing.T) {
+	tests := map[string]struct {
+		volume       string
+		allowMissing bool
+		spdk         []string
+		errCode      codes.Code
+		errMsg       string
+	}{
+		"valid request": {
+			volume:  "volume-42",
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"SPDK call result false": {
+			volume:  "volume-42",
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			errCode: status.Convert(spdk.ErrUnexpectedSpdkCallResult).Code(),
+			errMsg:  status.Convert(spdk.ErrUnexpectedSpdkCallResult).Message(),
+		},
+		"not attached volume": {
+			volume:  "volume-test",
+			spdk:    []string{},
+			errCode: codes.NotFound,
+			errMsg:  "unable to find key volume-test",
+		},
+		"not attached volume with allow missing": {
+			volume:       "volume-test",
+			allowMissing: true,
+			spdk:         []string{},
+			errCode:      codes.OK,
+			errMsg:       "",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+			createTestQosPolicy(t, testEnv, "volume-42")
+
+			_, err := testEnv.client.DetachQosPolicy(testEnv.ctx, &bridgepb.DetachQosPolicyRequest{
+				Name:          testQosPolicyName,
+				VolumeNameRef: tt.volume,
+				AllowMissing:  tt.allowMissing,
+			})
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_DeleteQosPolicy(t *testing.T) {
+	tests := map[string]struct {
+		name         string
+		attached     []string
+		allowMissing bool
+		errCode      codes.Code
+		errMsg       string
+	}{
+		"valid request": {
+			name:    testQosPolicyName,
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"attached policy": {
+			name:     testQosPolicyName,
+			attached: []string{"volume-42"},
+			errCode:  codes.FailedPrecondition,
+			errMsg:   "QoS policy " + testQosPolicyName + " is attached to 1 volumes",
+		},
+		"unknown policy": {
+			name:    "unknown-policy",
+			errCode: codes.NotFound,
+			errMsg:  "unable to find key unknown-policy",
+		},
+		"unknown policy with allow missing": {
+			name:         "unknown-policy",
+			allowMissing: true,
+			errCode:      codes.OK,
+			errMsg:       "",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment([]string{})
+			defer testEnv.Close()
+			createTestQosPolicy(t, testEnv, tt.attached...)
+
+			_, err := testEnv.client.DeleteQosPolicy(testEnv.ctx, &bridgepb.DeleteQosPolicyRequest{Name: tt.name, AllowMissing: tt.allowMissing})
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
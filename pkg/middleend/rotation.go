@@ -0,0 +1,309 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package middleend implements the MiddleEnd APIs (service) of the storage Server
+package middleend
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+
+	"github.com/opiproject/gospdk/spdk"
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/kms"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
+
+	"go.einride.tech/aip/fieldbehavior"
+	"go.einride.tech/aip/resourcename"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxKeyRotations is the number of key rotations kept in the history of an
+// encrypted volume
+const maxKeyRotations = 16
+
+// GetEncryptedVolumeStatus returns the key in use and the key rotation
+// history of an encrypted volume
+func (s *Server) GetEncryptedVolumeStatus(_ context.Context, in *bridgepb.GetEncryptedVolumeStatusRequest) (*bridgepb.EncryptedVolumeStatus, error) {
+	log.Printf("GetEncryptedVolumeStatus: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(in.Name); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	key, ok := s.volumes.encKeys[in.Name]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	response := &bridgepb.EncryptedVolumeStatus{Key: server.ProtoClone(key)}
+	for _, rotation := range s.volumes.encRotations[in.Name] {
+		response.Rotations = append(response.Rotations, server.ProtoClone(rotation))
+	}
+	return response, nil
+}
+
+// rotateEncryptedVolumeKey replaces the key of an encrypted volume without
+// deleting the objects using it. The new key is created, subsystems of users
+// of the volume are paused and users are detached, the crypto bdev is
+// recreated with the new key under the same name, users are attached again,
+// their subsystems are resumed and then the previous key is destroyed. If a
+// step fails, the steps taken are undone in reverse order. Data written with
+// the previous key is not re-encrypted, so volumes holding data are rejected
+// and need to be migrated to a new encrypted volume instead, unless the
+// caller discards the data with discardWrittenData
+func (s *Server) rotateEncryptedVolumeKey(ctx context.Context, in *pb.EncryptedVolume, current *pb.EncryptedVolume, fingerprint string, discardWrittenData bool) (*pb.EncryptedVolume, error) {
+	defer kms.Zero(in.Key)
+	base, err := s.registry.Get(current.VolumeNameRef)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	requested, err := s.registry.Get(in.VolumeNameRef)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if requested.Name != base.Name {
+		msg := fmt.Sprintf("VolumeNameRef of EncryptedVolume %s cannot be changed", in.Name)
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	encrypted, err := s.registry.Get(in.Name)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	consumers := make(map[string]volume.Consumer, len(encrypted.Users))
+	for _, user := range encrypted.Users {
+		consumer, ok := s.registry.ConsumerOf(user)
+		if !ok {
+			msg := fmt.Sprintf("EncryptedVolume %s is used by %s, which cannot be detached", in.Name, user)
+			log.Print(msg)
+			return nil, status.Errorf(codes.FailedPrecondition, msg)
+		}
+		consumers[user] = consumer
+	}
+	resourceID := path.Base(in.Name)
+	if discardWrittenData {
+		log.Printf("Discarding data written to %s with its current key", in.Name)
+	} else if err := s.verifyNoDataWritten(in.Name, resourceID); err != nil {
+		return nil, err
+	}
+
+	previous := &bridgepb.EncryptedVolumeKey{KeyName: resourceID}
+	if key, ok := s.volumes.encKeys[in.Name]; ok {
+		previous = key
+	}
+	next := &bridgepb.EncryptedVolumeKey{
+		KeyName:     fmt.Sprintf("%s-%d", resourceID, previous.Generation+1),
+		Fingerprint: fingerprint,
+		Generation:  previous.Generation + 1,
+	}
+	rotation := &bridgepb.KeyRotation{PreviousFingerprint: previous.Fingerprint, Fingerprint: fingerprint}
+
+	var undo []func() error
+	fail := func(err error) (*pb.EncryptedVolume, error) {
+		return nil, s.rollbackKeyRotation(in.Name, undo, rotation, err)
+	}
+
+	params := s.getAccelCryptoKeyCreateParams(in)
+	params.Name = next.KeyName
+	kms.Zero(in.Key)
+	if err := s.createCryptoKey(&params); err != nil {
+		return fail(err)
+	}
+	undo = append(undo, func() error { return s.destroyCryptoKey(next.KeyName) })
+
+	for _, user := range encrypted.Users {
+		user, consumer := user, consumers[user]
+		if err := consumer.Pause(ctx, user); err != nil {
+			return fail(err)
+		}
+		undo = append(undo, func() error { return consumer.Resume(ctx, user) })
+		if err := consumer.Detach(ctx, user); err != nil {
+			return fail(err)
+		}
+		undo = append(undo, func() error { return consumer.Attach(ctx, user, resourceID) })
+	}
+
+	if err := s.deleteCryptoBdev(resourceID); err != nil {
+		return fail(err)
+	}
+	undo = append(undo, func() error { return s.createCryptoBdev(resourceID, base.BdevName, previous.KeyName) })
+	if err := s.createCryptoBdev(resourceID, base.BdevName, next.KeyName); err != nil {
+		return fail(err)
+	}
+	undo = append(undo, func() error { return s.deleteCryptoBdev(resourceID) })
+
+	for _, user := range encrypted.Users {
+		user, consumer := user, consumers[user]
+		if err := consumer.Attach(ctx, user, resourceID); err != nil {
+			return fail(err)
+		}
+		undo = append(undo, func() error { return consumer.Detach(ctx, user) })
+		if err := consumer.Resume(ctx, user); err != nil {
+			return fail(err)
+		}
+		undo = append(undo, func() error { return consumer.Pause(ctx, user) })
+	}
+
+	// the new key is in use, so a failure to destroy the previous one is
+	// only recorded
+	rotation.Status = bridgepb.KeyRotationStatus_KEY_ROTATION_STATUS_COMPLETED
+	if err := s.destroyCryptoKey(previous.KeyName); err != nil {
+		rotation.Error = status.Convert(err).Message()
+	}
+	s.recordKeyRotation(in.Name, rotation)
+
+	response := withoutKey(in)
+	s.volumes.encVolumes[in.Name] = response
+	s.volumes.encKeys[in.Name] = next
+	log.Printf("UpdateEncryptedVolume: Sending to client: %v", server.Redact(response))
+	return server.ProtoClone(response), nil
+}
+
+// verifyNoDataWritten checks that nothing was written to the crypto bdev of
+// an encrypted volume since it was created, as data written with the current
+// key would not be readable with a new one
+func (s *Server) verifyNoDataWritten(name string, bdevName string) error {
+	params := spdk.BdevGetIostatParams{
+		Name: bdevName,
+	}
+	var result spdk.BdevGetIostatResult
+	err := s.rpc.Call("bdev_get_iostat", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if len(result.Bdevs) != 1 {
+		msg := fmt.Sprintf("expecting exactly 1 result, got %d", len(result.Bdevs))
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+	if result.Bdevs[0].NumWriteOps != 0 {
+		msg := fmt.Sprintf("EncryptedVolume %s holds data written with its current key, which is not re-encrypted; migrate the data to a new EncryptedVolume instead", name)
+		log.Print(msg)
+		return status.Errorf(codes.FailedPrecondition, msg)
+	}
+	return nil
+}
+
+// rollbackKeyRotation runs undo in reverse order and records the rotation
+func (s *Server) rollbackKeyRotation(name string, undo []func() error, rotation *bridgepb.KeyRotation, cause error) error {
+	rotation.Status = bridgepb.KeyRotationStatus_KEY_ROTATION_STATUS_ROLLED_BACK
+	rotation.Error = status.Convert(cause).Message()
+	var failures []string
+	for i := len(undo) - 1; i >= 0; i-- {
+		if err := undo[i](); err != nil {
+			failures = append(failures, status.Convert(err).Message())
+		}
+	}
+	if len(failures) == 0 {
+		s.recordKeyRotation(name, rotation)
+		return cause
+	}
+	rotation.Status = bridgepb.KeyRotationStatus_KEY_ROTATION_STATUS_FAILED
+	rotation.Error = fmt.Sprintf("%s; rollback failed: %s", rotation.Error, strings.Join(failures, "; "))
+	s.recordKeyRotation(name, rotation)
+	log.Printf("error: key rotation of %s: %s", name, rotation.Error)
+	return status.Error(codes.Internal, rotation.Error)
+}
+
+func (s *Server) recordKeyRotation(name string, rotation *bridgepb.KeyRotation) {
+	rotation.Time = timestamppb.Now()
+	rotations := append(s.volumes.encRotations[name], rotation)
+	if len(rotations) > maxKeyRotations {
+		rotations = rotations[len(rotations)-maxKeyRotations:]
+	}
+	s.volumes.encRotations[name] = rotations
+}
+
+func (s *Server) createCryptoKey(params *accelCryptoKeyCreateParams) error {
+	var result spdk.AccelCryptoKeyCreateResult
+	err := s.rpc.Call("accel_crypto_key_create", params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not create Crypto Key: %s", params.Name)
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+	return nil
+}
+
+func (s *Server) destroyCryptoKey(name string) error {
+	params := spdk.AccelCryptoKeyDestroyParams{
+		KeyName: name,
+	}
+	var result spdk.AccelCryptoKeyDestroyResult
+	err := s.rpc.Call("accel_crypto_key_destroy", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not destroy Crypto Key: %v", params.KeyName)
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+	return nil
+}
+
+func (s *Server) createCryptoBdev(name string, baseBdevName string, keyName string) error {
+	params := spdk.BdevCryptoCreateParams{
+		Name:         name,
+		BaseBdevName: baseBdevName,
+		KeyName:      keyName,
+	}
+	var result spdk.BdevCryptoCreateResult
+	err := s.rpc.Call("bdev_crypto_create", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if result == "" {
+		msg := fmt.Sprintf("Could not create Crypto Dev: %s", params.Name)
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+	return nil
+}
+
+func (s *Server) deleteCryptoBdev(name string) error {
+	params := spdk.BdevCryptoDeleteParams{
+		Name: name,
+	}
+	var result spdk.BdevCryptoDeleteResult
+	err := s.rpc.Call("bdev_crypto_delete", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not delete Crypto: %s", params.Name)
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+	return nil
+}
@@ -0,0 +1,390 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package middleend implements the MiddleEnd APIs (service) of the storage Server
+package middleend
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+)
+
+const testNamespaceUser = "//storage.opiproject.org/namespaces/ns-test"
+
+// testConsumer records calls and fails calls listed in failures once
+type testConsumer struct {
+	calls    []string
+	failures map[string]bool
+	// objects are volumes exposed through frontend objects
+	objects map[string][]string
+}
+
+func (c *testConsumer) Consumes(user string) bool {
+	return user == testNamespaceUser
+}
+
+func (c *testConsumer) call(call string) error {
+	c.calls = append(c.calls, call)
+	if c.failures[call] {
+		delete(c.failures, call)
+		return status.Errorf(codes.InvalidArgument, "Could not %s", call)
+	}
+	return nil
+}
+
+func (c *testConsumer) Pause(_ context.Context, user string) error {
+	return c.call("pause " + user)
+}
+
+func (c *testConsumer) Resume(_ context.Context, user string) error {
+	return c.call("resume " + user)
+}
+
+func (c *testConsumer) Detach(_ context.Context, user string) error {
+	return c.call("detach " + user)
+}
+
+func (c *testConsumer) Attach(_ context.Context, user string, bdevName string) error {
+	return c.call("attach " + user + " " + bdevName)
+}
+
+func (c *testConsumer) VolumesOf(object string) ([]string, bool) {
+	refs, ok := c.objects[object]
+	return refs, ok
+}
+
+func TestMiddleEnd_RotateEncryptedVolumeKey(t *testing.T) {
+	t.Cleanup(checkGlobalTestProtoObjectsNotChanged(t, t.Name()))
+	newKey := []byte("fedcba9876543210fedcba9876543210")
+	rotatedVolume := server.ProtoClone(&encryptedVolume)
+	rotatedVolume.Name = encryptedVolumeName
+	rotatedVolume.Key = newKey
+	expectedVolume := server.ProtoClone(rotatedVolume)
+	expectedVolume.Key = nil
+	pause := "pause " + testNamespaceUser
+	detach := "detach " + testNamespaceUser
+	attach := "attach " + testNamespaceUser + " " + encryptedVolumeID
+	resume := "resume " + testNamespaceUser
+	iostat := `{"id":%%d,"error":{"code":0,"message":""},"result":{"tick_rate":2490000000,"ticks":1,"bdevs":[{"name":"crypto-test","num_write_ops":%d}]}}`
+	noWrites := fmt.Sprintf(iostat, 0)
+
+	tests := map[string]struct {
+		in       *pb.EncryptedVolume
+		user     string
+		failures []string
+		out      *pb.EncryptedVolume
+		spdk     []string
+		errCode  codes.Code
+		errMsg   string
+		calls    []string
+		rotation *bridgepb.KeyRotation
+		keyName  string
+	}{
+		"rotate key of volume in use": {
+			in:   rotatedVolume,
+			user: testNamespaceUser,
+			out:  expectedVolume,
+			spdk: []string{
+				noWrites,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":"crypto-test"}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode:  codes.OK,
+			errMsg:   "",
+			calls:    []string{pause, detach, attach, resume},
+			rotation: &bridgepb.KeyRotation{Status: bridgepb.KeyRotationStatus_KEY_ROTATION_STATUS_COMPLETED},
+			keyName:  "crypto-test-1",
+		},
+		"previous key destroy fails": {
+			in:   rotatedVolume,
+			user: testNamespaceUser,
+			out:  expectedVolume,
+			spdk: []string{
+				noWrites,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":"crypto-test"}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":false}`,
+			},
+			errCode:  codes.OK,
+			errMsg:   "",
+			calls:    []string{pause, detach, attach, resume},
+			rotation: &bridgepb.KeyRotation{Status: bridgepb.KeyRotationStatus_KEY_ROTATION_STATUS_COMPLETED, Error: "Could not destroy Crypto Key: crypto-test"},
+			keyName:  "crypto-test-1",
+		},
+		"volume holds data": {
+			in:       rotatedVolume,
+			user:     testNamespaceUser,
+			out:      nil,
+			spdk:     []string{fmt.Sprintf(iostat, 5)},
+			errCode:  codes.FailedPrecondition,
+			errMsg:   fmt.Sprintf("EncryptedVolume %s holds data written with its current key, which is not re-encrypted; migrate the data to a new EncryptedVolume instead", encryptedVolumeName),
+			calls:    nil,
+			rotation: nil,
+			keyName:  "crypto-test",
+		},
+		"key create fails": {
+			in:   rotatedVolume,
+			user: testNamespaceUser,
+			out:  nil,
+			spdk: []string{
+				noWrites,
+				`{"id":%d,"error":{"code":0,"message":""},"result":false}`,
+			},
+			errCode:  codes.InvalidArgument,
+			errMsg:   "Could not create Crypto Key: crypto-test-1",
+			calls:    nil,
+			rotation: &bridgepb.KeyRotation{Status: bridgepb.KeyRotationStatus_KEY_ROTATION_STATUS_ROLLED_BACK, Error: "Could not create Crypto Key: crypto-test-1"},
+			keyName:  "crypto-test",
+		},
+		"pause fails": {
+			in:       rotatedVolume,
+			user:     testNamespaceUser,
+			failures: []string{pause},
+			out:      nil,
+			spdk: []string{
+				noWrites,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode:  codes.InvalidArgument,
+			errMsg:   "Could not " + pause,
+			calls:    []string{pause},
+			rotation: &bridgepb.KeyRotation{Status: bridgepb.KeyRotationStatus_KEY_ROTATION_STATUS_ROLLED_BACK, Error: "Could not " + pause},
+			keyName:  "crypto-test",
+		},
+		"detach fails": {
+			in:       rotatedVolume,
+			user:     testNamespaceUser,
+			failures: []string{detach},
+			out:      nil,
+			spdk: []string{
+				noWrites,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode:  codes.InvalidArgument,
+			errMsg:   "Could not " + detach,
+			calls:    []string{pause, detach, resume},
+			rotation: &bridgepb.KeyRotation{Status: bridgepb.KeyRotationStatus_KEY_ROTATION_STATUS_ROLLED_BACK, Error: "Could not " + detach},
+			keyName:  "crypto-test",
+		},
+		"bdev delete fails": {
+			in:   rotatedVolume,
+			user: testNamespaceUser,
+			out:  nil,
+			spdk: []string{
+				noWrites,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":false}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode:  codes.InvalidArgument,
+			errMsg:   "Could not delete Crypto: crypto-test",
+			calls:    []string{pause, detach, attach, resume},
+			rotation: &bridgepb.KeyRotation{Status: bridgepb.KeyRotationStatus_KEY_ROTATION_STATUS_ROLLED_BACK, Error: "Could not delete Crypto: crypto-test"},
+			keyName:  "crypto-test",
+		},
+		"bdev create fails": {
+			in:   rotatedVolume,
+			user: testNamespaceUser,
+			out:  nil,
+			spdk: []string{
+				noWrites,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":""}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":"crypto-test"}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode:  codes.InvalidArgument,
+			errMsg:   "Could not create Crypto Dev: crypto-test",
+			calls:    []string{pause, detach, attach, resume},
+			rotation: &bridgepb.KeyRotation{Status: bridgepb.KeyRotationStatus_KEY_ROTATION_STATUS_ROLLED_BACK, Error: "Could not create Crypto Dev: crypto-test"},
+			keyName:  "crypto-test",
+		},
+		"attach fails": {
+			in:       rotatedVolume,
+			user:     testNamespaceUser,
+			failures: []string{attach},
+			out:      nil,
+			spdk: []string{
+				noWrites,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":"crypto-test"}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":"crypto-test"}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode:  codes.InvalidArgument,
+			errMsg:   "Could not " + attach,
+			calls:    []string{pause, detach, attach, attach, resume},
+			rotation: &bridgepb.KeyRotation{Status: bridgepb.KeyRotationStatus_KEY_ROTATION_STATUS_ROLLED_BACK, Error: "Could not " + attach},
+			keyName:  "crypto-test",
+		},
+		"resume fails": {
+			in:       rotatedVolume,
+			user:     testNamespaceUser,
+			failures: []string{resume},
+			out:      nil,
+			spdk: []string{
+				noWrites,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":"crypto-test"}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":"crypto-test"}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode:  codes.InvalidArgument,
+			errMsg:   "Could not " + resume,
+			calls:    []string{pause, detach, attach, resume, detach, attach, resume},
+			rotation: &bridgepb.KeyRotation{Status: bridgepb.KeyRotationStatus_KEY_ROTATION_STATUS_ROLLED_BACK, Error: "Could not " + resume},
+			keyName:  "crypto-test",
+		},
+		"rollback fails": {
+			in:   rotatedVolume,
+			user: testNamespaceUser,
+			out:  nil,
+			spdk: []string{
+				noWrites,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":""}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":""}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":true}`,
+			},
+			errCode: codes.Internal,
+			errMsg:  "Could not create Crypto Dev: crypto-test; rollback failed: Could not create Crypto Dev: crypto-test",
+			calls:   []string{pause, detach, attach, resume},
+			rotation: &bridgepb.KeyRotation{Status: bridgepb.KeyRotationStatus_KEY_ROTATION_STATUS_FAILED,
+				Error: "Could not create Crypto Dev: crypto-test; rollback failed: Could not create Crypto Dev: crypto-test"},
+			keyName: "crypto-test",
+		},
+		"user cannot be detached": {
+			in:       rotatedVolume,
+			user:     "//storage.opiproject.org/volumes/qos-test",
+			out:      nil,
+			spdk:     []string{},
+			errCode:  codes.FailedPrecondition,
+			errMsg:   fmt.Sprintf("EncryptedVolume %s is used by //storage.opiproject.org/volumes/qos-test, which cannot be detached", encryptedVolumeName),
+			calls:    nil,
+			rotation: nil,
+			keyName:  "crypto-test",
+		},
+		"base volume changed": {
+			in: &pb.EncryptedVolume{
+				Name:          encryptedVolumeName,
+				VolumeNameRef: "volume-42",
+				Key:           newKey,
+				Cipher:        encryptedVolume.Cipher,
+			},
+			user:     testNamespaceUser,
+			out:      nil,
+			spdk:     []string{},
+			errCode:  codes.InvalidArgument,
+			errMsg:   fmt.Sprintf("VolumeNameRef of EncryptedVolume %s cannot be changed", encryptedVolumeName),
+			calls:    nil,
+			rotation: nil,
+			keyName:  "crypto-test",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+			consumer := &testConsumer{failures: map[string]bool{}}
+			for _, failure := range tt.failures {
+				consumer.failures[failure] = true
+			}
+			testEnv.opiSpdkServer.registry.AddConsumer(consumer)
+			base, err := testEnv.opiSpdkServer.registry.Get(encryptedVolume.VolumeNameRef)
+			if err != nil {
+				t.Fatal(err)
+			}
+			existing := server.ProtoClone(expectedVolume)
+			testEnv.opiSpdkServer.volumes.encVolumes[encryptedVolumeName] = existing
+			testEnv.opiSpdkServer.volumes.encKeys[encryptedVolumeName] = &bridgepb.EncryptedVolumeKey{
+				KeyName:     encryptedVolumeID,
+				Fingerprint: keyFingerprint(encryptedVolume.Key),
+			}
+			testEnv.opiSpdkServer.registerEncryptedVolume(encryptedVolumeName, base)
+			testEnv.opiSpdkServer.acquireVolume(encryptedVolumeName, tt.user)
+
+			request := &pb.UpdateEncryptedVolumeRequest{EncryptedVolume: tt.in}
+			response, err := testEnv.client.UpdateEncryptedVolume(testEnv.ctx, request)
+
+			if !proto.Equal(response, tt.out) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+			if !reflect.DeepEqual(consumer.calls, tt.calls) {
+				t.Error("consumer calls: expected", tt.calls, "received", consumer.calls)
+			}
+
+			volumeStatus, err := testEnv.client.GetEncryptedVolumeStatus(testEnv.ctx, &bridgepb.GetEncryptedVolumeStatusRequest{Name: encryptedVolumeName})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if volumeStatus.Key.KeyName != tt.keyName {
+				t.Error("key name: expected", tt.keyName, "received", volumeStatus.Key.KeyName)
+			}
+			if tt.rotation == nil {
+				if len(volumeStatus.Rotations) != 0 {
+					t.Error("rotations: expected none, received", volumeStatus.Rotations)
+				}
+				return
+			}
+			if len(volumeStatus.Rotations) != 1 {
+				t.Fatal("rotations: expected 1, received", volumeStatus.Rotations)
+			}
+			rotation := volumeStatus.Rotations[0]
+			if rotation.Status != tt.rotation.Status || rotation.Error != tt.rotation.Error {
+				t.Error("rotation: expected", tt.rotation, "received", rotation)
+			}
+			if rotation.PreviousFingerprint != keyFingerprint(encryptedVolume.Key) || rotation.Fingerprint != keyFingerprint(newKey) {
+				t.Error("rotation fingerprints: received", rotation.PreviousFingerprint, rotation.Fingerprint)
+			}
+			if rotation.Time == nil {
+				t.Error("rotation time not set")
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_KeyRotationHistoryIsBounded(t *testing.T) {
+	testEnv := createTestEnvironment([]string{})
+	defer testEnv.Close()
+
+	for i := 0; i < maxKeyRotations+2; i++ {
+		testEnv.opiSpdkServer.recordKeyRotation(encryptedVolumeName, &bridgepb.KeyRotation{Fingerprint: fmt.Sprint(i)})
+	}
+
+	rotations := testEnv.opiSpdkServer.volumes.encRotations[encryptedVolumeName]
+	if len(rotations) != maxKeyRotations {
+		t.Fatal("rotations: expected", maxKeyRotations, "received", len(rotations))
+	}
+	if rotations[0].Fingerprint != "2" || rotations[maxKeyRotations-1].Fingerprint != fmt.Sprint(maxKeyRotations+1) {
+		t.Error("rotations: expected latest entries, received", rotations)
+	}
+}
@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package middleend implements the MiddleEnd APIs (service) of the storage Server
+package middleend
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/opiproject/gospdk/spdk"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"github.com/opiproject/opi-spdk-bridge/pkg/volume"
+
+	"go.einride.tech/aip/fieldbehavior"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// histogramKinds are kinds of I/O whose latencies can be collected by SPDK
+var histogramKinds = map[bridgepb.HistogramKind]string{
+	bridgepb.HistogramKind_HISTOGRAM_KIND_ALL:   server.HistogramAll,
+	bridgepb.HistogramKind_HISTOGRAM_KIND_READ:  server.HistogramRead,
+	bridgepb.HistogramKind_HISTOGRAM_KIND_WRITE: server.HistogramWrite,
+}
+
+// StatsVolume gets 64-bit I/O stats of any registered volume together with
+// rates observed since the previous stats of the volume were requested
+func (s *Server) StatsVolume(_ context.Context, in *bridgepb.StatsVolumeRequest) (*bridgepb.StatsVolumeResponse, error) {
+	log.Printf("StatsVolume: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	stats, err := s.volumeStats(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &bridgepb.StatsVolumeResponse{Stats: volumeStatsToProto(stats)}, nil
+}
+
+// EnableVolumeHistogram starts collecting latencies of kind of I/O of any
+// registered volume into a histogram. Latency percentiles are then reported
+// in stats of the volume. Enabling an enabled histogram resets it
+func (s *Server) EnableVolumeHistogram(_ context.Context, in *bridgepb.EnableVolumeHistogramRequest) (*emptypb.Empty, error) {
+	log.Printf("EnableVolumeHistogram: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	kind, ok := histogramKinds[in.Kind]
+	if !ok {
+		msg := fmt.Sprintf("histogram kind %v is not supported", in.Kind)
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	vol, err := s.registry.Get(in.Name)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if err := server.EnableLatencyHistogram(s.rpc, vol.BdevName, kind); err != nil {
+		return nil, err
+	}
+	if err := s.registry.SetHistogram(vol.Name, kind); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DisableVolumeHistogram stops collecting latencies of a registered volume
+func (s *Server) DisableVolumeHistogram(_ context.Context, in *bridgepb.DisableVolumeHistogramRequest) (*emptypb.Empty, error) {
+	log.Printf("DisableVolumeHistogram: Received from client: %v", server.Redact(in))
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	vol, err := s.registry.Get(in.Name)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if vol.Histogram == "" {
+		log.Printf("Histogram of %v is not enabled", vol.Name)
+		return &emptypb.Empty{}, nil
+	}
+	if err := server.DisableLatencyHistogram(s.rpc, vol.BdevName); err != nil {
+		return nil, err
+	}
+	if err := s.registry.SetHistogram(vol.Name, ""); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// volumeStats gets I/O statistics of a registered volume and rates since the
+// previous statistics of the volume
+func (s *Server) volumeStats(volumeNameRef string) (*server.VolumeStats, error) {
+	vol, err := s.registry.Get(volumeNameRef)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	params := spdk.BdevGetIostatParams{
+		Name: vol.BdevName,
+	}
+	var result spdk.BdevGetIostatResult
+	err = s.rpc.Call("bdev_get_iostat", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if len(result.Bdevs) != 1 {
+		msg := fmt.Sprintf("expecting exactly 1 result, got %d", len(result.Bdevs))
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+	stats := server.NewVolumeStats(&result)
+	if err := s.volumeHistogram(vol, stats); err != nil {
+		return nil, err
+	}
+	s.stats.Update(vol.BdevName, stats)
+	log.Printf("Rates of %v: %+v", vol.BdevName, stats.Rates)
+	return stats, nil
+}
+
+// volumeHistogram adds latency percentiles to stats of a volume with an
+// enabled histogram
+func (s *Server) volumeHistogram(vol volume.Volume, stats *server.VolumeStats) error {
+	if vol.Histogram == "" {
+		return nil
+	}
+	histogram, err := server.GetLatencyHistogram(s.rpc, vol.BdevName)
+	if err != nil {
+		return err
+	}
+	stats.SetHistogram(histogram)
+	log.Printf("Latency of %v %v I/O: %v", vol.BdevName, vol.Histogram, stats.Percentiles)
+	return nil
+}
+
+// volumeStatsToProto converts 64-bit stats of a volume into the bridge model
+func volumeStatsToProto(stats *server.VolumeStats) *bridgepb.VolumeIoStats {
+	response := &bridgepb.VolumeIoStats{
+		Uptime:          durationpb.New(stats.Uptime),
+		ReadBytesCount:  stats.ReadBytes,
+		ReadOpsCount:    stats.ReadOps,
+		WriteBytesCount: stats.WriteBytes,
+		WriteOpsCount:   stats.WriteOps,
+		UnmapBytesCount: stats.UnmapBytes,
+		UnmapOpsCount:   stats.UnmapOps,
+		ReadLatency:     durationpb.New(stats.ReadLatency),
+		WriteLatency:    durationpb.New(stats.WriteLatency),
+		UnmapLatency:    durationpb.New(stats.UnmapLatency),
+		Percentiles:     percentilesToProto(stats.Percentiles),
+	}
+	if rates := stats.Rates; rates != nil {
+		response.Rates = &bridgepb.VolumeRates{
+			Interval:     durationpb.New(rates.Interval),
+			ReadIops:     rates.ReadIops,
+			WriteIops:    rates.WriteIops,
+			UnmapIops:    rates.UnmapIops,
+			ReadMbs:      rates.ReadMbs,
+			WriteMbs:     rates.WriteMbs,
+			UnmapMbs:     rates.UnmapMbs,
+			ReadLatency:  durationpb.New(rates.ReadLatency),
+			WriteLatency: durationpb.New(rates.WriteLatency),
+			UnmapLatency: durationpb.New(rates.UnmapLatency),
+			Percentiles:  percentilesToProto(rates.Percentiles),
+		}
+	}
+	return response
+}
+
+func percentilesToProto(percentiles *server.LatencyPercentiles) *bridgepb.LatencyPercentiles {
+	if percentiles == nil {
+		return nil
+	}
+	return &bridgepb.LatencyPercentiles{
+		Count: percentiles.Count,
+		P50:   durationpb.New(percentiles.P50),
+		P90:   durationpb.New(percentiles.P90),
+		P99:   durationpb.New(percentiles.P99),
+		P999:  durationpb.New(percentiles.P999),
+	}
+}
@@ -0,0 +1,286 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package middleend implements the MiddleEnd APIs (service) of the storage Server
+package middleend
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestMiddleEnd_StatsVolume(t *testing.T) {
+	tests := map[string]struct {
+		in        string
+		histogram string
+		previous  int
+		spdk      []string
+		out       *bridgepb.VolumeIoStats
+		errCode   codes.Code
+		errMsg    string
+	}{
+		"rates since previous stats": {
+			in:       "volume-42",
+			previous: 1,
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":{"tick_rate":1000,"ticks":1000,"bdevs":[{"name":"volume-42","num_read_ops":1000}]}}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":{"tick_rate":1000,"ticks":3000,"bdevs":[{"name":"volume-42","bytes_written":4294967296,"num_read_ops":5000,"read_latency_ticks":4}]}}`,
+			},
+			out: &bridgepb.VolumeIoStats{
+				Uptime:          durationpb.New(3 * time.Second),
+				ReadOpsCount:    5000,
+				WriteBytesCount: 4294967296,
+				ReadLatency:     durationpb.New(4 * time.Millisecond),
+				WriteLatency:    durationpb.New(0),
+				UnmapLatency:    durationpb.New(0),
+				Rates: &bridgepb.VolumeRates{
+					Interval:     durationpb.New(2 * time.Second),
+					ReadIops:     2000,
+					WriteMbs:     2048,
+					ReadLatency:  durationpb.New(time.Microsecond),
+					WriteLatency: durationpb.New(0),
+					UnmapLatency: durationpb.New(0),
+				},
+			},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"latency percentiles of enabled histogram": {
+			in:        "volume-42",
+			histogram: server.HistogramRead,
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":{"tick_rate":1000,"ticks":1000,"bdevs":[{"name":"volume-42","num_read_ops":4}]}}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":{"histogram":"AAAAAAAAAAAAAAAAAAAAAAMAAAAAAAAAAQAAAAAAAAA=","bucket_shift":1,"tsc_rate":1000}}`,
+			},
+			out: &bridgepb.VolumeIoStats{
+				Uptime:       durationpb.New(time.Second),
+				ReadOpsCount: 4,
+				ReadLatency:  durationpb.New(0),
+				WriteLatency: durationpb.New(0),
+				UnmapLatency: durationpb.New(0),
+				Percentiles: &bridgepb.LatencyPercentiles{
+					Count: 4,
+					P50:   durationpb.New(3 * time.Millisecond),
+					P90:   durationpb.New(4 * time.Millisecond),
+					P99:   durationpb.New(4 * time.Millisecond),
+					P999:  durationpb.New(4 * time.Millisecond),
+				},
+			},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"valid request with invalid histogram": {
+			in:        "volume-42",
+			histogram: server.HistogramAll,
+			spdk: []string{
+				`{"id":%d,"error":{"code":0,"message":""},"result":{"tick_rate":1000,"ticks":1000,"bdevs":[{"name":"volume-42"}]}}`,
+				`{"id":%d,"error":{"code":0,"message":""},"result":{"histogram":"AAAAAAAAAAA=","bucket_shift":1,"tsc_rate":1000}}`,
+			},
+			out:     nil,
+			errCode: codes.InvalidArgument,
+			errMsg:  "histogram of 8 bytes does not match bucket_shift 1",
+		},
+		"valid request with invalid SPDK response": {
+			in:      "volume-42",
+			spdk:    []string{`{"id":%d,"error":{"code":0,"message":""},"result":{"tick_rate":0,"ticks":0,"bdevs":[]}}`},
+			out:     nil,
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("expecting exactly 1 result, got %v", 0),
+		},
+		"unknown volume": {
+			in:      "unknown-volume",
+			spdk:    []string{},
+			out:     nil,
+			errCode: codes.NotFound,
+			errMsg:  "unable to find volume unknown-volume",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			if tt.histogram != "" {
+				if err := testEnv.opiSpdkServer.registry.SetHistogram(tt.in, tt.histogram); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			request := &bridgepb.StatsVolumeRequest{Name: tt.in}
+			for i := 0; i < tt.previous; i++ {
+				if _, err := testEnv.client.StatsVolume(testEnv.ctx, request); err != nil {
+					t.Fatal(err)
+				}
+			}
+			response, err := testEnv.client.StatsVolume(testEnv.ctx, request)
+
+			if !proto.Equal(response.GetStats(), tt.out) {
+				t.Error("response: expected", tt.out, "received", response.GetStats())
+			}
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_EnableVolumeHistogram(t *testing.T) {
+	tests := map[string]struct {
+		in        string
+		kind      bridgepb.HistogramKind
+		spdk      []string
+		histogram string
+		errCode   codes.Code
+		errMsg    string
+	}{
+		"valid request": {
+			in:        "volume-42",
+			kind:      bridgepb.HistogramKind_HISTOGRAM_KIND_WRITE,
+			spdk:      []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			histogram: server.HistogramWrite,
+			errCode:   codes.OK,
+			errMsg:    "",
+		},
+		"unsupported kind of I/O": {
+			in:        "volume-42",
+			kind:      9,
+			spdk:      []string{},
+			histogram: "",
+			errCode:   codes.InvalidArgument,
+			errMsg:    "histogram kind 9 is not supported",
+		},
+		"missing kind of I/O": {
+			in:        "volume-42",
+			spdk:      []string{},
+			histogram: "",
+			errCode:   codes.Unknown,
+			errMsg:    "missing required field: kind",
+		},
+		"valid request with invalid SPDK response": {
+			in:        "volume-42",
+			kind:      bridgepb.HistogramKind_HISTOGRAM_KIND_ALL,
+			spdk:      []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			histogram: "",
+			errCode:   codes.InvalidArgument,
+			errMsg:    "Could not set histogram of volume-42 to true",
+		},
+		"valid request with error code from SPDK response": {
+			in:        "volume-42",
+			kind:      bridgepb.HistogramKind_HISTOGRAM_KIND_ALL,
+			spdk:      []string{`{"id":%d,"error":{"code":1,"message":"myopierr"},"result":false}`},
+			histogram: "",
+			errCode:   codes.Unknown,
+			errMsg:    "bdev_enable_histogram: json response error: myopierr",
+		},
+		"unknown volume": {
+			in:        "unknown-volume",
+			kind:      bridgepb.HistogramKind_HISTOGRAM_KIND_ALL,
+			spdk:      []string{},
+			histogram: "",
+			errCode:   codes.NotFound,
+			errMsg:    "unable to find volume unknown-volume",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+
+			_, err := testEnv.client.EnableVolumeHistogram(testEnv.ctx, &bridgepb.EnableVolumeHistogramRequest{Name: tt.in, Kind: tt.kind})
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+			if vol, err := testEnv.opiSpdkServer.registry.Get(tt.in); err == nil && vol.Histogram != tt.histogram {
+				t.Errorf("histogram: expected %v, received %v", tt.histogram, vol.Histogram)
+			}
+		})
+	}
+}
+
+func TestMiddleEnd_DisableVolumeHistogram(t *testing.T) {
+	tests := map[string]struct {
+		in        string
+		enabled   bool
+		spdk      []string
+		histogram string
+		errCode   codes.Code
+		errMsg    string
+	}{
+		"valid request": {
+			in:        "volume-42",
+			enabled:   true,
+			spdk:      []string{`{"id":%d,"error":{"code":0,"message":""},"result":true}`},
+			histogram: "",
+			errCode:   codes.OK,
+			errMsg:    "",
+		},
+		"histogram not enabled": {
+			in:        "volume-42",
+			enabled:   false,
+			spdk:      []string{},
+			histogram: "",
+			errCode:   codes.OK,
+			errMsg:    "",
+		},
+		"valid request with invalid SPDK response": {
+			in:        "volume-42",
+			enabled:   true,
+			spdk:      []string{`{"id":%d,"error":{"code":0,"message":""},"result":false}`},
+			histogram: server.HistogramAll,
+			errCode:   codes.InvalidArgument,
+			errMsg:    "Could not set histogram of volume-42 to false",
+		},
+		"unknown volume": {
+			in:        "unknown-volume",
+			spdk:      []string{},
+			histogram: "",
+			errCode:   codes.NotFound,
+			errMsg:    "unable to find volume unknown-volume",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testEnv := createTestEnvironment(tt.spdk)
+			defer testEnv.Close()
+			if tt.enabled {
+				if err := testEnv.opiSpdkServer.registry.SetHistogram(tt.in, server.HistogramAll); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			_, err := testEnv.client.DisableVolumeHistogram(testEnv.ctx, &bridgepb.DisableVolumeHistogramRequest{Name: tt.in})
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+			if vol, err := testEnv.opiSpdkServer.registry.Get(tt.in); err == nil && vol.Histogram != tt.histogram {
+				t.Errorf("histogram: expected %v, received %v", tt.histogram, vol.Histogram)
+			}
+		})
+	}
+}
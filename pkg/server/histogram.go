@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package server implements the server
+package server
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"math"
+	"math/bits"
+	"time"
+
+	"github.com/opiproject/gospdk/spdk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Kinds of I/O whose latencies can be collected into a histogram
+const (
+	HistogramAll   = "all"
+	HistogramRead  = "read"
+	HistogramWrite = "write"
+)
+
+// bdevEnableHistogramParams holds the parameters required to enable or
+// disable a latency histogram of a Block Device
+type bdevEnableHistogramParams struct {
+	Name   string `json:"name"`
+	Enable bool   `json:"enable"`
+	Opc    string `json:"opc,omitempty"`
+}
+
+// bdevEnableHistogramResult is the result of enabling or disabling a latency
+// histogram of a Block Device
+type bdevEnableHistogramResult bool
+
+// bdevGetHistogramParams holds the parameters required to get a latency
+// histogram of a Block Device
+type bdevGetHistogramParams struct {
+	Name string `json:"name"`
+}
+
+// bdevGetHistogramResult is a latency histogram of a Block Device. Buckets
+// are base64 encoded little endian 64-bit counters
+type bdevGetHistogramResult struct {
+	Histogram   string `json:"histogram"`
+	BucketShift uint   `json:"bucket_shift"`
+	TscRate     uint64 `json:"tsc_rate"`
+}
+
+// LatencyHistogram is a latency histogram of a bdev in the SPDK format. The
+// histogram is split into ranges of 1 << BucketShift buckets each, range n
+// covering latencies below 1 << (n + BucketShift) ticks
+type LatencyHistogram struct {
+	BucketShift uint
+	TickRate    uint64
+	Buckets     []uint64
+}
+
+// LatencyPercentiles are latency percentiles of I/O collected in a histogram.
+// A percentile is the upper bound of the histogram bucket holding it
+type LatencyPercentiles struct {
+	// Count is the number of I/O in the histogram
+	Count uint64
+	P50   time.Duration
+	P90   time.Duration
+	P99   time.Duration
+	P999  time.Duration
+}
+
+// EnableLatencyHistogram enables collecting latencies of kind of I/O into a
+// histogram of a bdev. Any previously collected latencies are dropped
+func EnableLatencyHistogram(rpc spdk.JSONRPC, bdevName string, kind string) error {
+	opc := ""
+	switch kind {
+	case HistogramAll:
+	case HistogramRead, HistogramWrite:
+		opc = kind
+	default:
+		msg := fmt.Sprintf("Histogram of %v I/O is not supported", kind)
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+	return setLatencyHistogram(rpc, &bdevEnableHistogramParams{Name: bdevName, Enable: true, Opc: opc})
+}
+
+// DisableLatencyHistogram stops collecting latencies of a bdev
+func DisableLatencyHistogram(rpc spdk.JSONRPC, bdevName string) error {
+	return setLatencyHistogram(rpc, &bdevEnableHistogramParams{Name: bdevName, Enable: false})
+}
+
+func setLatencyHistogram(rpc spdk.JSONRPC, params *bdevEnableHistogramParams) error {
+	var result bdevEnableHistogramResult
+	err := rpc.Call("bdev_enable_histogram", params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	if !result {
+		msg := fmt.Sprintf("Could not set histogram of %v to %v", params.Name, params.Enable)
+		log.Print(msg)
+		return status.Errorf(codes.InvalidArgument, msg)
+	}
+	return nil
+}
+
+// GetLatencyHistogram gets the latency histogram of a bdev
+func GetLatencyHistogram(rpc spdk.JSONRPC, bdevName string) (*LatencyHistogram, error) {
+	params := bdevGetHistogramParams{
+		Name: bdevName,
+	}
+	var result bdevGetHistogramResult
+	err := rpc.Call("bdev_get_histogram", &params, &result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", result)
+	histogram, err := newLatencyHistogram(&result)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return histogram, nil
+}
+
+func newLatencyHistogram(result *bdevGetHistogramResult) (*LatencyHistogram, error) {
+	data, err := base64.StdEncoding.DecodeString(result.Histogram)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode histogram: %v", err)
+	}
+	if result.BucketShift >= 32 {
+		return nil, fmt.Errorf("histogram bucket_shift %v is not supported", result.BucketShift)
+	}
+	bucketsPerRange := 1 << result.BucketShift
+	if len(data)%8 != 0 || (len(data)/8)%bucketsPerRange != 0 ||
+		len(data)/8/bucketsPerRange > 65-int(result.BucketShift) {
+		return nil, fmt.Errorf("histogram of %v bytes does not match bucket_shift %v", len(data), result.BucketShift)
+	}
+	buckets := make([]uint64, len(data)/8)
+	for i := range buckets {
+		buckets[i] = binary.LittleEndian.Uint64(data[i*8:])
+	}
+	return &LatencyHistogram{
+		BucketShift: result.BucketShift,
+		TickRate:    result.TscRate,
+		Buckets:     buckets,
+	}, nil
+}
+
+// Count is the number of I/O in the histogram
+func (h *LatencyHistogram) Count() uint64 {
+	var count uint64
+	for _, bucket := range h.Buckets {
+		count += bucket
+	}
+	return count
+}
+
+// Permille returns the latency below which the given permille of I/O
+// completed, e.g. 999 for the 99.9th percentile
+func (h *LatencyHistogram) Permille(permille uint64) time.Duration {
+	count := h.Count()
+	if count == 0 {
+		return 0
+	}
+	hi, lo := bits.Mul64(count, permille)
+	rank, remainder := bits.Div64(hi, lo, 1000)
+	if remainder > 0 || rank == 0 {
+		rank++
+	}
+	var seen uint64
+	for i, bucket := range h.Buckets {
+		seen += bucket
+		if seen >= rank {
+			return ticksToDuration(h.bucketEnd(i), h.TickRate)
+		}
+	}
+	return ticksToDuration(h.bucketEnd(len(h.Buckets)-1), h.TickRate)
+}
+
+// Percentiles computes the usual latency percentiles of the histogram
+func (h *LatencyHistogram) Percentiles() *LatencyPercentiles {
+	return &LatencyPercentiles{
+		Count: h.Count(),
+		P50:   h.Permille(500),
+		P90:   h.Permille(900),
+		P99:   h.Permille(990),
+		P999:  h.Permille(999),
+	}
+}
+
+// Sub returns the histogram of I/O completed since prev was taken, nil if
+// the histogram was reset or changed its format since then
+func (h *LatencyHistogram) Sub(prev *LatencyHistogram) *LatencyHistogram {
+	if prev == nil || h.BucketShift != prev.BucketShift || h.TickRate != prev.TickRate ||
+		len(h.Buckets) != len(prev.Buckets) {
+		return nil
+	}
+	buckets := make([]uint64, len(h.Buckets))
+	for i, bucket := range h.Buckets {
+		if bucket < prev.Buckets[i] {
+			return nil
+		}
+		buckets[i] = bucket - prev.Buckets[i]
+	}
+	return &LatencyHistogram{
+		BucketShift: h.BucketShift,
+		TickRate:    h.TickRate,
+		Buckets:     buckets,
+	}
+}
+
+// bucketEnd is the number of ticks ending a bucket, the same way as
+// __spdk_histogram_data_get_bucket_start
+func (h *LatencyHistogram) bucketEnd(i int) uint64 {
+	rng := uint(i) >> h.BucketShift
+	index := uint64(i)&(1<<h.BucketShift-1) + 1
+	if rng == 0 {
+		return index
+	}
+	start := uint64(1) << (rng + h.BucketShift - 1)
+	offset := index << (rng - 1)
+	if start > math.MaxUint64-offset {
+		return math.MaxUint64
+	}
+	return start + offset
+}
+
+// String formats percentiles for logs
+func (p *LatencyPercentiles) String() string {
+	return fmt.Sprintf("{Count:%v P50:%v P90:%v P99:%v P999:%v}", p.Count, p.P50, p.P90, p.P99, p.P999)
+}
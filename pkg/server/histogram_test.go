@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package server implements the server
+package server
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// encodeTestHistogram encodes buckets the same way as bdev_get_histogram
+func encodeTestHistogram(buckets ...uint64) string {
+	data := make([]byte, 8*len(buckets))
+	for i, bucket := range buckets {
+		binary.LittleEndian.PutUint64(data[i*8:], bucket)
+	}
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+func TestNewLatencyHistogram(t *testing.T) {
+	tests := map[string]struct {
+		in          *bdevGetHistogramResult
+		out         *LatencyPercentiles
+		errExpected string
+	}{
+		"percentiles are bucket upper bounds": {
+			in: &bdevGetHistogramResult{
+				// buckets end at 1, 2, 3, 4, 6, 8, 12 and 16 ticks
+				Histogram:   encodeTestHistogram(0, 0, 0, 500, 0, 400, 90, 10),
+				BucketShift: 1,
+				TscRate:     1000,
+			},
+			out: &LatencyPercentiles{
+				Count: 1000,
+				P50:   4 * time.Millisecond,
+				P90:   8 * time.Millisecond,
+				P99:   12 * time.Millisecond,
+				P999:  16 * time.Millisecond,
+			},
+		},
+		"percentiles round up": {
+			in: &bdevGetHistogramResult{
+				Histogram:   encodeTestHistogram(1, 0, 0, 0, 0, 0, 0, 1),
+				BucketShift: 1,
+				TscRate:     1000,
+			},
+			out: &LatencyPercentiles{
+				Count: 2,
+				P50:   time.Millisecond,
+				P90:   16 * time.Millisecond,
+				P99:   16 * time.Millisecond,
+				P999:  16 * time.Millisecond,
+			},
+		},
+		"last bucket of default bucket shift": {
+			in: &bdevGetHistogramResult{
+				Histogram:   encodeTestHistogram(append(make([]uint64, 58*128-1), 1)...),
+				BucketShift: 7,
+				TscRate:     1,
+			},
+			out: &LatencyPercentiles{
+				Count: 1,
+				P50:   time.Duration(1<<63 - 1),
+				P90:   time.Duration(1<<63 - 1),
+				P99:   time.Duration(1<<63 - 1),
+				P999:  time.Duration(1<<63 - 1),
+			},
+		},
+		"empty histogram": {
+			in: &bdevGetHistogramResult{
+				Histogram:   encodeTestHistogram(0, 0),
+				BucketShift: 1,
+				TscRate:     1000,
+			},
+			out: &LatencyPercentiles{},
+		},
+		"invalid encoding": {
+			in:          &bdevGetHistogramResult{Histogram: "!!", BucketShift: 1},
+			errExpected: "cannot decode histogram: illegal base64 data at input byte 0",
+		},
+		"buckets do not match bucket shift": {
+			in:          &bdevGetHistogramResult{Histogram: encodeTestHistogram(1, 2, 3), BucketShift: 1},
+			errExpected: "histogram of 24 bytes does not match bucket_shift 1",
+		},
+		"too many ranges": {
+			in:          &bdevGetHistogramResult{Histogram: encodeTestHistogram(make([]uint64, 66)...), BucketShift: 0},
+			errExpected: "histogram of 528 bytes does not match bucket_shift 0",
+		},
+		"unsupported bucket shift": {
+			in:          &bdevGetHistogramResult{Histogram: "", BucketShift: 32},
+			errExpected: "histogram bucket_shift 32 is not supported",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			histogram, err := newLatencyHistogram(tt.in)
+
+			errMsg := ""
+			if err != nil {
+				errMsg = err.Error()
+			}
+			if errMsg != tt.errExpected {
+				t.Errorf("error: expected %v, received %v", tt.errExpected, errMsg)
+			}
+			if tt.out == nil {
+				if histogram != nil {
+					t.Errorf("histogram: expected nil, received %+v", histogram)
+				}
+				return
+			}
+			if percentiles := histogram.Percentiles(); !reflect.DeepEqual(percentiles, tt.out) {
+				t.Errorf("percentiles: expected %v, received %v", tt.out, percentiles)
+			}
+		})
+	}
+}
+
+func TestLatencyHistogram_Sub(t *testing.T) {
+	prev := &LatencyHistogram{BucketShift: 1, TickRate: 1000, Buckets: []uint64{1, 2, 3, 4}}
+	tests := map[string]struct {
+		in  *LatencyHistogram
+		out *LatencyHistogram
+	}{
+		"I/O since previous histogram": {
+			in:  &LatencyHistogram{BucketShift: 1, TickRate: 1000, Buckets: []uint64{1, 5, 3, 10}},
+			out: &LatencyHistogram{BucketShift: 1, TickRate: 1000, Buckets: []uint64{0, 3, 0, 6}},
+		},
+		"histogram was reset": {
+			in:  &LatencyHistogram{BucketShift: 1, TickRate: 1000, Buckets: []uint64{0, 5, 3, 10}},
+			out: nil,
+		},
+		"different bucket shift": {
+			in:  &LatencyHistogram{BucketShift: 2, TickRate: 1000, Buckets: []uint64{1, 2, 3, 4}},
+			out: nil,
+		},
+		"different number of buckets": {
+			in:  &LatencyHistogram{BucketShift: 1, TickRate: 1000, Buckets: []uint64{1, 2, 3, 4, 5, 6}},
+			out: nil,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			histogram := tt.in.Sub(prev)
+
+			if !reflect.DeepEqual(histogram, tt.out) {
+				t.Errorf("histogram: expected %+v, received %+v", tt.out, histogram)
+			}
+		})
+	}
+}
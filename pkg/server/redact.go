@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package server implements the server
+package server
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Redacted replaces values of sensitive fields in logs
+const Redacted = "[REDACTED]"
+
+// sensitiveFields are fields carrying key material. opi-api does not
+// annotate them with debug_redact, so they are listed here. Fields
+// annotated with debug_redact are redacted as well
+var sensitiveFields = map[protoreflect.FullName]bool{
+	fieldName(&pb.EncryptedVolume{}, "key"):      true,
+	fieldName(&pb.NvmeRemoteController{}, "psk"): true,
+}
+
+// sensitiveSpdkParams are SPDK request parameters carrying key material,
+// logged by the SPDK client as raw JSON
+var sensitiveSpdkParams = regexp.MustCompile(`("(?:key|key2)"\s*:\s*)"[^"]*"`)
+
+func fieldName(msg proto.Message, field protoreflect.Name) protoreflect.FullName {
+	fd := msg.ProtoReflect().Descriptor().Fields().ByName(field)
+	if fd == nil {
+		panic("unknown field " + string(field) + " of " + string(msg.ProtoReflect().Descriptor().FullName()))
+	}
+	return fd.FullName()
+}
+
+// Redact returns a copy of msg with values of sensitive fields replaced,
+// so that it can be logged or put into an error message
+func Redact[T proto.Message](msg T) T {
+	redacted := ProtoClone(msg)
+	if redacted.ProtoReflect().IsValid() {
+		redactMessage(redacted.ProtoReflect())
+	}
+	return redacted
+}
+
+func isSensitive(fd protoreflect.FieldDescriptor) bool {
+	if sensitiveFields[fd.FullName()] {
+		return true
+	}
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	return ok && opts.GetDebugRedact()
+}
+
+func redactMessage(m protoreflect.Message) {
+	var sensitive []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case isSensitive(fd):
+			sensitive = append(sensitive, fd)
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					redactMessage(mv.Message())
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				for i := 0; i < v.List().Len(); i++ {
+					redactMessage(v.List().Get(i).Message())
+				}
+			}
+		case fd.Message() != nil:
+			redactMessage(v.Message())
+		}
+		return true
+	})
+	for _, fd := range sensitive {
+		switch {
+		case fd.IsList() || fd.IsMap():
+			m.Clear(fd)
+		case fd.Kind() == protoreflect.BytesKind:
+			m.Set(fd, protoreflect.ValueOfBytes([]byte(Redacted)))
+		case fd.Kind() == protoreflect.StringKind:
+			m.Set(fd, protoreflect.ValueOfString(Redacted))
+		default:
+			m.Clear(fd)
+		}
+	}
+}
+
+// redactingWriter removes key material from log output
+type redactingWriter struct {
+	out io.Writer
+}
+
+// NewRedactingWriter creates a writer replacing values of sensitive SPDK
+// request parameters before passing data to out. It is meant to be set as
+// log output to cover messages logged by dependencies
+func NewRedactingWriter(out io.Writer) io.Writer {
+	return &redactingWriter{out: out}
+}
+
+func (w *redactingWriter) Write(p []byte) (int, error) {
+	redacted := sensitiveSpdkParams.ReplaceAll(p, []byte(`${1}"`+Redacted+`"`))
+	if bytes.Equal(redacted, p) {
+		return w.out.Write(p)
+	}
+	if _, err := w.out.Write(redacted); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
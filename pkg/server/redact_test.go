@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package server implements the server
+package server
+
+import (
+	"bytes"
+	"testing"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-spdk-bridge/pkg/bridgepb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRedact(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	tests := map[string]struct {
+		in  proto.Message
+		out proto.Message
+	}{
+		"encrypted volume key": {
+			in: &pb.EncryptedVolume{Name: "crypto", VolumeNameRef: "volume", Key: key},
+			out: &pb.EncryptedVolume{Name: "crypto", VolumeNameRef: "volume",
+				Key: []byte(Redacted)},
+		},
+		"nested encrypted volume key": {
+			in: &pb.CreateEncryptedVolumeRequest{EncryptedVolumeId: "crypto",
+				EncryptedVolume: &pb.EncryptedVolume{Key: key}},
+			out: &pb.CreateEncryptedVolumeRequest{EncryptedVolumeId: "crypto",
+				EncryptedVolume: &pb.EncryptedVolume{Key: []byte(Redacted)}},
+		},
+		"remote controller psk": {
+			in: &pb.UpdateNvmeRemoteControllerRequest{
+				NvmeRemoteController: &pb.NvmeRemoteController{Name: "ctrl", Psk: key}},
+			out: &pb.UpdateNvmeRemoteControllerRequest{
+				NvmeRemoteController: &pb.NvmeRemoteController{Name: "ctrl", Psk: []byte(Redacted)}},
+		},
+		"dhchap keys annotated with debug_redact": {
+			in: &bridgepb.SetNvmeRemoteControllerDhchapRequest{Name: "ctrl",
+				Dhchap: &bridgepb.Dhchap{HostKey: key, CtrlrKey: key, Digests: []string{"sha256"}}},
+			out: &bridgepb.SetNvmeRemoteControllerDhchapRequest{Name: "ctrl",
+				Dhchap: &bridgepb.Dhchap{HostKey: []byte(Redacted), CtrlrKey: []byte(Redacted), Digests: []string{"sha256"}}},
+		},
+		"unset key is not redacted": {
+			in:  &pb.EncryptedVolume{Name: "crypto"},
+			out: &pb.EncryptedVolume{Name: "crypto"},
+		},
+		"message without sensitive fields": {
+			in:  &pb.NullVolume{Name: "null", BlockSize: 512},
+			out: &pb.NullVolume{Name: "null", BlockSize: 512},
+		},
+		"nil message": {
+			in:  (*pb.EncryptedVolume)(nil),
+			out: (*pb.EncryptedVolume)(nil),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			original := ProtoClone(tt.in)
+
+			out := Redact(tt.in)
+
+			if !proto.Equal(out, tt.out) {
+				t.Error("response: expected", tt.out, "received", out)
+			}
+			if !proto.Equal(tt.in, original) {
+				t.Error("input changed to", tt.in)
+			}
+		})
+	}
+}
+
+func TestRedactingWriter(t *testing.T) {
+	tests := map[string]struct {
+		in  string
+		out string
+	}{
+		"crypto key create": {
+			in:  `Sending to SPDK: {"jsonrpc":"2.0","id":1,"method":"accel_crypto_key_create","params":{"cipher":"AES_XTS","key":"30313233","key2":"34353637","name":"crypto"}}` + "\n",
+			out: `Sending to SPDK: {"jsonrpc":"2.0","id":1,"method":"accel_crypto_key_create","params":{"cipher":"AES_XTS","key":"[REDACTED]","key2":"[REDACTED]","name":"crypto"}}` + "\n",
+		},
+		"key names are kept": {
+			in:  `{"params":{"key_name":"crypto","dhchap_key":"key0"}}` + "\n",
+			out: `{"params":{"key_name":"crypto","dhchap_key":"key0"}}` + "\n",
+		},
+		"no parameters": {
+			in:  "Received from SPDK: true\n",
+			out: "Received from SPDK: true\n",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := NewRedactingWriter(&buf)
+
+			n, err := w.Write([]byte(tt.in))
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != len(tt.in) {
+				t.Error("written: expected", len(tt.in), "received", n)
+			}
+			if buf.String() != tt.out {
+				t.Error("output: expected", tt.out, "received", buf.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package server implements the server
+package server
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/opiproject/gospdk/spdk"
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+)
+
+// bytesPerMb is the unit of bandwidth rates, as in bdev_set_qos_limit
+const bytesPerMb = 1024 * 1024
+
+// VolumeStats are I/O statistics of a bdev reported by bdev_get_iostat.
+// Counters are kept in 64 bits and latencies are converted from ticks using
+// tick_rate
+type VolumeStats struct {
+	// Uptime is the time SPDK was running for when the stats were taken
+	Uptime       time.Duration
+	ReadBytes    uint64
+	ReadOps      uint64
+	WriteBytes   uint64
+	WriteOps     uint64
+	UnmapBytes   uint64
+	UnmapOps     uint64
+	ReadLatency  time.Duration
+	WriteLatency time.Duration
+	UnmapLatency time.Duration
+	// Histogram is the latency histogram of the bdev, nil if the histogram
+	// is not enabled
+	Histogram *LatencyHistogram
+	// Percentiles are latency percentiles of I/O since the histogram was
+	// enabled, nil if the histogram is not enabled
+	Percentiles *LatencyPercentiles
+	// Rates are observed since the previous stats of the bdev, nil if
+	// there are no previous stats to compare with
+	Rates *VolumeRates
+}
+
+// VolumeRates are per second rates and average latencies of a bdev observed
+// between two stats
+type VolumeRates struct {
+	// Interval is the time between the stats
+	Interval  time.Duration
+	ReadIops  float64
+	WriteIops float64
+	UnmapIops float64
+	ReadMbs   float64
+	WriteMbs  float64
+	UnmapMbs  float64
+	// ReadLatency is the average latency of reads completed in Interval
+	ReadLatency  time.Duration
+	WriteLatency time.Duration
+	UnmapLatency time.Duration
+	// Percentiles are latency percentiles of I/O completed in Interval,
+	// nil if the histogram was not enabled for the whole interval
+	Percentiles *LatencyPercentiles
+}
+
+// NewVolumeStats converts the first bdev of a bdev_get_iostat result. The
+// result is expected to hold exactly one bdev
+func NewVolumeStats(result *spdk.BdevGetIostatResult) *VolumeStats {
+	tickRate := uint64(nonNegative(result.TickRate))
+	bdev := result.Bdevs[0]
+	return &VolumeStats{
+		Uptime:       ticksToDuration(uint64(nonNegative(int(result.Ticks))), tickRate),
+		ReadBytes:    uint64(nonNegative(bdev.BytesRead)),
+		ReadOps:      uint64(nonNegative(bdev.NumReadOps)),
+		WriteBytes:   uint64(nonNegative(bdev.BytesWritten)),
+		WriteOps:     uint64(nonNegative(bdev.NumWriteOps)),
+		UnmapBytes:   uint64(nonNegative(bdev.BytesUnmapped)),
+		UnmapOps:     uint64(nonNegative(bdev.NumUnmapOps)),
+		ReadLatency:  ticksToDuration(uint64(nonNegative(bdev.ReadLatencyTicks)), tickRate),
+		WriteLatency: ticksToDuration(uint64(nonNegative(bdev.WriteLatencyTicks)), tickRate),
+		UnmapLatency: ticksToDuration(uint64(nonNegative(bdev.UnmapLatencyTicks)), tickRate),
+	}
+}
+
+// SetHistogram adds the latency histogram of the bdev and its percentiles.
+// It is expected to be called before the stats are tracked
+func (v *VolumeStats) SetHistogram(histogram *LatencyHistogram) {
+	v.Histogram = histogram
+	v.Percentiles = histogram.Percentiles()
+}
+
+// ToProto converts stats into the OPI model. Its counters are int32, so
+// every counter saturates at math.MaxInt32 instead of wrapping: the OPI
+// Stats* RPCs cannot report more than 2 GiB read, written or unmapped, nor
+// more than 2^31-1 operations. The *LatencyTicks fields carry microseconds,
+// not ticks, and saturate the same way. StatsVolume of the bridge
+// VolumeStatsService reports the same stats without these limits
+func (v *VolumeStats) ToProto() *pb.VolumeStats {
+	return &pb.VolumeStats{
+		ReadBytesCount:    saturateInt32(v.ReadBytes),
+		ReadOpsCount:      saturateInt32(v.ReadOps),
+		WriteBytesCount:   saturateInt32(v.WriteBytes),
+		WriteOpsCount:     saturateInt32(v.WriteOps),
+		UnmapBytesCount:   saturateInt32(v.UnmapBytes),
+		UnmapOpsCount:     saturateInt32(v.UnmapOps),
+		ReadLatencyTicks:  saturateInt32(uint64(v.ReadLatency.Microseconds())),
+		WriteLatencyTicks: saturateInt32(uint64(v.WriteLatency.Microseconds())),
+		UnmapLatencyTicks: saturateInt32(uint64(v.UnmapLatency.Microseconds())),
+	}
+}
+
+// RatesSince computes rates between prev and v. There are no rates if no
+// time passed or the counters were reset, e.g. by recreating the bdev
+func (v *VolumeStats) RatesSince(prev *VolumeStats) *VolumeRates {
+	if prev == nil || v.Uptime <= prev.Uptime ||
+		v.ReadOps < prev.ReadOps || v.WriteOps < prev.WriteOps || v.UnmapOps < prev.UnmapOps ||
+		v.ReadBytes < prev.ReadBytes || v.WriteBytes < prev.WriteBytes || v.UnmapBytes < prev.UnmapBytes ||
+		v.ReadLatency < prev.ReadLatency || v.WriteLatency < prev.WriteLatency || v.UnmapLatency < prev.UnmapLatency {
+		return nil
+	}
+	interval := v.Uptime - prev.Uptime
+	seconds := interval.Seconds()
+	readOps := v.ReadOps - prev.ReadOps
+	writeOps := v.WriteOps - prev.WriteOps
+	unmapOps := v.UnmapOps - prev.UnmapOps
+	rates := &VolumeRates{
+		Interval:     interval,
+		ReadIops:     float64(readOps) / seconds,
+		WriteIops:    float64(writeOps) / seconds,
+		UnmapIops:    float64(unmapOps) / seconds,
+		ReadMbs:      float64(v.ReadBytes-prev.ReadBytes) / bytesPerMb / seconds,
+		WriteMbs:     float64(v.WriteBytes-prev.WriteBytes) / bytesPerMb / seconds,
+		UnmapMbs:     float64(v.UnmapBytes-prev.UnmapBytes) / bytesPerMb / seconds,
+		ReadLatency:  averageLatency(v.ReadLatency-prev.ReadLatency, readOps),
+		WriteLatency: averageLatency(v.WriteLatency-prev.WriteLatency, writeOps),
+		UnmapLatency: averageLatency(v.UnmapLatency-prev.UnmapLatency, unmapOps),
+	}
+	if v.Histogram != nil {
+		if histogram := v.Histogram.Sub(prev.Histogram); histogram != nil {
+			rates.Percentiles = histogram.Percentiles()
+		}
+	}
+	return rates
+}
+
+// StatsTracker keeps the last stats of bdevs, so rates can be computed
+// between subsequent stats requests
+type StatsTracker struct {
+	mu   sync.Mutex
+	last map[string]*VolumeStats
+}
+
+// NewStatsTracker creates a tracker without stats
+func NewStatsTracker() *StatsTracker {
+	return &StatsTracker{
+		last: make(map[string]*VolumeStats),
+	}
+}
+
+// Update sets rates of stats of a bdev since its previous stats and keeps
+// the stats for the next update
+func (t *StatsTracker) Update(bdevName string, v *VolumeStats) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	v.Rates = v.RatesSince(t.last[bdevName])
+	last := *v
+	last.Rates = nil
+	t.last[bdevName] = &last
+}
+
+// ticksToDuration converts ticks into time. Whole seconds and the remainder
+// are converted separately to not overflow for large tick counts
+func ticksToDuration(ticks uint64, tickRate uint64) time.Duration {
+	if tickRate == 0 {
+		return 0
+	}
+	seconds := ticks / tickRate
+	if seconds >= uint64(math.MaxInt64/int64(time.Second)) {
+		return time.Duration(math.MaxInt64)
+	}
+	var remainder uint64
+	if tickRate <= math.MaxUint64/uint64(time.Second) {
+		remainder = (ticks % tickRate) * uint64(time.Second) / tickRate
+	} else {
+		remainder = uint64(float64(ticks%tickRate) / float64(tickRate) * float64(time.Second))
+	}
+	return time.Duration(seconds)*time.Second + time.Duration(remainder)
+}
+
+func averageLatency(latency time.Duration, ops uint64) time.Duration {
+	if ops == 0 {
+		return 0
+	}
+	return latency / time.Duration(ops)
+}
+
+func saturateInt32(value uint64) int32 {
+	if value > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int32(value)
+}
+
+func nonNegative(value int) int {
+	if value < 0 {
+		return 0
+	}
+	return value
+}
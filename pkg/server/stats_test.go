@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package server implements the server
+package server
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/opiproject/gospdk/spdk"
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNewVolumeStats(t *testing.T) {
+	tests := map[string]struct {
+		iostat string
+		out    *VolumeStats
+		proto  *pb.VolumeStats
+	}{
+		"ticks are converted using tick_rate": {
+			iostat: `{"tick_rate":2000000000,"ticks":4000000000,"bdevs":[{"name":"mytest",` +
+				`"bytes_read":4096,"num_read_ops":1,"read_latency_ticks":30000,"write_latency_ticks":1000}]}`,
+			out: &VolumeStats{
+				Uptime:       2 * time.Second,
+				ReadBytes:    4096,
+				ReadOps:      1,
+				ReadLatency:  15 * time.Microsecond,
+				WriteLatency: 500 * time.Nanosecond,
+			},
+			proto: &pb.VolumeStats{ReadBytesCount: 4096, ReadOpsCount: 1, ReadLatencyTicks: 15},
+		},
+		"counters above 32 bits": {
+			iostat: `{"tick_rate":1000,"ticks":1000,"bdevs":[{"name":"mytest",` +
+				`"bytes_written":8589934592,"num_write_ops":2097152,"unmap_latency_ticks":3000000000}]}`,
+			out: &VolumeStats{
+				Uptime:       time.Second,
+				WriteBytes:   8589934592,
+				WriteOps:     2097152,
+				UnmapLatency: 3000000 * time.Second,
+			},
+			proto: &pb.VolumeStats{WriteBytesCount: math.MaxInt32, WriteOpsCount: 2097152, UnmapLatencyTicks: math.MaxInt32},
+		},
+		"ticks of a long running SPDK": {
+			iostat: `{"tick_rate":3000000000,"ticks":30000000001500000,"bdevs":[{"name":"mytest"}]}`,
+			out: &VolumeStats{
+				Uptime: 10000000*time.Second + 500*time.Microsecond,
+			},
+			proto: &pb.VolumeStats{},
+		},
+		"no tick_rate": {
+			iostat: `{"tick_rate":0,"ticks":0,"bdevs":[{"name":"mytest","num_read_ops":2,"read_latency_ticks":7}]}`,
+			out:    &VolumeStats{ReadOps: 2},
+			proto:  &pb.VolumeStats{ReadOpsCount: 2},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var result spdk.BdevGetIostatResult
+			if err := json.Unmarshal([]byte(tt.iostat), &result); err != nil {
+				t.Fatal(err)
+			}
+
+			stats := NewVolumeStats(&result)
+
+			if !reflect.DeepEqual(stats, tt.out) {
+				t.Errorf("stats: expected %+v, received %+v", tt.out, stats)
+			}
+			if !proto.Equal(stats.ToProto(), tt.proto) {
+				t.Error("proto: expected", tt.proto, "received", stats.ToProto())
+			}
+		})
+	}
+}
+
+func TestStatsTracker_Update(t *testing.T) {
+	first := &VolumeStats{
+		Uptime:      time.Second,
+		ReadBytes:   1024 * 1024,
+		ReadOps:     100,
+		ReadLatency: time.Millisecond,
+	}
+	tests := map[string]struct {
+		stats []*VolumeStats
+		out   *VolumeRates
+	}{
+		"first stats": {
+			stats: []*VolumeStats{first},
+			out:   nil,
+		},
+		"rates between stats": {
+			stats: []*VolumeStats{first, {
+				Uptime:       3 * time.Second,
+				ReadBytes:    5 * 1024 * 1024,
+				ReadOps:      300,
+				ReadLatency:  5 * time.Millisecond,
+				WriteBytes:   2 * 1024 * 1024,
+				WriteOps:     10,
+				WriteLatency: time.Millisecond,
+			}},
+			out: &VolumeRates{
+				Interval:     2 * time.Second,
+				ReadIops:     100,
+				WriteIops:    5,
+				ReadMbs:      2,
+				WriteMbs:     1,
+				ReadLatency:  20 * time.Microsecond,
+				WriteLatency: 100 * time.Microsecond,
+			},
+		},
+		"latency percentiles between stats": {
+			stats: []*VolumeStats{
+				{
+					Uptime:    time.Second,
+					ReadOps:   100,
+					Histogram: &LatencyHistogram{BucketShift: 1, TickRate: 1000, Buckets: []uint64{100, 0, 0, 0}},
+				},
+				{
+					Uptime:    2 * time.Second,
+					ReadOps:   200,
+					Histogram: &LatencyHistogram{BucketShift: 1, TickRate: 1000, Buckets: []uint64{100, 0, 90, 10}},
+				},
+			},
+			out: &VolumeRates{
+				Interval: time.Second,
+				ReadIops: 100,
+				Percentiles: &LatencyPercentiles{
+					Count: 100,
+					P50:   3 * time.Millisecond,
+					P90:   3 * time.Millisecond,
+					P99:   4 * time.Millisecond,
+					P999:  4 * time.Millisecond,
+				},
+			},
+		},
+		"counters were reset": {
+			stats: []*VolumeStats{first, {Uptime: 3 * time.Second, ReadOps: 10}},
+			out:   nil,
+		},
+		"no time passed": {
+			stats: []*VolumeStats{first, {Uptime: time.Second, ReadOps: 200}},
+			out:   nil,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tracker := NewStatsTracker()
+			var stats VolumeStats
+			for _, s := range tt.stats {
+				stats = *s
+				tracker.Update("mytest", &stats)
+			}
+
+			if !reflect.DeepEqual(stats.Rates, tt.out) {
+				t.Errorf("rates: expected %+v, received %+v", tt.out, stats.Rates)
+			}
+		})
+	}
+}
@@ -0,0 +1,293 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package volume keeps track of volumes which can be referenced by other services
+package volume
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Type is the kind of bdev backing a volume
+type Type string
+
+// Types of volumes registered by the bridge services
+const (
+	TypeAio                 Type = "aio"
+	TypeUring               Type = "uring"
+	TypeNull                Type = "null"
+	TypeNvmeRemoteNamespace Type = "nvme"
+	TypeEncrypted           Type = "encrypted"
+	TypeCompressed          Type = "compressed"
+	TypeCached              Type = "cached"
+	TypeError               Type = "error"
+	TypeDelay               Type = "delay"
+)
+
+// Status tells if a volume is referenced by other objects
+type Status string
+
+// Statuses of a volume
+const (
+	StatusAvailable Status = "available"
+	StatusInUse     Status = "in-use"
+)
+
+// Volume describes a bdev which can be referenced as a VolumeNameRef
+type Volume struct {
+	// Name is the resource name of the volume
+	Name string
+	// BdevName is the name of the bdev in SPDK
+	BdevName string
+	// Type is the kind of bdev
+	Type Type
+	// Owner is the service which created the volume
+	Owner string
+	// BlockSize is the size of a block in bytes
+	BlockSize int64
+	// BlocksCount is the number of blocks
+	BlocksCount int64
+	// Histogram is the kind of I/O whose latencies are collected into a
+	// histogram of the bdev, empty if the histogram is disabled
+	Histogram string
+	// Status tells if the volume is used by other objects
+	Status Status
+	// Users are names of objects using the volume
+	Users []string
+}
+
+type entry struct {
+	volume Volume
+	users  map[string]struct{}
+}
+
+// Consumer is a service exposing volumes to hosts. It allows to replace the
+// bdev of a volume without deleting the objects using the volume
+type Consumer interface {
+	// Consumes tells if user is an object of the consumer
+	Consumes(user string) bool
+	// Pause queues I/O of hosts to user until Resume
+	Pause(ctx context.Context, user string) error
+	// Resume resumes I/O of hosts to user paused with Pause
+	Resume(ctx context.Context, user string) error
+	// Detach detaches user from the bdev of the volume
+	Detach(ctx context.Context, user string) error
+	// Attach attaches user detached with Detach to bdevName
+	Attach(ctx context.Context, user string, bdevName string) error
+	// VolumesOf returns references of volumes exposed through object, e.g.
+	// an NVMe subsystem, and tells if object is an object of the consumer
+	VolumesOf(object string) ([]string, bool)
+}
+
+// Registry is a list of volumes shared between services. Volumes are
+// registered by the services creating them and resolved by the services
+// referencing them
+type Registry struct {
+	mu        sync.Mutex
+	volumes   map[string]*entry
+	consumers []Consumer
+}
+
+// NewRegistry creates an empty volume registry
+func NewRegistry() *Registry {
+	return &Registry{
+		volumes: make(map[string]*entry),
+	}
+}
+
+// Register adds a volume to the registry
+func (r *Registry) Register(volume Volume) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.volumes[volume.Name]; ok {
+		return status.Errorf(codes.AlreadyExists, "volume %v already exists", volume.Name)
+	}
+	volume.Status = StatusAvailable
+	volume.Users = nil
+	r.volumes[volume.Name] = &entry{volume: volume, users: make(map[string]struct{})}
+	return nil
+}
+
+// Unregister removes a volume from the registry. Volumes in use cannot be
+// removed
+func (r *Registry) Unregister(name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.volumes[name]
+	if !ok {
+		return status.Errorf(codes.NotFound, "unable to find volume %v", name)
+	}
+	if len(e.users) > 0 {
+		return status.Errorf(codes.FailedPrecondition, "volume %v is in use by %v", name, e.usersList())
+	}
+	delete(r.volumes, name)
+	return nil
+}
+
+// CheckUnused returns an error if a registered volume is in use. Services
+// call it before deleting the bdev of a volume
+func (r *Registry) CheckUnused(name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.volumes[name]
+	if ok && len(e.users) > 0 {
+		return status.Errorf(codes.FailedPrecondition, "volume %v is in use by %v", name, e.usersList())
+	}
+	return nil
+}
+
+// SetSize updates size of a registered volume
+func (r *Registry) SetSize(name string, blockSize int64, blocksCount int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.volumes[name]
+	if !ok {
+		return status.Errorf(codes.NotFound, "unable to find volume %v", name)
+	}
+	e.volume.BlockSize = blockSize
+	e.volume.BlocksCount = blocksCount
+	return nil
+}
+
+// SetHistogram records the kind of I/O collected into a latency histogram of
+// a registered volume, empty to record that the histogram is disabled
+func (r *Registry) SetHistogram(ref string, histogram string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, err := r.find(ref)
+	if err != nil {
+		return err
+	}
+	e.volume.Histogram = histogram
+	return nil
+}
+
+// Get resolves a volume reference. The reference is either the name of a
+// volume or its resource ID
+func (r *Registry) Get(ref string) (Volume, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, err := r.find(ref)
+	if err != nil {
+		return Volume{}, err
+	}
+	return e.snapshot(), nil
+}
+
+// List returns all registered volumes sorted by name
+func (r *Registry) List() []Volume {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	volumes := make([]Volume, 0, len(r.volumes))
+	for _, e := range r.volumes {
+		volumes = append(volumes, e.snapshot())
+	}
+	sort.Slice(volumes, func(i int, j int) bool {
+		return volumes[i].Name < volumes[j].Name
+	})
+	return volumes
+}
+
+// Acquire resolves a volume reference and marks the volume as used by user
+func (r *Registry) Acquire(ref string, user string) (Volume, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, err := r.find(ref)
+	if err != nil {
+		return Volume{}, err
+	}
+	e.users[user] = struct{}{}
+	return e.snapshot(), nil
+}
+
+// Release marks a volume as no longer used by user. Unknown volumes are
+// ignored
+func (r *Registry) Release(ref string, user string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if e, err := r.find(ref); err == nil {
+		delete(e.users, user)
+	}
+}
+
+// AddConsumer registers a service exposing volumes to hosts
+func (r *Registry) AddConsumer(consumer Consumer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.consumers = append(r.consumers, consumer)
+}
+
+// ConsumerOf returns the service which user of a volume is an object of
+func (r *Registry) ConsumerOf(user string) (Consumer, bool) {
+	r.mu.Lock()
+	consumers := r.consumers
+	r.mu.Unlock()
+
+	for _, consumer := range consumers {
+		if consumer.Consumes(user) {
+			return consumer, true
+		}
+	}
+	return nil, false
+}
+
+// VolumesOf returns references of volumes exposed through object of a
+// consumer
+func (r *Registry) VolumesOf(object string) ([]string, error) {
+	r.mu.Lock()
+	consumers := r.consumers
+	r.mu.Unlock()
+
+	for _, consumer := range consumers {
+		if refs, ok := consumer.VolumesOf(object); ok {
+			return refs, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "unable to find object %v", object)
+}
+
+func (r *Registry) find(ref string) (*entry, error) {
+	if e, ok := r.volumes[ref]; ok {
+		return e, nil
+	}
+	if e, ok := r.volumes[server.ResourceIDToVolumeName(ref)]; ok {
+		return e, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "unable to find volume %v", ref)
+}
+
+func (e *entry) snapshot() Volume {
+	volume := e.volume
+	volume.Users = e.usersList()
+	volume.Status = StatusAvailable
+	if len(volume.Users) > 0 {
+		volume.Status = StatusInUse
+	}
+	return volume
+}
+
+func (e *entry) usersList() []string {
+	users := make([]string, 0, len(e.users))
+	for user := range e.users {
+		users = append(users, user)
+	}
+	sort.Strings(users)
+	return users
+}
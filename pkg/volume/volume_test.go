@@ -0,0 +1,319 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (C) 2023 Intel Corporation
+
+// Package volume keeps track of volumes which can be referenced by other services
+package volume
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+)
+
+var (
+	testVolumeID   = "mytest"
+	testVolumeName = server.ResourceIDToVolumeName(testVolumeID)
+	testVolume     = Volume{
+		Name:        testVolumeName,
+		BdevName:    testVolumeID,
+		Type:        TypeAio,
+		Owner:       "AioVolumeService",
+		BlockSize:   512,
+		BlocksCount: 12,
+	}
+)
+
+func TestRegistry_Register(t *testing.T) {
+	tests := map[string]struct {
+		exist   bool
+		errCode codes.Code
+		errMsg  string
+	}{
+		"valid request": {
+			errCode: codes.OK,
+		},
+		"already exists": {
+			exist:   true,
+			errCode: codes.AlreadyExists,
+			errMsg:  "volume " + testVolumeName + " already exists",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := NewRegistry()
+			if tt.exist {
+				if err := r.Register(testVolume); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := r.Register(testVolume)
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}
+
+func TestRegistry_Unregister(t *testing.T) {
+	tests := map[string]struct {
+		name    string
+		user    string
+		errCode codes.Code
+		errMsg  string
+	}{
+		"valid request": {
+			name:    testVolumeName,
+			errCode: codes.OK,
+		},
+		"unknown volume": {
+			name:    server.ResourceIDToVolumeName("unknown"),
+			errCode: codes.NotFound,
+			errMsg:  "unable to find volume " + server.ResourceIDToVolumeName("unknown"),
+		},
+		"volume in use": {
+			name:    testVolumeName,
+			user:    "namespace",
+			errCode: codes.FailedPrecondition,
+			errMsg:  "volume " + testVolumeName + " is in use by [namespace]",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := NewRegistry()
+			if err := r.Register(testVolume); err != nil {
+				t.Fatal(err)
+			}
+			if tt.user != "" {
+				if _, err := r.Acquire(testVolumeName, tt.user); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := r.Unregister(tt.name)
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+			if er.Code() != codes.NotFound {
+				_, err := r.Get(testVolumeName)
+				if (err == nil) != (tt.errCode != codes.OK) {
+					t.Error("unexpected registration state", err)
+				}
+			}
+		})
+	}
+}
+
+func TestRegistry_AcquireRelease(t *testing.T) {
+	tests := map[string]struct {
+		ref     string
+		out     *Volume
+		errCode codes.Code
+		errMsg  string
+	}{
+		"by name": {
+			ref: testVolumeName,
+			out: &Volume{
+				Name:        testVolumeName,
+				BdevName:    testVolumeID,
+				Type:        TypeAio,
+				Owner:       "AioVolumeService",
+				BlockSize:   512,
+				BlocksCount: 12,
+				Status:      StatusInUse,
+				Users:       []string{"user"},
+			},
+			errCode: codes.OK,
+		},
+		"by resource id": {
+			ref: testVolumeID,
+			out: &Volume{
+				Name:        testVolumeName,
+				BdevName:    testVolumeID,
+				Type:        TypeAio,
+				Owner:       "AioVolumeService",
+				BlockSize:   512,
+				BlocksCount: 12,
+				Status:      StatusInUse,
+				Users:       []string{"user"},
+			},
+			errCode: codes.OK,
+		},
+		"unknown volume": {
+			ref:     "unknown",
+			errCode: codes.NotFound,
+			errMsg:  "unable to find volume unknown",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := NewRegistry()
+			if err := r.Register(testVolume); err != nil {
+				t.Fatal(err)
+			}
+
+			volume, err := r.Acquire(tt.ref, "user")
+
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+			if tt.out == nil {
+				return
+			}
+			if !reflect.DeepEqual(volume, *tt.out) {
+				t.Error("volume: expected", *tt.out, "received", volume)
+			}
+
+			r.Release(tt.ref, "user")
+			volume, err = r.Get(tt.ref)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if volume.Status != StatusAvailable || len(volume.Users) != 0 {
+				t.Error("expected volume to be released, received", volume)
+			}
+		})
+	}
+}
+
+type testConsumer struct {
+	users   map[string]bool
+	objects map[string][]string
+}
+
+func (c *testConsumer) Consumes(user string) bool {
+	return c.users[user]
+}
+
+func (c *testConsumer) Pause(context.Context, string) error {
+	return nil
+}
+
+func (c *testConsumer) Resume(context.Context, string) error {
+	return nil
+}
+
+func (c *testConsumer) Detach(context.Context, string) error {
+	return nil
+}
+
+func (c *testConsumer) Attach(context.Context, string, string) error {
+	return nil
+}
+
+func (c *testConsumer) VolumesOf(object string) ([]string, bool) {
+	refs, ok := c.objects[object]
+	return refs, ok
+}
+
+func TestRegistry_ConsumerOf(t *testing.T) {
+	nvme := &testConsumer{users: map[string]bool{"namespace": true}}
+	blk := &testConsumer{users: map[string]bool{"virtio-blk": true}}
+	tests := map[string]struct {
+		user string
+		out  Consumer
+	}{
+		"first consumer": {
+			user: "namespace",
+			out:  nvme,
+		},
+		"second consumer": {
+			user: "virtio-blk",
+			out:  blk,
+		},
+		"user without consumer": {
+			user: "encrypted-volume",
+			out:  nil,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := NewRegistry()
+			r.AddConsumer(nvme)
+			r.AddConsumer(blk)
+
+			consumer, ok := r.ConsumerOf(tt.user)
+
+			if consumer != tt.out {
+				t.Error("consumer: expected", tt.out, "received", consumer)
+			}
+			if ok != (tt.out != nil) {
+				t.Error("found: expected", tt.out != nil, "received", ok)
+			}
+		})
+	}
+}
+
+func TestRegistry_VolumesOf(t *testing.T) {
+	nvme := &testConsumer{objects: map[string][]string{"subsystem": {"volume-1", "volume-2"}}}
+	scsi := &testConsumer{objects: map[string][]string{"scsi-controller": {}}}
+	tests := map[string]struct {
+		object  string
+		out     []string
+		errCode codes.Code
+		errMsg  string
+	}{
+		"object of first consumer": {
+			object:  "subsystem",
+			out:     []string{"volume-1", "volume-2"},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"object without volumes": {
+			object:  "scsi-controller",
+			out:     []string{},
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"unknown object": {
+			object:  "unknown-object",
+			out:     nil,
+			errCode: codes.NotFound,
+			errMsg:  "unable to find object unknown-object",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := NewRegistry()
+			r.AddConsumer(nvme)
+			r.AddConsumer(scsi)
+
+			refs, err := r.VolumesOf(tt.object)
+
+			if !reflect.DeepEqual(refs, tt.out) {
+				t.Error("volumes: expected", tt.out, "received", refs)
+			}
+			er := status.Convert(err)
+			if er.Code() != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", er.Code())
+			}
+			if er.Message() != tt.errMsg {
+				t.Error("error message: expected", tt.errMsg, "received", er.Message())
+			}
+		})
+	}
+}